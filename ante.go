@@ -48,20 +48,25 @@ func SigVerificationGasConsumer(
 var _ sdk.AnteDecorator = SigVerificationDecorator{}
 
 type SigVerificationDecorator struct {
-	bank       types.BankKeeper
-	underlying sdk.AnteDecorator
+	bank           types.BankKeeper
+	circuitBreaker types.CircuitBreaker
+	underlying     sdk.AnteDecorator
 }
 
 var _ sdk.AnteDecorator = SigVerificationDecorator{}
 
-func NewSigVerificationDecorator(bk types.BankKeeper, underlying sdk.AnteDecorator) SigVerificationDecorator {
+// NewSigVerificationDecorator returns a new SigVerificationDecorator. The
+// circuit breaker is optional, and signerless registrations are never
+// rejected if it is nil.
+func NewSigVerificationDecorator(bk types.BankKeeper, cb types.CircuitBreaker, underlying sdk.AnteDecorator) SigVerificationDecorator {
 	if underlying == nil {
 		panic("underlying ante decorator cannot be nil")
 	}
 
 	return SigVerificationDecorator{
-		bank:       bk,
-		underlying: underlying,
+		bank:           bk,
+		circuitBreaker: cb,
+		underlying:     underlying,
 	}
 }
 
@@ -72,13 +77,17 @@ func (d SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 			return d.underlying.AnteHandle(ctx, tx, simulate, next)
 		}
 
-		address := types.GenerateAddress(msg.Channel, msg.Recipient, msg.Fallback)
+		address := types.GenerateAddress(msg.ForwardingAccount())
 		balance := d.bank.GetAllBalances(ctx, address)
 
 		if balance.IsZero() || msg.Signer != address.String() {
 			return d.underlying.AnteHandle(ctx, tx, simulate, next)
 		}
 
+		if d.circuitBreaker != nil && d.circuitBreaker.IsCircuitBreakerEnabled(ctx) {
+			return ctx, types.ErrCircuitBreakerEnabled
+		}
+
 		return next(ctx, tx, simulate)
 	}
 
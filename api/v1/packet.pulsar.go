@@ -4,6 +4,7 @@ package forwardingv1
 import (
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -12,11 +13,220 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_RegisterAccountData_5_list)(nil)
+
+type _RegisterAccountData_5_list struct {
+	list *[]*Hop
+}
+
+func (x *_RegisterAccountData_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RegisterAccountData_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RegisterAccountData_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hop)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RegisterAccountData_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Hop)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RegisterAccountData_5_list) AppendMutable() protoreflect.Value {
+	v := new(Hop)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RegisterAccountData_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RegisterAccountData_5_list) NewElement() protoreflect.Value {
+	v := new(Hop)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RegisterAccountData_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_RegisterAccountData_7_list)(nil)
+
+type _RegisterAccountData_7_list struct {
+	list *[]*Split
+}
+
+func (x *_RegisterAccountData_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RegisterAccountData_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RegisterAccountData_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Split)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RegisterAccountData_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Split)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RegisterAccountData_7_list) AppendMutable() protoreflect.Value {
+	v := new(Split)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RegisterAccountData_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RegisterAccountData_7_list) NewElement() protoreflect.Value {
+	v := new(Split)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RegisterAccountData_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_RegisterAccountData_9_list)(nil)
+
+type _RegisterAccountData_9_list struct {
+	list *[]*DenomRoute
+}
+
+func (x *_RegisterAccountData_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RegisterAccountData_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_RegisterAccountData_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomRoute)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RegisterAccountData_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DenomRoute)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RegisterAccountData_9_list) AppendMutable() protoreflect.Value {
+	v := new(DenomRoute)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RegisterAccountData_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RegisterAccountData_9_list) NewElement() protoreflect.Value {
+	v := new(DenomRoute)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_RegisterAccountData_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_RegisterAccountData_10_list)(nil)
+
+type _RegisterAccountData_10_list struct {
+	list *[]string
+}
+
+func (x *_RegisterAccountData_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_RegisterAccountData_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_RegisterAccountData_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_RegisterAccountData_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_RegisterAccountData_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message RegisterAccountData at list field AllowedDenoms as it is not of Message kind"))
+}
+
+func (x *_RegisterAccountData_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_RegisterAccountData_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_RegisterAccountData_10_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_RegisterAccountData           protoreflect.MessageDescriptor
-	fd_RegisterAccountData_recipient protoreflect.FieldDescriptor
-	fd_RegisterAccountData_channel   protoreflect.FieldDescriptor
-	fd_RegisterAccountData_fallback  protoreflect.FieldDescriptor
+	md_RegisterAccountData                  protoreflect.MessageDescriptor
+	fd_RegisterAccountData_recipient        protoreflect.FieldDescriptor
+	fd_RegisterAccountData_channel          protoreflect.FieldDescriptor
+	fd_RegisterAccountData_fallback         protoreflect.FieldDescriptor
+	fd_RegisterAccountData_memo             protoreflect.FieldDescriptor
+	fd_RegisterAccountData_hops             protoreflect.FieldDescriptor
+	fd_RegisterAccountData_schedule         protoreflect.FieldDescriptor
+	fd_RegisterAccountData_splits           protoreflect.FieldDescriptor
+	fd_RegisterAccountData_batching         protoreflect.FieldDescriptor
+	fd_RegisterAccountData_denom_routes     protoreflect.FieldDescriptor
+	fd_RegisterAccountData_allowed_denoms   protoreflect.FieldDescriptor
+	fd_RegisterAccountData_unwind_only      protoreflect.FieldDescriptor
+	fd_RegisterAccountData_destination_type protoreflect.FieldDescriptor
+	fd_RegisterAccountData_route_data       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +235,16 @@ func init() {
 	fd_RegisterAccountData_recipient = md_RegisterAccountData.Fields().ByName("recipient")
 	fd_RegisterAccountData_channel = md_RegisterAccountData.Fields().ByName("channel")
 	fd_RegisterAccountData_fallback = md_RegisterAccountData.Fields().ByName("fallback")
+	fd_RegisterAccountData_memo = md_RegisterAccountData.Fields().ByName("memo")
+	fd_RegisterAccountData_hops = md_RegisterAccountData.Fields().ByName("hops")
+	fd_RegisterAccountData_schedule = md_RegisterAccountData.Fields().ByName("schedule")
+	fd_RegisterAccountData_splits = md_RegisterAccountData.Fields().ByName("splits")
+	fd_RegisterAccountData_batching = md_RegisterAccountData.Fields().ByName("batching")
+	fd_RegisterAccountData_denom_routes = md_RegisterAccountData.Fields().ByName("denom_routes")
+	fd_RegisterAccountData_allowed_denoms = md_RegisterAccountData.Fields().ByName("allowed_denoms")
+	fd_RegisterAccountData_unwind_only = md_RegisterAccountData.Fields().ByName("unwind_only")
+	fd_RegisterAccountData_destination_type = md_RegisterAccountData.Fields().ByName("destination_type")
+	fd_RegisterAccountData_route_data = md_RegisterAccountData.Fields().ByName("route_data")
 }
 
 var _ protoreflect.Message = (*fastReflection_RegisterAccountData)(nil)
@@ -110,6 +330,66 @@ func (x *fastReflection_RegisterAccountData) Range(f func(protoreflect.FieldDesc
 			return
 		}
 	}
+	if x.Memo != "" {
+		value := protoreflect.ValueOfString(x.Memo)
+		if !f(fd_RegisterAccountData_memo, value) {
+			return
+		}
+	}
+	if len(x.Hops) != 0 {
+		value := protoreflect.ValueOfList(&_RegisterAccountData_5_list{list: &x.Hops})
+		if !f(fd_RegisterAccountData_hops, value) {
+			return
+		}
+	}
+	if x.Schedule != nil {
+		value := protoreflect.ValueOfMessage(x.Schedule.ProtoReflect())
+		if !f(fd_RegisterAccountData_schedule, value) {
+			return
+		}
+	}
+	if len(x.Splits) != 0 {
+		value := protoreflect.ValueOfList(&_RegisterAccountData_7_list{list: &x.Splits})
+		if !f(fd_RegisterAccountData_splits, value) {
+			return
+		}
+	}
+	if x.Batching != nil {
+		value := protoreflect.ValueOfMessage(x.Batching.ProtoReflect())
+		if !f(fd_RegisterAccountData_batching, value) {
+			return
+		}
+	}
+	if len(x.DenomRoutes) != 0 {
+		value := protoreflect.ValueOfList(&_RegisterAccountData_9_list{list: &x.DenomRoutes})
+		if !f(fd_RegisterAccountData_denom_routes, value) {
+			return
+		}
+	}
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_RegisterAccountData_10_list{list: &x.AllowedDenoms})
+		if !f(fd_RegisterAccountData_allowed_denoms, value) {
+			return
+		}
+	}
+	if x.UnwindOnly != false {
+		value := protoreflect.ValueOfBool(x.UnwindOnly)
+		if !f(fd_RegisterAccountData_unwind_only, value) {
+			return
+		}
+	}
+	if x.DestinationType != "" {
+		value := protoreflect.ValueOfString(x.DestinationType)
+		if !f(fd_RegisterAccountData_destination_type, value) {
+			return
+		}
+	}
+	if len(x.RouteData) != 0 {
+		value := protoreflect.ValueOfBytes(x.RouteData)
+		if !f(fd_RegisterAccountData_route_data, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -131,6 +411,26 @@ func (x *fastReflection_RegisterAccountData) Has(fd protoreflect.FieldDescriptor
 		return x.Channel != ""
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		return x.Fallback != ""
+	case "noble.forwarding.v1.RegisterAccountData.memo":
+		return x.Memo != ""
+	case "noble.forwarding.v1.RegisterAccountData.hops":
+		return len(x.Hops) != 0
+	case "noble.forwarding.v1.RegisterAccountData.schedule":
+		return x.Schedule != nil
+	case "noble.forwarding.v1.RegisterAccountData.splits":
+		return len(x.Splits) != 0
+	case "noble.forwarding.v1.RegisterAccountData.batching":
+		return x.Batching != nil
+	case "noble.forwarding.v1.RegisterAccountData.denom_routes":
+		return len(x.DenomRoutes) != 0
+	case "noble.forwarding.v1.RegisterAccountData.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "noble.forwarding.v1.RegisterAccountData.unwind_only":
+		return x.UnwindOnly != false
+	case "noble.forwarding.v1.RegisterAccountData.destination_type":
+		return x.DestinationType != ""
+	case "noble.forwarding.v1.RegisterAccountData.route_data":
+		return len(x.RouteData) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -153,6 +453,26 @@ func (x *fastReflection_RegisterAccountData) Clear(fd protoreflect.FieldDescript
 		x.Channel = ""
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		x.Fallback = ""
+	case "noble.forwarding.v1.RegisterAccountData.memo":
+		x.Memo = ""
+	case "noble.forwarding.v1.RegisterAccountData.hops":
+		x.Hops = nil
+	case "noble.forwarding.v1.RegisterAccountData.schedule":
+		x.Schedule = nil
+	case "noble.forwarding.v1.RegisterAccountData.splits":
+		x.Splits = nil
+	case "noble.forwarding.v1.RegisterAccountData.batching":
+		x.Batching = nil
+	case "noble.forwarding.v1.RegisterAccountData.denom_routes":
+		x.DenomRoutes = nil
+	case "noble.forwarding.v1.RegisterAccountData.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "noble.forwarding.v1.RegisterAccountData.unwind_only":
+		x.UnwindOnly = false
+	case "noble.forwarding.v1.RegisterAccountData.destination_type":
+		x.DestinationType = ""
+	case "noble.forwarding.v1.RegisterAccountData.route_data":
+		x.RouteData = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -178,6 +498,48 @@ func (x *fastReflection_RegisterAccountData) Get(descriptor protoreflect.FieldDe
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		value := x.Fallback
 		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.RegisterAccountData.memo":
+		value := x.Memo
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.RegisterAccountData.hops":
+		if len(x.Hops) == 0 {
+			return protoreflect.ValueOfList(&_RegisterAccountData_5_list{})
+		}
+		listValue := &_RegisterAccountData_5_list{list: &x.Hops}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.forwarding.v1.RegisterAccountData.schedule":
+		value := x.Schedule
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountData.splits":
+		if len(x.Splits) == 0 {
+			return protoreflect.ValueOfList(&_RegisterAccountData_7_list{})
+		}
+		listValue := &_RegisterAccountData_7_list{list: &x.Splits}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.forwarding.v1.RegisterAccountData.batching":
+		value := x.Batching
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountData.denom_routes":
+		if len(x.DenomRoutes) == 0 {
+			return protoreflect.ValueOfList(&_RegisterAccountData_9_list{})
+		}
+		listValue := &_RegisterAccountData_9_list{list: &x.DenomRoutes}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.forwarding.v1.RegisterAccountData.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_RegisterAccountData_10_list{})
+		}
+		listValue := &_RegisterAccountData_10_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "noble.forwarding.v1.RegisterAccountData.unwind_only":
+		value := x.UnwindOnly
+		return protoreflect.ValueOfBool(value)
+	case "noble.forwarding.v1.RegisterAccountData.destination_type":
+		value := x.DestinationType
+		return protoreflect.ValueOfString(value)
+	case "noble.forwarding.v1.RegisterAccountData.route_data":
+		value := x.RouteData
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -204,6 +566,34 @@ func (x *fastReflection_RegisterAccountData) Set(fd protoreflect.FieldDescriptor
 		x.Channel = value.Interface().(string)
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		x.Fallback = value.Interface().(string)
+	case "noble.forwarding.v1.RegisterAccountData.memo":
+		x.Memo = value.Interface().(string)
+	case "noble.forwarding.v1.RegisterAccountData.hops":
+		lv := value.List()
+		clv := lv.(*_RegisterAccountData_5_list)
+		x.Hops = *clv.list
+	case "noble.forwarding.v1.RegisterAccountData.schedule":
+		x.Schedule = value.Message().Interface().(*Schedule)
+	case "noble.forwarding.v1.RegisterAccountData.splits":
+		lv := value.List()
+		clv := lv.(*_RegisterAccountData_7_list)
+		x.Splits = *clv.list
+	case "noble.forwarding.v1.RegisterAccountData.batching":
+		x.Batching = value.Message().Interface().(*Batching)
+	case "noble.forwarding.v1.RegisterAccountData.denom_routes":
+		lv := value.List()
+		clv := lv.(*_RegisterAccountData_9_list)
+		x.DenomRoutes = *clv.list
+	case "noble.forwarding.v1.RegisterAccountData.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_RegisterAccountData_10_list)
+		x.AllowedDenoms = *clv.list
+	case "noble.forwarding.v1.RegisterAccountData.unwind_only":
+		x.UnwindOnly = value.Bool()
+	case "noble.forwarding.v1.RegisterAccountData.destination_type":
+		x.DestinationType = value.Interface().(string)
+	case "noble.forwarding.v1.RegisterAccountData.route_data":
+		x.RouteData = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -224,12 +614,54 @@ func (x *fastReflection_RegisterAccountData) Set(fd protoreflect.FieldDescriptor
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_RegisterAccountData) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "noble.forwarding.v1.RegisterAccountData.hops":
+		if x.Hops == nil {
+			x.Hops = []*Hop{}
+		}
+		value := &_RegisterAccountData_5_list{list: &x.Hops}
+		return protoreflect.ValueOfList(value)
+	case "noble.forwarding.v1.RegisterAccountData.schedule":
+		if x.Schedule == nil {
+			x.Schedule = new(Schedule)
+		}
+		return protoreflect.ValueOfMessage(x.Schedule.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountData.splits":
+		if x.Splits == nil {
+			x.Splits = []*Split{}
+		}
+		value := &_RegisterAccountData_7_list{list: &x.Splits}
+		return protoreflect.ValueOfList(value)
+	case "noble.forwarding.v1.RegisterAccountData.batching":
+		if x.Batching == nil {
+			x.Batching = new(Batching)
+		}
+		return protoreflect.ValueOfMessage(x.Batching.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountData.denom_routes":
+		if x.DenomRoutes == nil {
+			x.DenomRoutes = []*DenomRoute{}
+		}
+		value := &_RegisterAccountData_9_list{list: &x.DenomRoutes}
+		return protoreflect.ValueOfList(value)
+	case "noble.forwarding.v1.RegisterAccountData.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_RegisterAccountData_10_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
 	case "noble.forwarding.v1.RegisterAccountData.recipient":
 		panic(fmt.Errorf("field recipient of message noble.forwarding.v1.RegisterAccountData is not mutable"))
 	case "noble.forwarding.v1.RegisterAccountData.channel":
 		panic(fmt.Errorf("field channel of message noble.forwarding.v1.RegisterAccountData is not mutable"))
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		panic(fmt.Errorf("field fallback of message noble.forwarding.v1.RegisterAccountData is not mutable"))
+	case "noble.forwarding.v1.RegisterAccountData.memo":
+		panic(fmt.Errorf("field memo of message noble.forwarding.v1.RegisterAccountData is not mutable"))
+	case "noble.forwarding.v1.RegisterAccountData.unwind_only":
+		panic(fmt.Errorf("field unwind_only of message noble.forwarding.v1.RegisterAccountData is not mutable"))
+	case "noble.forwarding.v1.RegisterAccountData.destination_type":
+		panic(fmt.Errorf("field destination_type of message noble.forwarding.v1.RegisterAccountData is not mutable"))
+	case "noble.forwarding.v1.RegisterAccountData.route_data":
+		panic(fmt.Errorf("field route_data of message noble.forwarding.v1.RegisterAccountData is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -249,6 +681,32 @@ func (x *fastReflection_RegisterAccountData) NewField(fd protoreflect.FieldDescr
 		return protoreflect.ValueOfString("")
 	case "noble.forwarding.v1.RegisterAccountData.fallback":
 		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.RegisterAccountData.memo":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.RegisterAccountData.hops":
+		list := []*Hop{}
+		return protoreflect.ValueOfList(&_RegisterAccountData_5_list{list: &list})
+	case "noble.forwarding.v1.RegisterAccountData.schedule":
+		m := new(Schedule)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountData.splits":
+		list := []*Split{}
+		return protoreflect.ValueOfList(&_RegisterAccountData_7_list{list: &list})
+	case "noble.forwarding.v1.RegisterAccountData.batching":
+		m := new(Batching)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "noble.forwarding.v1.RegisterAccountData.denom_routes":
+		list := []*DenomRoute{}
+		return protoreflect.ValueOfList(&_RegisterAccountData_9_list{list: &list})
+	case "noble.forwarding.v1.RegisterAccountData.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_RegisterAccountData_10_list{list: &list})
+	case "noble.forwarding.v1.RegisterAccountData.unwind_only":
+		return protoreflect.ValueOfBool(false)
+	case "noble.forwarding.v1.RegisterAccountData.destination_type":
+		return protoreflect.ValueOfString("")
+	case "noble.forwarding.v1.RegisterAccountData.route_data":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: noble.forwarding.v1.RegisterAccountData"))
@@ -330,6 +788,53 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Memo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Hops) > 0 {
+			for _, e := range x.Hops {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Schedule != nil {
+			l = options.Size(x.Schedule)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Splits) > 0 {
+			for _, e := range x.Splits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Batching != nil {
+			l = options.Size(x.Batching)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DenomRoutes) > 0 {
+			for _, e := range x.DenomRoutes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.UnwindOnly {
+			n += 2
+		}
+		l = len(x.DestinationType)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RouteData)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -359,6 +864,122 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.RouteData) > 0 {
+			i -= len(x.RouteData)
+			copy(dAtA[i:], x.RouteData)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RouteData)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.DestinationType) > 0 {
+			i -= len(x.DestinationType)
+			copy(dAtA[i:], x.DestinationType)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationType)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.UnwindOnly {
+			i--
+			if x.UnwindOnly {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.DenomRoutes) > 0 {
+			for iNdEx := len(x.DenomRoutes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomRoutes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if x.Batching != nil {
+			encoded, err := options.Marshal(x.Batching)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Splits) > 0 {
+			for iNdEx := len(x.Splits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Splits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.Schedule != nil {
+			encoded, err := options.Marshal(x.Schedule)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Hops) > 0 {
+			for iNdEx := len(x.Hops) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Hops[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Memo) > 0 {
+			i -= len(x.Memo)
+			copy(dAtA[i:], x.Memo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Memo)))
+			i--
+			dAtA[i] = 0x22
+		}
 		if len(x.Fallback) > 0 {
 			i -= len(x.Fallback)
 			copy(dAtA[i:], x.Fallback)
@@ -431,7 +1052,103 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Channel = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fallback = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -459,11 +1176,185 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Recipient = string(dAtA[iNdEx:postIndex])
+				x.Memo = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Hops = append(x.Hops, &Hop{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Hops[len(x.Hops)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Schedule == nil {
+					x.Schedule = &Schedule{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Schedule); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Splits = append(x.Splits, &Split{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Splits[len(x.Splits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Batching", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Batching == nil {
+					x.Batching = &Batching{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Batching); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomRoutes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomRoutes = append(x.DenomRoutes, &DenomRoute{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomRoutes[len(x.DenomRoutes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -491,11 +1382,31 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Channel = string(dAtA[iNdEx:postIndex])
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 3:
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UnwindOnly", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.UnwindOnly = bool(v != 0)
+			case 12:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -523,7 +1434,41 @@ func (x *fastReflection_RegisterAccountData) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Fallback = string(dAtA[iNdEx:postIndex])
+				x.DestinationType = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RouteData", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RouteData = append(x.RouteData[:0], dAtA[iNdEx:postIndex]...)
+				if x.RouteData == nil {
+					x.RouteData = []byte{}
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -1448,9 +2393,19 @@ type RegisterAccountData struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Channel   string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	Fallback  string `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Recipient       string        `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Channel         string        `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Fallback        string        `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Memo            string        `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Hops            []*Hop        `protobuf:"bytes,5,rep,name=hops,proto3" json:"hops,omitempty"`
+	Schedule        *Schedule     `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Splits          []*Split      `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits,omitempty"`
+	Batching        *Batching     `protobuf:"bytes,8,opt,name=batching,proto3" json:"batching,omitempty"`
+	DenomRoutes     []*DenomRoute `protobuf:"bytes,9,rep,name=denom_routes,json=denomRoutes,proto3" json:"denom_routes,omitempty"`
+	AllowedDenoms   []string      `protobuf:"bytes,10,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	UnwindOnly      bool          `protobuf:"varint,11,opt,name=unwind_only,json=unwindOnly,proto3" json:"unwind_only,omitempty"`
+	DestinationType string        `protobuf:"bytes,12,opt,name=destination_type,json=destinationType,proto3" json:"destination_type,omitempty"`
+	RouteData       []byte        `protobuf:"bytes,13,opt,name=route_data,json=routeData,proto3" json:"route_data,omitempty"`
 }
 
 func (x *RegisterAccountData) Reset() {
@@ -1494,6 +2449,76 @@ func (x *RegisterAccountData) GetFallback() string {
 	return ""
 }
 
+func (x *RegisterAccountData) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *RegisterAccountData) GetHops() []*Hop {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+func (x *RegisterAccountData) GetSchedule() *Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *RegisterAccountData) GetSplits() []*Split {
+	if x != nil {
+		return x.Splits
+	}
+	return nil
+}
+
+func (x *RegisterAccountData) GetBatching() *Batching {
+	if x != nil {
+		return x.Batching
+	}
+	return nil
+}
+
+func (x *RegisterAccountData) GetDenomRoutes() []*DenomRoute {
+	if x != nil {
+		return x.DenomRoutes
+	}
+	return nil
+}
+
+func (x *RegisterAccountData) GetAllowedDenoms() []string {
+	if x != nil {
+		return x.AllowedDenoms
+	}
+	return nil
+}
+
+func (x *RegisterAccountData) GetUnwindOnly() bool {
+	if x != nil {
+		return x.UnwindOnly
+	}
+	return false
+}
+
+func (x *RegisterAccountData) GetDestinationType() string {
+	if x != nil {
+		return x.DestinationType
+	}
+	return ""
+}
+
+func (x *RegisterAccountData) GetRouteData() []byte {
+	if x != nil {
+		return x.RouteData
+	}
+	return nil
+}
+
 type RegisterAccountMemo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1570,42 +2595,76 @@ var file_noble_forwarding_v1_packet_proto_rawDesc = []byte{
 	0x0a, 0x20, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
 	0x6e, 0x67, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x13, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x22, 0x69, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x22, 0xd8, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f,
-	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x6f, 0x62, 0x6c,
-	0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d,
-	0x65, 0x6d, 0x6f, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x05,
-	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x1a, 0x66, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
-	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e,
-	0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xc9, 0x04, 0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x12, 0x32, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x70, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04,
+	0x68, 0x6f, 0x70, 0x73, 0x12, 0x3f, 0x0a, 0x08, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x73, 0x12,
+	0x3f, 0x0a, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x12, 0x48, 0x0a, 0x0c, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x75, 0x6e, 0x77, 0x69, 0x6e, 0x64, 0x5f, 0x6f, 0x6e, 0x6c, 0x79,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x75, 0x6e, 0x77, 0x69, 0x6e, 0x64, 0x4f, 0x6e,
+	0x6c, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a,
+	0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x09, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0xd8, 0x01, 0x0a,
+	0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x4d, 0x65, 0x6d, 0x6f, 0x12, 0x59, 0x0a, 0x05, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x2e, 0x52, 0x65,
 	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x42, 0xe0, 0x01,
-	0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77,
-	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65,
-	0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74,
-	0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x32, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
-	0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
-	0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4e, 0x46, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x6f, 0x62,
-	0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31,
-	0xca, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
-	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46,
-	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65,
-	0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x52, 0x05, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x1a,
+	0x66, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x57, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x12, 0x48, 0x0a,
+	0x0a, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72,
+	0x64, 0x69, 0x6e, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x66, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x42, 0xe0, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x4a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e,
+	0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76, 0x32, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6e, 0x6f,
+	0x62, 0x6c, 0x65, 0x2f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x76,
+	0x31, 0x3b, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x76, 0x31, 0xa2, 0x02,
+	0x03, 0x4e, 0x46, 0x58, 0xaa, 0x02, 0x13, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x2e, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4e, 0x6f, 0x62,
+	0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31,
+	0xe2, 0x02, 0x1f, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x5c, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64,
+	0x69, 0x6e, 0x67, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x15, 0x4e, 0x6f, 0x62, 0x6c, 0x65, 0x3a, 0x3a, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -1625,15 +2684,25 @@ var file_noble_forwarding_v1_packet_proto_goTypes = []interface{}{
 	(*RegisterAccountData)(nil),                            // 0: noble.forwarding.v1.RegisterAccountData
 	(*RegisterAccountMemo)(nil),                            // 1: noble.forwarding.v1.RegisterAccountMemo
 	(*RegisterAccountMemo_RegisterAccountDataWrapper)(nil), // 2: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper
+	(*Hop)(nil),        // 3: noble.forwarding.v1.Hop
+	(*Schedule)(nil),   // 4: noble.forwarding.v1.Schedule
+	(*Split)(nil),      // 5: noble.forwarding.v1.Split
+	(*Batching)(nil),   // 6: noble.forwarding.v1.Batching
+	(*DenomRoute)(nil), // 7: noble.forwarding.v1.DenomRoute
 }
 var file_noble_forwarding_v1_packet_proto_depIdxs = []int32{
-	2, // 0: noble.forwarding.v1.RegisterAccountMemo.noble:type_name -> noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper
-	0, // 1: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding:type_name -> noble.forwarding.v1.RegisterAccountData
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 0: noble.forwarding.v1.RegisterAccountData.hops:type_name -> noble.forwarding.v1.Hop
+	4, // 1: noble.forwarding.v1.RegisterAccountData.schedule:type_name -> noble.forwarding.v1.Schedule
+	5, // 2: noble.forwarding.v1.RegisterAccountData.splits:type_name -> noble.forwarding.v1.Split
+	6, // 3: noble.forwarding.v1.RegisterAccountData.batching:type_name -> noble.forwarding.v1.Batching
+	7, // 4: noble.forwarding.v1.RegisterAccountData.denom_routes:type_name -> noble.forwarding.v1.DenomRoute
+	2, // 5: noble.forwarding.v1.RegisterAccountMemo.noble:type_name -> noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper
+	0, // 6: noble.forwarding.v1.RegisterAccountMemo.RegisterAccountDataWrapper.forwarding:type_name -> noble.forwarding.v1.RegisterAccountData
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_noble_forwarding_v1_packet_proto_init() }
@@ -1641,6 +2710,7 @@ func file_noble_forwarding_v1_packet_proto_init() {
 	if File_noble_forwarding_v1_packet_proto != nil {
 		return
 	}
+	file_noble_forwarding_v1_account_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_noble_forwarding_v1_packet_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RegisterAccountData); i {
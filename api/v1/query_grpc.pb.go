@@ -19,10 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Query_Denoms_FullMethodName         = "/noble.forwarding.v1.Query/Denoms"
-	Query_Address_FullMethodName        = "/noble.forwarding.v1.Query/Address"
-	Query_Stats_FullMethodName          = "/noble.forwarding.v1.Query/Stats"
-	Query_StatsByChannel_FullMethodName = "/noble.forwarding.v1.Query/StatsByChannel"
+	Query_Denoms_FullMethodName              = "/noble.forwarding.v1.Query/Denoms"
+	Query_Address_FullMethodName             = "/noble.forwarding.v1.Query/Address"
+	Query_Stats_FullMethodName               = "/noble.forwarding.v1.Query/Stats"
+	Query_StatsByChannel_FullMethodName      = "/noble.forwarding.v1.Query/StatsByChannel"
+	Query_RefundPolicy_FullMethodName        = "/noble.forwarding.v1.Query/RefundPolicy"
+	Query_TimeoutPolicy_FullMethodName       = "/noble.forwarding.v1.Query/TimeoutPolicy"
+	Query_TimeoutPolicies_FullMethodName     = "/noble.forwarding.v1.Query/TimeoutPolicies"
+	Query_MaxMemoLength_FullMethodName       = "/noble.forwarding.v1.Query/MaxMemoLength"
+	Query_MinForwardAmount_FullMethodName    = "/noble.forwarding.v1.Query/MinForwardAmount"
+	Query_ExecutionLimits_FullMethodName     = "/noble.forwarding.v1.Query/ExecutionLimits"
+	Query_QueueDepth_FullMethodName          = "/noble.forwarding.v1.Query/QueueDepth"
+	Query_FeeSchedule_FullMethodName         = "/noble.forwarding.v1.Query/FeeSchedule"
+	Query_FeeRecipient_FullMethodName        = "/noble.forwarding.v1.Query/FeeRecipient"
+	Query_RelayerFee_FullMethodName          = "/noble.forwarding.v1.Query/RelayerFee"
+	Query_Retries_FullMethodName             = "/noble.forwarding.v1.Query/Retries"
+	Query_FallbackGracePeriod_FullMethodName = "/noble.forwarding.v1.Query/FallbackGracePeriod"
+	Query_BlockedForwards_FullMethodName     = "/noble.forwarding.v1.Query/BlockedForwards"
+	Query_PausedChannels_FullMethodName      = "/noble.forwarding.v1.Query/PausedChannels"
+	Query_CircuitBreaker_FullMethodName      = "/noble.forwarding.v1.Query/CircuitBreaker"
+	Query_RateLimits_FullMethodName          = "/noble.forwarding.v1.Query/RateLimits"
+	Query_RateLimitUsage_FullMethodName      = "/noble.forwarding.v1.Query/RateLimitUsage"
+	Query_HeldDeposits_FullMethodName        = "/noble.forwarding.v1.Query/HeldDeposits"
+	Query_Batch_FullMethodName               = "/noble.forwarding.v1.Query/Batch"
+	Query_ChannelDenoms_FullMethodName       = "/noble.forwarding.v1.Query/ChannelDenoms"
+	Query_UnwindOnly_FullMethodName          = "/noble.forwarding.v1.Query/UnwindOnly"
 )
 
 // QueryClient is the client API for Query service.
@@ -33,6 +54,27 @@ type QueryClient interface {
 	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
 	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
 	StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error)
+	RefundPolicy(ctx context.Context, in *QueryRefundPolicy, opts ...grpc.CallOption) (*QueryRefundPolicyResponse, error)
+	TimeoutPolicy(ctx context.Context, in *QueryTimeoutPolicy, opts ...grpc.CallOption) (*QueryTimeoutPolicyResponse, error)
+	TimeoutPolicies(ctx context.Context, in *QueryTimeoutPolicies, opts ...grpc.CallOption) (*QueryTimeoutPoliciesResponse, error)
+	MaxMemoLength(ctx context.Context, in *QueryMaxMemoLength, opts ...grpc.CallOption) (*QueryMaxMemoLengthResponse, error)
+	MinForwardAmount(ctx context.Context, in *QueryMinForwardAmount, opts ...grpc.CallOption) (*QueryMinForwardAmountResponse, error)
+	ExecutionLimits(ctx context.Context, in *QueryExecutionLimits, opts ...grpc.CallOption) (*QueryExecutionLimitsResponse, error)
+	QueueDepth(ctx context.Context, in *QueryQueueDepth, opts ...grpc.CallOption) (*QueryQueueDepthResponse, error)
+	FeeSchedule(ctx context.Context, in *QueryFeeSchedule, opts ...grpc.CallOption) (*QueryFeeScheduleResponse, error)
+	FeeRecipient(ctx context.Context, in *QueryFeeRecipient, opts ...grpc.CallOption) (*QueryFeeRecipientResponse, error)
+	RelayerFee(ctx context.Context, in *QueryRelayerFee, opts ...grpc.CallOption) (*QueryRelayerFeeResponse, error)
+	Retries(ctx context.Context, in *QueryRetries, opts ...grpc.CallOption) (*QueryRetriesResponse, error)
+	FallbackGracePeriod(ctx context.Context, in *QueryFallbackGracePeriod, opts ...grpc.CallOption) (*QueryFallbackGracePeriodResponse, error)
+	BlockedForwards(ctx context.Context, in *QueryBlockedForwards, opts ...grpc.CallOption) (*QueryBlockedForwardsResponse, error)
+	PausedChannels(ctx context.Context, in *QueryPausedChannels, opts ...grpc.CallOption) (*QueryPausedChannelsResponse, error)
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreaker, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
+	RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	RateLimitUsage(ctx context.Context, in *QueryRateLimitUsage, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error)
+	HeldDeposits(ctx context.Context, in *QueryHeldDeposits, opts ...grpc.CallOption) (*QueryHeldDepositsResponse, error)
+	Batch(ctx context.Context, in *QueryBatch, opts ...grpc.CallOption) (*QueryBatchResponse, error)
+	ChannelDenoms(ctx context.Context, in *QueryChannelDenoms, opts ...grpc.CallOption) (*QueryChannelDenomsResponse, error)
+	UnwindOnly(ctx context.Context, in *QueryUnwindOnly, opts ...grpc.CallOption) (*QueryUnwindOnlyResponse, error)
 }
 
 type queryClient struct {
@@ -83,6 +125,216 @@ func (c *queryClient) StatsByChannel(ctx context.Context, in *QueryStatsByChanne
 	return out, nil
 }
 
+func (c *queryClient) RefundPolicy(ctx context.Context, in *QueryRefundPolicy, opts ...grpc.CallOption) (*QueryRefundPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRefundPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_RefundPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimeoutPolicy(ctx context.Context, in *QueryTimeoutPolicy, opts ...grpc.CallOption) (*QueryTimeoutPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTimeoutPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_TimeoutPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimeoutPolicies(ctx context.Context, in *QueryTimeoutPolicies, opts ...grpc.CallOption) (*QueryTimeoutPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryTimeoutPoliciesResponse)
+	err := c.cc.Invoke(ctx, Query_TimeoutPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxMemoLength(ctx context.Context, in *QueryMaxMemoLength, opts ...grpc.CallOption) (*QueryMaxMemoLengthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMaxMemoLengthResponse)
+	err := c.cc.Invoke(ctx, Query_MaxMemoLength_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinForwardAmount(ctx context.Context, in *QueryMinForwardAmount, opts ...grpc.CallOption) (*QueryMinForwardAmountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryMinForwardAmountResponse)
+	err := c.cc.Invoke(ctx, Query_MinForwardAmount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionLimits(ctx context.Context, in *QueryExecutionLimits, opts ...grpc.CallOption) (*QueryExecutionLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryExecutionLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_ExecutionLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueueDepth(ctx context.Context, in *QueryQueueDepth, opts ...grpc.CallOption) (*QueryQueueDepthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryQueueDepthResponse)
+	err := c.cc.Invoke(ctx, Query_QueueDepth_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSchedule(ctx context.Context, in *QueryFeeSchedule, opts ...grpc.CallOption) (*QueryFeeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, Query_FeeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRecipient(ctx context.Context, in *QueryFeeRecipient, opts ...grpc.CallOption) (*QueryFeeRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFeeRecipientResponse)
+	err := c.cc.Invoke(ctx, Query_FeeRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayerFee(ctx context.Context, in *QueryRelayerFee, opts ...grpc.CallOption) (*QueryRelayerFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRelayerFeeResponse)
+	err := c.cc.Invoke(ctx, Query_RelayerFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Retries(ctx context.Context, in *QueryRetries, opts ...grpc.CallOption) (*QueryRetriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRetriesResponse)
+	err := c.cc.Invoke(ctx, Query_Retries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FallbackGracePeriod(ctx context.Context, in *QueryFallbackGracePeriod, opts ...grpc.CallOption) (*QueryFallbackGracePeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryFallbackGracePeriodResponse)
+	err := c.cc.Invoke(ctx, Query_FallbackGracePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedForwards(ctx context.Context, in *QueryBlockedForwards, opts ...grpc.CallOption) (*QueryBlockedForwardsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBlockedForwardsResponse)
+	err := c.cc.Invoke(ctx, Query_BlockedForwards_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedChannels(ctx context.Context, in *QueryPausedChannels, opts ...grpc.CallOption) (*QueryPausedChannelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryPausedChannelsResponse)
+	err := c.cc.Invoke(ctx, Query_PausedChannels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreaker, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Query_CircuitBreaker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitUsage(ctx context.Context, in *QueryRateLimitUsage, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryRateLimitUsageResponse)
+	err := c.cc.Invoke(ctx, Query_RateLimitUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeldDeposits(ctx context.Context, in *QueryHeldDeposits, opts ...grpc.CallOption) (*QueryHeldDepositsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryHeldDepositsResponse)
+	err := c.cc.Invoke(ctx, Query_HeldDeposits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Batch(ctx context.Context, in *QueryBatch, opts ...grpc.CallOption) (*QueryBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryBatchResponse)
+	err := c.cc.Invoke(ctx, Query_Batch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelDenoms(ctx context.Context, in *QueryChannelDenoms, opts ...grpc.CallOption) (*QueryChannelDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryChannelDenomsResponse)
+	err := c.cc.Invoke(ctx, Query_ChannelDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnwindOnly(ctx context.Context, in *QueryUnwindOnly, opts ...grpc.CallOption) (*QueryUnwindOnlyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QueryUnwindOnlyResponse)
+	err := c.cc.Invoke(ctx, Query_UnwindOnly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility.
@@ -91,6 +343,27 @@ type QueryServer interface {
 	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
 	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
 	StatsByChannel(context.Context, *QueryStatsByChannel) (*QueryStatsByChannelResponse, error)
+	RefundPolicy(context.Context, *QueryRefundPolicy) (*QueryRefundPolicyResponse, error)
+	TimeoutPolicy(context.Context, *QueryTimeoutPolicy) (*QueryTimeoutPolicyResponse, error)
+	TimeoutPolicies(context.Context, *QueryTimeoutPolicies) (*QueryTimeoutPoliciesResponse, error)
+	MaxMemoLength(context.Context, *QueryMaxMemoLength) (*QueryMaxMemoLengthResponse, error)
+	MinForwardAmount(context.Context, *QueryMinForwardAmount) (*QueryMinForwardAmountResponse, error)
+	ExecutionLimits(context.Context, *QueryExecutionLimits) (*QueryExecutionLimitsResponse, error)
+	QueueDepth(context.Context, *QueryQueueDepth) (*QueryQueueDepthResponse, error)
+	FeeSchedule(context.Context, *QueryFeeSchedule) (*QueryFeeScheduleResponse, error)
+	FeeRecipient(context.Context, *QueryFeeRecipient) (*QueryFeeRecipientResponse, error)
+	RelayerFee(context.Context, *QueryRelayerFee) (*QueryRelayerFeeResponse, error)
+	Retries(context.Context, *QueryRetries) (*QueryRetriesResponse, error)
+	FallbackGracePeriod(context.Context, *QueryFallbackGracePeriod) (*QueryFallbackGracePeriodResponse, error)
+	BlockedForwards(context.Context, *QueryBlockedForwards) (*QueryBlockedForwardsResponse, error)
+	PausedChannels(context.Context, *QueryPausedChannels) (*QueryPausedChannelsResponse, error)
+	CircuitBreaker(context.Context, *QueryCircuitBreaker) (*QueryCircuitBreakerResponse, error)
+	RateLimits(context.Context, *QueryRateLimits) (*QueryRateLimitsResponse, error)
+	RateLimitUsage(context.Context, *QueryRateLimitUsage) (*QueryRateLimitUsageResponse, error)
+	HeldDeposits(context.Context, *QueryHeldDeposits) (*QueryHeldDepositsResponse, error)
+	Batch(context.Context, *QueryBatch) (*QueryBatchResponse, error)
+	ChannelDenoms(context.Context, *QueryChannelDenoms) (*QueryChannelDenomsResponse, error)
+	UnwindOnly(context.Context, *QueryUnwindOnly) (*QueryUnwindOnlyResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -113,6 +386,69 @@ func (UnimplementedQueryServer) Stats(context.Context, *QueryStats) (*QueryStats
 func (UnimplementedQueryServer) StatsByChannel(context.Context, *QueryStatsByChannel) (*QueryStatsByChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StatsByChannel not implemented")
 }
+func (UnimplementedQueryServer) RefundPolicy(context.Context, *QueryRefundPolicy) (*QueryRefundPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPolicy not implemented")
+}
+func (UnimplementedQueryServer) TimeoutPolicy(context.Context, *QueryTimeoutPolicy) (*QueryTimeoutPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeoutPolicy not implemented")
+}
+func (UnimplementedQueryServer) TimeoutPolicies(context.Context, *QueryTimeoutPolicies) (*QueryTimeoutPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeoutPolicies not implemented")
+}
+func (UnimplementedQueryServer) MaxMemoLength(context.Context, *QueryMaxMemoLength) (*QueryMaxMemoLengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxMemoLength not implemented")
+}
+func (UnimplementedQueryServer) MinForwardAmount(context.Context, *QueryMinForwardAmount) (*QueryMinForwardAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinForwardAmount not implemented")
+}
+func (UnimplementedQueryServer) ExecutionLimits(context.Context, *QueryExecutionLimits) (*QueryExecutionLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionLimits not implemented")
+}
+func (UnimplementedQueryServer) QueueDepth(context.Context, *QueryQueueDepth) (*QueryQueueDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueDepth not implemented")
+}
+func (UnimplementedQueryServer) FeeSchedule(context.Context, *QueryFeeSchedule) (*QueryFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSchedule not implemented")
+}
+func (UnimplementedQueryServer) FeeRecipient(context.Context, *QueryFeeRecipient) (*QueryFeeRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRecipient not implemented")
+}
+func (UnimplementedQueryServer) RelayerFee(context.Context, *QueryRelayerFee) (*QueryRelayerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerFee not implemented")
+}
+func (UnimplementedQueryServer) Retries(context.Context, *QueryRetries) (*QueryRetriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retries not implemented")
+}
+func (UnimplementedQueryServer) FallbackGracePeriod(context.Context, *QueryFallbackGracePeriod) (*QueryFallbackGracePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FallbackGracePeriod not implemented")
+}
+func (UnimplementedQueryServer) BlockedForwards(context.Context, *QueryBlockedForwards) (*QueryBlockedForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedForwards not implemented")
+}
+func (UnimplementedQueryServer) PausedChannels(context.Context, *QueryPausedChannels) (*QueryPausedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedChannels not implemented")
+}
+func (UnimplementedQueryServer) CircuitBreaker(context.Context, *QueryCircuitBreaker) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
+func (UnimplementedQueryServer) RateLimits(context.Context, *QueryRateLimits) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (UnimplementedQueryServer) RateLimitUsage(context.Context, *QueryRateLimitUsage) (*QueryRateLimitUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitUsage not implemented")
+}
+func (UnimplementedQueryServer) HeldDeposits(context.Context, *QueryHeldDeposits) (*QueryHeldDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeldDeposits not implemented")
+}
+func (UnimplementedQueryServer) Batch(context.Context, *QueryBatch) (*QueryBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (UnimplementedQueryServer) ChannelDenoms(context.Context, *QueryChannelDenoms) (*QueryChannelDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDenoms not implemented")
+}
+func (UnimplementedQueryServer) UnwindOnly(context.Context, *QueryUnwindOnly) (*QueryUnwindOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwindOnly not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 func (UnimplementedQueryServer) testEmbeddedByValue()               {}
 
@@ -206,6 +542,384 @@ func _Query_StatsByChannel_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_RefundPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefundPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RefundPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RefundPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RefundPolicy(ctx, req.(*QueryRefundPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeoutPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeoutPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeoutPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimeoutPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeoutPolicy(ctx, req.(*QueryTimeoutPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeoutPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeoutPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeoutPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TimeoutPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeoutPolicies(ctx, req.(*QueryTimeoutPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxMemoLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxMemoLength)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxMemoLength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MaxMemoLength_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxMemoLength(ctx, req.(*QueryMaxMemoLength))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinForwardAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinForwardAmount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinForwardAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MinForwardAmount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinForwardAmount(ctx, req.(*QueryMinForwardAmount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ExecutionLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionLimits(ctx, req.(*QueryExecutionLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueueDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueueDepth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueueDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_QueueDepth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueueDepth(ctx, req.(*QueryQueueDepth))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSchedule(ctx, req.(*QueryFeeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FeeRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRecipient(ctx, req.(*QueryFeeRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RelayerFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerFee(ctx, req.(*QueryRelayerFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Retries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Retries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Retries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Retries(ctx, req.(*QueryRetries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FallbackGracePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFallbackGracePeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FallbackGracePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FallbackGracePeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FallbackGracePeriod(ctx, req.(*QueryFallbackGracePeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedForwards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BlockedForwards_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedForwards(ctx, req.(*QueryBlockedForwards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedChannels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PausedChannels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedChannels(ctx, req.(*QueryPausedChannels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RateLimitUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitUsage(ctx, req.(*QueryRateLimitUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeldDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeldDeposits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeldDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_HeldDeposits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeldDeposits(ctx, req.(*QueryHeldDeposits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Batch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Batch(ctx, req.(*QueryBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ChannelDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelDenoms(ctx, req.(*QueryChannelDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnwindOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnwindOnly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnwindOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnwindOnly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnwindOnly(ctx, req.(*QueryUnwindOnly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +943,90 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StatsByChannel",
 			Handler:    _Query_StatsByChannel_Handler,
 		},
+		{
+			MethodName: "RefundPolicy",
+			Handler:    _Query_RefundPolicy_Handler,
+		},
+		{
+			MethodName: "TimeoutPolicy",
+			Handler:    _Query_TimeoutPolicy_Handler,
+		},
+		{
+			MethodName: "TimeoutPolicies",
+			Handler:    _Query_TimeoutPolicies_Handler,
+		},
+		{
+			MethodName: "MaxMemoLength",
+			Handler:    _Query_MaxMemoLength_Handler,
+		},
+		{
+			MethodName: "MinForwardAmount",
+			Handler:    _Query_MinForwardAmount_Handler,
+		},
+		{
+			MethodName: "ExecutionLimits",
+			Handler:    _Query_ExecutionLimits_Handler,
+		},
+		{
+			MethodName: "QueueDepth",
+			Handler:    _Query_QueueDepth_Handler,
+		},
+		{
+			MethodName: "FeeSchedule",
+			Handler:    _Query_FeeSchedule_Handler,
+		},
+		{
+			MethodName: "FeeRecipient",
+			Handler:    _Query_FeeRecipient_Handler,
+		},
+		{
+			MethodName: "RelayerFee",
+			Handler:    _Query_RelayerFee_Handler,
+		},
+		{
+			MethodName: "Retries",
+			Handler:    _Query_Retries_Handler,
+		},
+		{
+			MethodName: "FallbackGracePeriod",
+			Handler:    _Query_FallbackGracePeriod_Handler,
+		},
+		{
+			MethodName: "BlockedForwards",
+			Handler:    _Query_BlockedForwards_Handler,
+		},
+		{
+			MethodName: "PausedChannels",
+			Handler:    _Query_PausedChannels_Handler,
+		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitUsage",
+			Handler:    _Query_RateLimitUsage_Handler,
+		},
+		{
+			MethodName: "HeldDeposits",
+			Handler:    _Query_HeldDeposits_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _Query_Batch_Handler,
+		},
+		{
+			MethodName: "ChannelDenoms",
+			Handler:    _Query_ChannelDenoms_Handler,
+		},
+		{
+			MethodName: "UnwindOnly",
+			Handler:    _Query_UnwindOnly_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/forwarding/v1/query.proto",
@@ -19,9 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Msg_RegisterAccount_FullMethodName  = "/noble.forwarding.v1.Msg/RegisterAccount"
-	Msg_ClearAccount_FullMethodName     = "/noble.forwarding.v1.Msg/ClearAccount"
-	Msg_SetAllowedDenoms_FullMethodName = "/noble.forwarding.v1.Msg/SetAllowedDenoms"
+	Msg_RegisterAccount_FullMethodName         = "/noble.forwarding.v1.Msg/RegisterAccount"
+	Msg_ClearAccount_FullMethodName            = "/noble.forwarding.v1.Msg/ClearAccount"
+	Msg_SetAllowedDenoms_FullMethodName        = "/noble.forwarding.v1.Msg/SetAllowedDenoms"
+	Msg_SetBlockedDenoms_FullMethodName        = "/noble.forwarding.v1.Msg/SetBlockedDenoms"
+	Msg_SetRefundPolicy_FullMethodName         = "/noble.forwarding.v1.Msg/SetRefundPolicy"
+	Msg_SetTimeoutPolicy_FullMethodName        = "/noble.forwarding.v1.Msg/SetTimeoutPolicy"
+	Msg_SetMaxMemoLength_FullMethodName        = "/noble.forwarding.v1.Msg/SetMaxMemoLength"
+	Msg_SetMinForwardAmount_FullMethodName     = "/noble.forwarding.v1.Msg/SetMinForwardAmount"
+	Msg_SetExecutionLimits_FullMethodName      = "/noble.forwarding.v1.Msg/SetExecutionLimits"
+	Msg_SetFeeSchedule_FullMethodName          = "/noble.forwarding.v1.Msg/SetFeeSchedule"
+	Msg_SetFeeRecipient_FullMethodName         = "/noble.forwarding.v1.Msg/SetFeeRecipient"
+	Msg_SetRelayerFee_FullMethodName           = "/noble.forwarding.v1.Msg/SetRelayerFee"
+	Msg_SetFallbackGracePeriod_FullMethodName  = "/noble.forwarding.v1.Msg/SetFallbackGracePeriod"
+	Msg_PauseChannel_FullMethodName            = "/noble.forwarding.v1.Msg/PauseChannel"
+	Msg_ResumeChannel_FullMethodName           = "/noble.forwarding.v1.Msg/ResumeChannel"
+	Msg_SetCircuitBreaker_FullMethodName       = "/noble.forwarding.v1.Msg/SetCircuitBreaker"
+	Msg_SetGuardian_FullMethodName             = "/noble.forwarding.v1.Msg/SetGuardian"
+	Msg_SetRateLimit_FullMethodName            = "/noble.forwarding.v1.Msg/SetRateLimit"
+	Msg_SetChannelAllowedDenoms_FullMethodName = "/noble.forwarding.v1.Msg/SetChannelAllowedDenoms"
+	Msg_SetUnwindOnly_FullMethodName           = "/noble.forwarding.v1.Msg/SetUnwindOnly"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +48,23 @@ type MsgClient interface {
 	RegisterAccount(ctx context.Context, in *MsgRegisterAccount, opts ...grpc.CallOption) (*MsgRegisterAccountResponse, error)
 	ClearAccount(ctx context.Context, in *MsgClearAccount, opts ...grpc.CallOption) (*MsgClearAccountResponse, error)
 	SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenoms, opts ...grpc.CallOption) (*MsgSetAllowedDenomsResponse, error)
+	SetBlockedDenoms(ctx context.Context, in *MsgSetBlockedDenoms, opts ...grpc.CallOption) (*MsgSetBlockedDenomsResponse, error)
+	SetRefundPolicy(ctx context.Context, in *MsgSetRefundPolicy, opts ...grpc.CallOption) (*MsgSetRefundPolicyResponse, error)
+	SetTimeoutPolicy(ctx context.Context, in *MsgSetTimeoutPolicy, opts ...grpc.CallOption) (*MsgSetTimeoutPolicyResponse, error)
+	SetMaxMemoLength(ctx context.Context, in *MsgSetMaxMemoLength, opts ...grpc.CallOption) (*MsgSetMaxMemoLengthResponse, error)
+	SetMinForwardAmount(ctx context.Context, in *MsgSetMinForwardAmount, opts ...grpc.CallOption) (*MsgSetMinForwardAmountResponse, error)
+	SetExecutionLimits(ctx context.Context, in *MsgSetExecutionLimits, opts ...grpc.CallOption) (*MsgSetExecutionLimitsResponse, error)
+	SetFeeSchedule(ctx context.Context, in *MsgSetFeeSchedule, opts ...grpc.CallOption) (*MsgSetFeeScheduleResponse, error)
+	SetFeeRecipient(ctx context.Context, in *MsgSetFeeRecipient, opts ...grpc.CallOption) (*MsgSetFeeRecipientResponse, error)
+	SetRelayerFee(ctx context.Context, in *MsgSetRelayerFee, opts ...grpc.CallOption) (*MsgSetRelayerFeeResponse, error)
+	SetFallbackGracePeriod(ctx context.Context, in *MsgSetFallbackGracePeriod, opts ...grpc.CallOption) (*MsgSetFallbackGracePeriodResponse, error)
+	PauseChannel(ctx context.Context, in *MsgPauseChannel, opts ...grpc.CallOption) (*MsgPauseChannelResponse, error)
+	ResumeChannel(ctx context.Context, in *MsgResumeChannel, opts ...grpc.CallOption) (*MsgResumeChannelResponse, error)
+	SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error)
+	SetGuardian(ctx context.Context, in *MsgSetGuardian, opts ...grpc.CallOption) (*MsgSetGuardianResponse, error)
+	SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error)
+	SetChannelAllowedDenoms(ctx context.Context, in *MsgSetChannelAllowedDenoms, opts ...grpc.CallOption) (*MsgSetChannelAllowedDenomsResponse, error)
+	SetUnwindOnly(ctx context.Context, in *MsgSetUnwindOnly, opts ...grpc.CallOption) (*MsgSetUnwindOnlyResponse, error)
 }
 
 type msgClient struct {
@@ -71,6 +105,176 @@ func (c *msgClient) SetAllowedDenoms(ctx context.Context, in *MsgSetAllowedDenom
 	return out, nil
 }
 
+func (c *msgClient) SetBlockedDenoms(ctx context.Context, in *MsgSetBlockedDenoms, opts ...grpc.CallOption) (*MsgSetBlockedDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetBlockedDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetBlockedDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRefundPolicy(ctx context.Context, in *MsgSetRefundPolicy, opts ...grpc.CallOption) (*MsgSetRefundPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRefundPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRefundPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTimeoutPolicy(ctx context.Context, in *MsgSetTimeoutPolicy, opts ...grpc.CallOption) (*MsgSetTimeoutPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetTimeoutPolicyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetTimeoutPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMaxMemoLength(ctx context.Context, in *MsgSetMaxMemoLength, opts ...grpc.CallOption) (*MsgSetMaxMemoLengthResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMaxMemoLengthResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMaxMemoLength_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMinForwardAmount(ctx context.Context, in *MsgSetMinForwardAmount, opts ...grpc.CallOption) (*MsgSetMinForwardAmountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMinForwardAmountResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMinForwardAmount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetExecutionLimits(ctx context.Context, in *MsgSetExecutionLimits, opts ...grpc.CallOption) (*MsgSetExecutionLimitsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetExecutionLimitsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetExecutionLimits_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeSchedule(ctx context.Context, in *MsgSetFeeSchedule, opts ...grpc.CallOption) (*MsgSetFeeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFeeRecipient(ctx context.Context, in *MsgSetFeeRecipient, opts ...grpc.CallOption) (*MsgSetFeeRecipientResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFeeRecipientResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFeeRecipient_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRelayerFee(ctx context.Context, in *MsgSetRelayerFee, opts ...grpc.CallOption) (*MsgSetRelayerFeeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRelayerFeeResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRelayerFee_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFallbackGracePeriod(ctx context.Context, in *MsgSetFallbackGracePeriod, opts ...grpc.CallOption) (*MsgSetFallbackGracePeriodResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetFallbackGracePeriodResponse)
+	err := c.cc.Invoke(ctx, Msg_SetFallbackGracePeriod_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseChannel(ctx context.Context, in *MsgPauseChannel, opts ...grpc.CallOption) (*MsgPauseChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgPauseChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeChannel(ctx context.Context, in *MsgResumeChannel, opts ...grpc.CallOption) (*MsgResumeChannelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResumeChannelResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumeChannel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCircuitBreaker(ctx context.Context, in *MsgSetCircuitBreaker, opts ...grpc.CallOption) (*MsgSetCircuitBreakerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, Msg_SetCircuitBreaker_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetGuardian(ctx context.Context, in *MsgSetGuardian, opts ...grpc.CallOption) (*MsgSetGuardianResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetGuardianResponse)
+	err := c.cc.Invoke(ctx, Msg_SetGuardian_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetRateLimit(ctx context.Context, in *MsgSetRateLimit, opts ...grpc.CallOption) (*MsgSetRateLimitResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetRateLimitResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRateLimit_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetChannelAllowedDenoms(ctx context.Context, in *MsgSetChannelAllowedDenoms, opts ...grpc.CallOption) (*MsgSetChannelAllowedDenomsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetChannelAllowedDenomsResponse)
+	err := c.cc.Invoke(ctx, Msg_SetChannelAllowedDenoms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetUnwindOnly(ctx context.Context, in *MsgSetUnwindOnly, opts ...grpc.CallOption) (*MsgSetUnwindOnlyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetUnwindOnlyResponse)
+	err := c.cc.Invoke(ctx, Msg_SetUnwindOnly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility.
@@ -78,6 +282,23 @@ type MsgServer interface {
 	RegisterAccount(context.Context, *MsgRegisterAccount) (*MsgRegisterAccountResponse, error)
 	ClearAccount(context.Context, *MsgClearAccount) (*MsgClearAccountResponse, error)
 	SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error)
+	SetBlockedDenoms(context.Context, *MsgSetBlockedDenoms) (*MsgSetBlockedDenomsResponse, error)
+	SetRefundPolicy(context.Context, *MsgSetRefundPolicy) (*MsgSetRefundPolicyResponse, error)
+	SetTimeoutPolicy(context.Context, *MsgSetTimeoutPolicy) (*MsgSetTimeoutPolicyResponse, error)
+	SetMaxMemoLength(context.Context, *MsgSetMaxMemoLength) (*MsgSetMaxMemoLengthResponse, error)
+	SetMinForwardAmount(context.Context, *MsgSetMinForwardAmount) (*MsgSetMinForwardAmountResponse, error)
+	SetExecutionLimits(context.Context, *MsgSetExecutionLimits) (*MsgSetExecutionLimitsResponse, error)
+	SetFeeSchedule(context.Context, *MsgSetFeeSchedule) (*MsgSetFeeScheduleResponse, error)
+	SetFeeRecipient(context.Context, *MsgSetFeeRecipient) (*MsgSetFeeRecipientResponse, error)
+	SetRelayerFee(context.Context, *MsgSetRelayerFee) (*MsgSetRelayerFeeResponse, error)
+	SetFallbackGracePeriod(context.Context, *MsgSetFallbackGracePeriod) (*MsgSetFallbackGracePeriodResponse, error)
+	PauseChannel(context.Context, *MsgPauseChannel) (*MsgPauseChannelResponse, error)
+	ResumeChannel(context.Context, *MsgResumeChannel) (*MsgResumeChannelResponse, error)
+	SetCircuitBreaker(context.Context, *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error)
+	SetGuardian(context.Context, *MsgSetGuardian) (*MsgSetGuardianResponse, error)
+	SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error)
+	SetChannelAllowedDenoms(context.Context, *MsgSetChannelAllowedDenoms) (*MsgSetChannelAllowedDenomsResponse, error)
+	SetUnwindOnly(context.Context, *MsgSetUnwindOnly) (*MsgSetUnwindOnlyResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -97,6 +318,57 @@ func (UnimplementedMsgServer) ClearAccount(context.Context, *MsgClearAccount) (*
 func (UnimplementedMsgServer) SetAllowedDenoms(context.Context, *MsgSetAllowedDenoms) (*MsgSetAllowedDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetAllowedDenoms not implemented")
 }
+func (UnimplementedMsgServer) SetBlockedDenoms(context.Context, *MsgSetBlockedDenoms) (*MsgSetBlockedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBlockedDenoms not implemented")
+}
+func (UnimplementedMsgServer) SetRefundPolicy(context.Context, *MsgSetRefundPolicy) (*MsgSetRefundPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRefundPolicy not implemented")
+}
+func (UnimplementedMsgServer) SetTimeoutPolicy(context.Context, *MsgSetTimeoutPolicy) (*MsgSetTimeoutPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTimeoutPolicy not implemented")
+}
+func (UnimplementedMsgServer) SetMaxMemoLength(context.Context, *MsgSetMaxMemoLength) (*MsgSetMaxMemoLengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxMemoLength not implemented")
+}
+func (UnimplementedMsgServer) SetMinForwardAmount(context.Context, *MsgSetMinForwardAmount) (*MsgSetMinForwardAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMinForwardAmount not implemented")
+}
+func (UnimplementedMsgServer) SetExecutionLimits(context.Context, *MsgSetExecutionLimits) (*MsgSetExecutionLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExecutionLimits not implemented")
+}
+func (UnimplementedMsgServer) SetFeeSchedule(context.Context, *MsgSetFeeSchedule) (*MsgSetFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSchedule not implemented")
+}
+func (UnimplementedMsgServer) SetFeeRecipient(context.Context, *MsgSetFeeRecipient) (*MsgSetFeeRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeRecipient not implemented")
+}
+func (UnimplementedMsgServer) SetRelayerFee(context.Context, *MsgSetRelayerFee) (*MsgSetRelayerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRelayerFee not implemented")
+}
+func (UnimplementedMsgServer) SetFallbackGracePeriod(context.Context, *MsgSetFallbackGracePeriod) (*MsgSetFallbackGracePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFallbackGracePeriod not implemented")
+}
+func (UnimplementedMsgServer) PauseChannel(context.Context, *MsgPauseChannel) (*MsgPauseChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseChannel not implemented")
+}
+func (UnimplementedMsgServer) ResumeChannel(context.Context, *MsgResumeChannel) (*MsgResumeChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeChannel not implemented")
+}
+func (UnimplementedMsgServer) SetCircuitBreaker(context.Context, *MsgSetCircuitBreaker) (*MsgSetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCircuitBreaker not implemented")
+}
+func (UnimplementedMsgServer) SetGuardian(context.Context, *MsgSetGuardian) (*MsgSetGuardianResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGuardian not implemented")
+}
+func (UnimplementedMsgServer) SetRateLimit(context.Context, *MsgSetRateLimit) (*MsgSetRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRateLimit not implemented")
+}
+func (UnimplementedMsgServer) SetChannelAllowedDenoms(context.Context, *MsgSetChannelAllowedDenoms) (*MsgSetChannelAllowedDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelAllowedDenoms not implemented")
+}
+func (UnimplementedMsgServer) SetUnwindOnly(context.Context, *MsgSetUnwindOnly) (*MsgSetUnwindOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUnwindOnly not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 func (UnimplementedMsgServer) testEmbeddedByValue()             {}
 
@@ -172,6 +444,312 @@ func _Msg_SetAllowedDenoms_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetBlockedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBlockedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBlockedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetBlockedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBlockedDenoms(ctx, req.(*MsgSetBlockedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRefundPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRefundPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRefundPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRefundPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRefundPolicy(ctx, req.(*MsgSetRefundPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTimeoutPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTimeoutPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTimeoutPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetTimeoutPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTimeoutPolicy(ctx, req.(*MsgSetTimeoutPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMaxMemoLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxMemoLength)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxMemoLength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMaxMemoLength_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxMemoLength(ctx, req.(*MsgSetMaxMemoLength))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMinForwardAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMinForwardAmount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMinForwardAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMinForwardAmount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMinForwardAmount(ctx, req.(*MsgSetMinForwardAmount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetExecutionLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetExecutionLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetExecutionLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetExecutionLimits_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetExecutionLimits(ctx, req.(*MsgSetExecutionLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeSchedule(ctx, req.(*MsgSetFeeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFeeRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFeeRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFeeRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFeeRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFeeRecipient(ctx, req.(*MsgSetFeeRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRelayerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRelayerFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRelayerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRelayerFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRelayerFee(ctx, req.(*MsgSetRelayerFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFallbackGracePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFallbackGracePeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFallbackGracePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetFallbackGracePeriod_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFallbackGracePeriod(ctx, req.(*MsgSetFallbackGracePeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseChannel(ctx, req.(*MsgPauseChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumeChannel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeChannel(ctx, req.(*MsgResumeChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetCircuitBreaker_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCircuitBreaker(ctx, req.(*MsgSetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetGuardian_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetGuardian)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetGuardian(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetGuardian_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetGuardian(ctx, req.(*MsgSetGuardian))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRateLimit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRateLimit(ctx, req.(*MsgSetRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetChannelAllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelAllowedDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelAllowedDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetChannelAllowedDenoms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelAllowedDenoms(ctx, req.(*MsgSetChannelAllowedDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetUnwindOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetUnwindOnly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetUnwindOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetUnwindOnly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetUnwindOnly(ctx, req.(*MsgSetUnwindOnly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -191,6 +769,74 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetAllowedDenoms",
 			Handler:    _Msg_SetAllowedDenoms_Handler,
 		},
+		{
+			MethodName: "SetBlockedDenoms",
+			Handler:    _Msg_SetBlockedDenoms_Handler,
+		},
+		{
+			MethodName: "SetRefundPolicy",
+			Handler:    _Msg_SetRefundPolicy_Handler,
+		},
+		{
+			MethodName: "SetTimeoutPolicy",
+			Handler:    _Msg_SetTimeoutPolicy_Handler,
+		},
+		{
+			MethodName: "SetMaxMemoLength",
+			Handler:    _Msg_SetMaxMemoLength_Handler,
+		},
+		{
+			MethodName: "SetMinForwardAmount",
+			Handler:    _Msg_SetMinForwardAmount_Handler,
+		},
+		{
+			MethodName: "SetExecutionLimits",
+			Handler:    _Msg_SetExecutionLimits_Handler,
+		},
+		{
+			MethodName: "SetFeeSchedule",
+			Handler:    _Msg_SetFeeSchedule_Handler,
+		},
+		{
+			MethodName: "SetFeeRecipient",
+			Handler:    _Msg_SetFeeRecipient_Handler,
+		},
+		{
+			MethodName: "SetRelayerFee",
+			Handler:    _Msg_SetRelayerFee_Handler,
+		},
+		{
+			MethodName: "SetFallbackGracePeriod",
+			Handler:    _Msg_SetFallbackGracePeriod_Handler,
+		},
+		{
+			MethodName: "PauseChannel",
+			Handler:    _Msg_PauseChannel_Handler,
+		},
+		{
+			MethodName: "ResumeChannel",
+			Handler:    _Msg_ResumeChannel_Handler,
+		},
+		{
+			MethodName: "SetCircuitBreaker",
+			Handler:    _Msg_SetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "SetGuardian",
+			Handler:    _Msg_SetGuardian_Handler,
+		},
+		{
+			MethodName: "SetRateLimit",
+			Handler:    _Msg_SetRateLimit_Handler,
+		},
+		{
+			MethodName: "SetChannelAllowedDenoms",
+			Handler:    _Msg_SetChannelAllowedDenoms_Handler,
+		},
+		{
+			MethodName: "SetUnwindOnly",
+			Handler:    _Msg_SetUnwindOnly_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "noble/forwarding/v1/tx.proto",
@@ -21,6 +21,8 @@
 package cli
 
 import (
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -31,6 +33,19 @@ import (
 	"github.com/spf13/cobra"
 )
 
+const (
+	FlagMemo            = "memo"
+	FlagHops            = "hops"
+	FlagSchedule        = "schedule"
+	FlagSplits          = "splits"
+	FlagBatching        = "batching"
+	FlagDenomRoutes     = "denom-routes"
+	FlagAllowedDenoms   = "allowed-denoms"
+	FlagUnwindOnly      = "unwind-only"
+	FlagDestinationType = "destination-type"
+	FlagRouteData       = "route-data"
+)
+
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -57,18 +72,109 @@ func TxRegisterAccountSignerlessly() *cobra.Command {
 				return err
 			}
 
-			address := types.GenerateAddress(args[0], args[1], "")
-			if len(args) == 3 {
-				address = types.GenerateAddress(args[0], args[1], args[2])
+			memo, err := cmd.Flags().GetString(FlagMemo)
+			if err != nil {
+				return err
+			}
+
+			var hops []types.Hop
+			rawHops, err := cmd.Flags().GetString(FlagHops)
+			if err != nil {
+				return err
+			}
+			if rawHops != "" {
+				if err := json.Unmarshal([]byte(rawHops), &hops); err != nil {
+					return fmt.Errorf("failed to parse hops: %w", err)
+				}
+			}
+
+			var schedule types.Schedule
+			rawSchedule, err := cmd.Flags().GetString(FlagSchedule)
+			if err != nil {
+				return err
+			}
+			if rawSchedule != "" {
+				if err := clientCtx.Codec.UnmarshalJSON([]byte(rawSchedule), &schedule); err != nil {
+					return fmt.Errorf("failed to parse schedule: %w", err)
+				}
+			}
+
+			var splits []types.Split
+			rawSplits, err := cmd.Flags().GetString(FlagSplits)
+			if err != nil {
+				return err
+			}
+			if rawSplits != "" {
+				if err := json.Unmarshal([]byte(rawSplits), &splits); err != nil {
+					return fmt.Errorf("failed to parse splits: %w", err)
+				}
+			}
+
+			var batching types.Batching
+			rawBatching, err := cmd.Flags().GetString(FlagBatching)
+			if err != nil {
+				return err
 			}
+			if rawBatching != "" {
+				if err := clientCtx.Codec.UnmarshalJSON([]byte(rawBatching), &batching); err != nil {
+					return fmt.Errorf("failed to parse batching: %w", err)
+				}
+			}
+
+			var denomRoutes []types.DenomRoute
+			rawDenomRoutes, err := cmd.Flags().GetString(FlagDenomRoutes)
+			if err != nil {
+				return err
+			}
+			if rawDenomRoutes != "" {
+				if err := json.Unmarshal([]byte(rawDenomRoutes), &denomRoutes); err != nil {
+					return fmt.Errorf("failed to parse denom routes: %w", err)
+				}
+			}
+
+			allowedDenoms, err := cmd.Flags().GetStringSlice(FlagAllowedDenoms)
+			if err != nil {
+				return err
+			}
+
+			unwindOnly, err := cmd.Flags().GetBool(FlagUnwindOnly)
+			if err != nil {
+				return err
+			}
+
+			destinationType, err := cmd.Flags().GetString(FlagDestinationType)
+			if err != nil {
+				return err
+			}
+
+			rawRouteData, err := cmd.Flags().GetString(FlagRouteData)
+			if err != nil {
+				return err
+			}
+			routeData, err := hex.DecodeString(rawRouteData)
+			if err != nil {
+				return fmt.Errorf("failed to parse route data: %w", err)
+			}
+
 			msg := &types.MsgRegisterAccount{
-				Signer:    address.String(),
-				Recipient: args[1],
-				Channel:   args[0],
+				Recipient:       args[1],
+				Channel:         args[0],
+				Memo:            memo,
+				Hops:            hops,
+				Schedule:        schedule,
+				Splits:          splits,
+				Batching:        batching,
+				DenomRoutes:     denomRoutes,
+				AllowedDenoms:   allowedDenoms,
+				UnwindOnly:      unwindOnly,
+				DestinationType: destinationType,
+				RouteData:       routeData,
 			}
 			if len(args) == 3 {
 				msg.Fallback = args[2]
 			}
+			address := types.GenerateAddress(msg.ForwardingAccount())
+			msg.Signer = address.String()
 
 			factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
 			if err != nil {
@@ -112,6 +218,16 @@ func TxRegisterAccountSignerlessly() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagMemo, "", "Memo included in forwards from the account")
+	cmd.Flags().String(FlagHops, "", `Additional hops executed after each forward, e.g. '[{"channel":"channel-0","receiver":"cosmos1..."}]'`)
+	cmd.Flags().String(FlagSchedule, "", `Schedule of when deposits are forwarded, e.g. '{"hold_duration":"3600000000000"}'`)
+	cmd.Flags().String(FlagSplits, "", `Shares that deposits are split into, in basis points, e.g. '[{"channel":"channel-0","recipient":"cosmos1...","bps":7000},{"channel":"channel-1","recipient":"osmo1...","bps":3000}]'`)
+	cmd.Flags().String(FlagBatching, "", `Batching of deposits before they are forwarded, e.g. '{"targets":[{"denom":"uusdc","amount":"1000000000"}],"max_wait":"86400000000000"}'`)
+	cmd.Flags().String(FlagDenomRoutes, "", `Routes of specific denoms, e.g. '[{"denom":"uusdc","channel":"channel-1","recipient":"osmo1..."}]'`)
+	cmd.Flags().StringSlice(FlagAllowedDenoms, nil, "Denoms that are forwarded from the account, e.g. 'uusdc,ueure'")
+	cmd.Flags().Bool(FlagUnwindOnly, false, "Only forward IBC vouchers that unwind along their own trace")
+	cmd.Flags().String(FlagDestinationType, "", "Type of destination that the account forwards to, e.g. local, defaults to ibc")
+	cmd.Flags().String(FlagRouteData, "", "Hex encoded route data interpreted by the destination type")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
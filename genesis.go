@@ -23,6 +23,8 @@ package forwarding
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/noble-assets/forwarding/v2/keeper"
 	"github.com/noble-assets/forwarding/v2/types"
@@ -30,9 +32,21 @@ import (
 
 func InitGenesis(ctx context.Context, k *keeper.Keeper, genesis types.GenesisState) {
 	for _, denom := range genesis.AllowedDenoms {
-		_ = k.AllowedDenoms.Set(ctx, denom)
+		_ = k.SetAllowedDenom(ctx, denom)
 	}
 
+	for _, denom := range genesis.BlockedDenoms {
+		_ = k.BlockedDenoms.Set(ctx, denom)
+	}
+
+	for _, entry := range genesis.ChannelAllowedDenoms {
+		for _, denom := range entry.Denoms {
+			_ = k.SetChannelAllowedDenom(ctx, entry.Channel, denom)
+		}
+	}
+
+	_ = k.ConfiguredUnwindOnly.Set(ctx, genesis.UnwindOnly)
+
 	for channel, count := range genesis.NumOfAccounts {
 		_ = k.NumOfAccounts.Set(ctx, channel, count)
 	}
@@ -44,13 +58,118 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, genesis types.GenesisSta
 	for channel, total := range genesis.TotalForwarded {
 		_ = k.TotalForwarded.Set(ctx, channel, total)
 	}
+
+	_ = k.ConfiguredRefundPolicy.Set(ctx, int32(genesis.RefundPolicy))
+
+	for channel, policy := range genesis.TimeoutPolicies {
+		_ = k.ConfiguredTimeoutPolicies.Set(ctx, channel, policy)
+	}
+
+	// NOTE: Genesis states exported before the max memo length was introduced
+	// decode it as zero, in which case the default is used.
+	if genesis.MaxMemoLength != 0 {
+		_ = k.ConfiguredMaxMemoLength.Set(ctx, genesis.MaxMemoLength)
+	}
+
+	for denom, rawAmount := range genesis.MinForwardAmounts {
+		amount, _ := sdkmath.NewIntFromString(rawAmount)
+		_ = k.MinForwardAmounts.Set(ctx, denom, amount)
+	}
+
+	for _, count := range genesis.NumOfDeferrals {
+		_ = k.NumOfDeferrals.Set(ctx, collections.Join(count.Channel, int32(count.Reason)), count.Count)
+	}
+
+	for _, retry := range genesis.Retries {
+		_ = k.RetryQueue.Set(ctx, retry.Address, retry)
+	}
+
+	for _, packet := range genesis.InFlightPackets {
+		k.SetInFlightPacket(ctx, packet.Channel, packet.Sequence, packet.Address)
+	}
+
+	_ = k.ConfiguredExecutionLimits.Set(ctx, genesis.ExecutionLimits)
+
+	for _, address := range genesis.ForwardQueue {
+		k.EnqueueForward(ctx, address)
+	}
+
+	for denom, schedule := range genesis.FeeSchedules {
+		_ = k.FeeSchedules.Set(ctx, denom, schedule)
+	}
+
+	if genesis.FeeRecipient != "" {
+		_ = k.ConfiguredFeeRecipient.Set(ctx, genesis.FeeRecipient)
+	}
+
+	for channel, total := range genesis.TotalFees {
+		_ = k.TotalFees.Set(ctx, channel, total)
+	}
+
+	for channel, fee := range genesis.RelayerFees {
+		_ = k.RelayerFees.Set(ctx, channel, fee)
+	}
+
+	_ = k.ConfiguredGracePeriod.Set(ctx, genesis.FallbackGracePeriod)
+
+	for _, blocked := range genesis.BlockedForwards {
+		k.SetBlockedForward(ctx, blocked)
+	}
+
+	for _, channel := range genesis.PausedChannels {
+		_ = k.ChannelPauses.Set(ctx, channel.Channel, channel.RejectRegistrations)
+	}
+
+	_ = k.ConfiguredCircuitBreaker.Set(ctx, genesis.CircuitBreaker)
+	_ = k.ConfiguredGuardian.Set(ctx, genesis.Guardian)
+
+	for _, limit := range genesis.RateLimits {
+		_ = k.ChannelRateLimits.Set(ctx, collections.Join(limit.Channel, limit.Denom), limit)
+	}
+
+	for _, usage := range genesis.RateLimitUsages {
+		_ = k.RateLimitUsages.Set(ctx, collections.Join(usage.Channel, usage.Denom), usage)
+	}
+
+	for _, deposit := range genesis.HeldDeposits {
+		k.SetHeldDeposit(ctx, deposit)
+	}
+
+	for _, batch := range genesis.Batches {
+		k.SetBatch(ctx, batch)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		AllowedDenoms:  k.GetAllowedDenoms(ctx),
-		NumOfAccounts:  k.GetAllNumOfAccounts(ctx),
-		NumOfForwards:  k.GetAllNumOfForwards(ctx),
-		TotalForwarded: k.GetAllTotalForwarded(ctx),
+		AllowedDenoms:        k.GetAllowedDenoms(ctx),
+		NumOfAccounts:        k.GetAllNumOfAccounts(ctx),
+		NumOfForwards:        k.GetAllNumOfForwards(ctx),
+		TotalForwarded:       k.GetAllTotalForwarded(ctx),
+		RefundPolicy:         k.GetRefundPolicy(ctx),
+		TimeoutPolicies:      k.GetAllTimeoutPolicies(ctx),
+		MaxMemoLength:        k.GetMaxMemoLength(ctx),
+		MinForwardAmounts:    k.GetAllMinForwardAmounts(ctx),
+		NumOfDeferrals:       k.GetAllNumOfDeferrals(ctx),
+		ExecutionLimits:      k.GetExecutionLimits(ctx),
+		ForwardQueue:         k.GetQueuedForwards(ctx),
+		FeeSchedules:         k.GetAllFeeSchedules(ctx),
+		FeeRecipient:         k.GetFeeRecipient(ctx),
+		TotalFees:            k.GetAllTotalFees(ctx),
+		RelayerFees:          k.GetAllRelayerFees(ctx),
+		FallbackGracePeriod:  k.GetFallbackGracePeriod(ctx),
+		BlockedForwards:      k.GetAllBlockedForwards(ctx),
+		PausedChannels:       k.GetPausedChannels(ctx),
+		CircuitBreaker:       k.IsCircuitBreakerEnabled(ctx),
+		Guardian:             k.GetGuardian(ctx),
+		RateLimits:           k.GetAllRateLimits(ctx),
+		RateLimitUsages:      k.GetAllRateLimitUsages(ctx),
+		HeldDeposits:         k.GetAllHeldDeposits(ctx),
+		Batches:              k.GetAllBatches(ctx),
+		BlockedDenoms:        k.GetBlockedDenoms(ctx),
+		ChannelAllowedDenoms: k.GetAllChannelAllowedDenoms(ctx),
+		UnwindOnly:           k.IsUnwindOnlyEnabled(ctx),
+		Retries:              k.GetAllRetries(ctx),
+		InFlightPackets:      k.GetAllInFlightPackets(ctx),
 	}
 }
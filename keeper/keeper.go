@@ -22,16 +22,24 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/event"
 	"cosmossdk.io/core/header"
 	"cosmossdk.io/core/store"
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
@@ -48,11 +56,45 @@ type Keeper struct {
 
 	authority string
 
-	Schema         collections.Schema
-	AllowedDenoms  collections.KeySet[string]
-	NumOfAccounts  collections.Map[string, uint64]
-	NumOfForwards  collections.Map[string, uint64]
-	TotalForwarded collections.Map[string, string]
+	Schema               collections.Schema
+	AllowedDenoms        collections.KeySet[string]
+	BlockedDenoms        collections.KeySet[string]
+	ChannelAllowedDenoms collections.KeySet[collections.Pair[string, string]]
+	// NOTE: The allowed denom patterns index the entries of the allowed denoms
+	// that match by prefix or denom trace, so that exact entries don't have
+	// to be iterated when checking a denom.
+	AllowedDenomPatterns        collections.KeySet[string]
+	ChannelAllowedDenomPatterns collections.KeySet[collections.Pair[string, string]]
+	ConfiguredUnwindOnly        collections.Item[bool]
+	NumOfAccounts               collections.Map[string, uint64]
+	NumOfForwards               collections.Map[string, uint64]
+	TotalForwarded              collections.Map[string, string]
+	RetryQueue                  *collections.IndexedMap[string, types.ForwardRetry, RetryIndexes]
+	InFlightPackets             collections.Map[collections.Pair[string, uint64], string]
+	ConfiguredRefundPolicy      collections.Item[int32]
+	ConfiguredTimeoutPolicies   collections.Map[string, types.TimeoutPolicy]
+	ConfiguredMaxMemoLength     collections.Item[uint64]
+	MinForwardAmounts           collections.Map[string, sdkmath.Int]
+	NumOfDeferrals              collections.Map[collections.Pair[string, int32], uint64]
+	ConfiguredExecutionLimits   collections.Item[types.ExecutionLimits]
+	ForwardQueue                collections.Map[collections.Pair[string, uint64], string]
+	ForwardQueueSequence        collections.Sequence
+	QueuedForwards              collections.KeySet[string]
+	ForwardQueueLengths         collections.Map[string, uint64]
+	ForwardQueueDepth           collections.Item[uint64]
+	FeeSchedules                collections.Map[string, types.FeeSchedule]
+	ConfiguredFeeRecipient      collections.Item[string]
+	TotalFees                   collections.Map[string, string]
+	RelayerFees                 collections.Map[string, types.RelayerFee]
+	ConfiguredGracePeriod       collections.Item[uint64]
+	BlockedAccounts             *collections.IndexedMap[string, types.BlockedForward, BlockedIndexes]
+	ChannelPauses               collections.Map[string, bool]
+	ConfiguredCircuitBreaker    collections.Item[bool]
+	ConfiguredGuardian          collections.Item[string]
+	ChannelRateLimits           collections.Map[collections.Pair[string, string], types.RateLimit]
+	RateLimitUsages             collections.Map[collections.Pair[string, string], types.RateLimitUsage]
+	DepositHolds                *collections.IndexedMap[collections.Pair[string, int64], types.HeldDeposit, HeldIndexes]
+	Batches                     *collections.IndexedMap[string, types.Batch, BatchIndexes]
 
 	TransientSchema collections.Schema
 	PendingForwards collections.Map[string, types.ForwardingAccount]
@@ -61,6 +103,10 @@ type Keeper struct {
 	bankKeeper     types.BankKeeper
 	channelKeeper  types.ChannelKeeper
 	transferKeeper types.TransferKeeper
+	feeKeeper      types.FeeKeeper
+
+	hooks   types.ForwardingHooks
+	routers map[string]types.Router
 }
 
 func NewKeeper(
@@ -75,7 +121,12 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	channelKeeper types.ChannelKeeper,
 	transferKeeper types.TransferKeeper,
+	hooks types.ForwardingHooks,
 ) *Keeper {
+	if hooks == nil {
+		hooks = types.MultiForwardingHooks{}
+	}
+
 	builder := collections.NewSchemaBuilder(storeService)
 	transientBuilder := collections.NewSchemaBuilderFromAccessor(transientService.OpenTransientStore)
 
@@ -89,10 +140,41 @@ func NewKeeper(
 
 		authority: authority,
 
-		AllowedDenoms:  collections.NewKeySet(builder, types.AllowedDenomsPrefix, "allowed_denoms", collections.StringKey),
-		NumOfAccounts:  collections.NewMap(builder, types.NumOfAccountsPrefix, "num_of_accounts", collections.StringKey, collections.Uint64Value),
-		NumOfForwards:  collections.NewMap(builder, types.NumOfForwardsPrefix, "num_of_forwards", collections.StringKey, collections.Uint64Value),
-		TotalForwarded: collections.NewMap(builder, types.TotalForwardedPrefix, "total_forwarded", collections.StringKey, collections.StringValue),
+		AllowedDenoms:               collections.NewKeySet(builder, types.AllowedDenomsPrefix, "allowed_denoms", collections.StringKey),
+		BlockedDenoms:               collections.NewKeySet(builder, types.BlockedDenomsPrefix, "blocked_denoms", collections.StringKey),
+		ChannelAllowedDenoms:        collections.NewKeySet(builder, types.ChannelAllowedDenomsPrefix, "channel_allowed_denoms", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		AllowedDenomPatterns:        collections.NewKeySet(builder, types.AllowedDenomPatternsPrefix, "allowed_denom_patterns", collections.StringKey),
+		ChannelAllowedDenomPatterns: collections.NewKeySet(builder, types.ChannelAllowedDenomPatternsPrefix, "channel_allowed_denom_patterns", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ConfiguredUnwindOnly:        collections.NewItem(builder, types.UnwindOnlyKey, "unwind_only", collections.BoolValue),
+		NumOfAccounts:               collections.NewMap(builder, types.NumOfAccountsPrefix, "num_of_accounts", collections.StringKey, collections.Uint64Value),
+		NumOfForwards:               collections.NewMap(builder, types.NumOfForwardsPrefix, "num_of_forwards", collections.StringKey, collections.Uint64Value),
+		TotalForwarded:              collections.NewMap(builder, types.TotalForwardedPrefix, "total_forwarded", collections.StringKey, collections.StringValue),
+		RetryQueue:                  collections.NewIndexedMap(builder, types.RetryQueuePrefix, "retry_queue", collections.StringKey, codec.CollValue[types.ForwardRetry](cdc), NewRetryIndexes(builder)),
+		InFlightPackets:             collections.NewMap(builder, types.InFlightPacketsPrefix, "in_flight_packets", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringValue),
+		ConfiguredRefundPolicy:      collections.NewItem(builder, types.RefundPolicyKey, "refund_policy", collections.Int32Value),
+		ConfiguredTimeoutPolicies:   collections.NewMap(builder, types.TimeoutPoliciesPrefix, "timeout_policies", collections.StringKey, codec.CollValue[types.TimeoutPolicy](cdc)),
+		ConfiguredMaxMemoLength:     collections.NewItem(builder, types.MaxMemoLengthKey, "max_memo_length", collections.Uint64Value),
+		MinForwardAmounts:           collections.NewMap(builder, types.MinForwardAmountsPrefix, "min_forward_amounts", collections.StringKey, sdk.IntValue),
+		NumOfDeferrals:              collections.NewMap(builder, types.NumOfDeferralsPrefix, "num_of_deferrals", collections.PairKeyCodec(collections.StringKey, collections.Int32Key), collections.Uint64Value),
+		ConfiguredExecutionLimits:   collections.NewItem(builder, types.ExecutionLimitsKey, "execution_limits", codec.CollValue[types.ExecutionLimits](cdc)),
+		ForwardQueue:                collections.NewMap(builder, types.ForwardQueuePrefix, "forward_queue", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), collections.StringValue),
+		ForwardQueueSequence:        collections.NewSequence(builder, types.ForwardQueueSequenceKey, "forward_queue_sequence"),
+		QueuedForwards:              collections.NewKeySet(builder, types.QueuedForwardsPrefix, "queued_forwards", collections.StringKey),
+		ForwardQueueLengths:         collections.NewMap(builder, types.QueueLengthsPrefix, "queue_lengths", collections.StringKey, collections.Uint64Value),
+		ForwardQueueDepth:           collections.NewItem(builder, types.QueueDepthKey, "queue_depth", collections.Uint64Value),
+		FeeSchedules:                collections.NewMap(builder, types.FeeSchedulesPrefix, "fee_schedules", collections.StringKey, codec.CollValue[types.FeeSchedule](cdc)),
+		ConfiguredFeeRecipient:      collections.NewItem(builder, types.FeeRecipientKey, "fee_recipient", collections.StringValue),
+		TotalFees:                   collections.NewMap(builder, types.TotalFeesPrefix, "total_fees", collections.StringKey, collections.StringValue),
+		RelayerFees:                 collections.NewMap(builder, types.RelayerFeesPrefix, "relayer_fees", collections.StringKey, codec.CollValue[types.RelayerFee](cdc)),
+		ConfiguredGracePeriod:       collections.NewItem(builder, types.FallbackGracePeriodKey, "fallback_grace_period", collections.Uint64Value),
+		BlockedAccounts:             collections.NewIndexedMap(builder, types.BlockedForwardsPrefix, "blocked_forwards", collections.StringKey, codec.CollValue[types.BlockedForward](cdc), NewBlockedIndexes(builder)),
+		ChannelPauses:               collections.NewMap(builder, types.PausedChannelsPrefix, "paused_channels", collections.StringKey, collections.BoolValue),
+		ConfiguredCircuitBreaker:    collections.NewItem(builder, types.CircuitBreakerKey, "circuit_breaker", collections.BoolValue),
+		ConfiguredGuardian:          collections.NewItem(builder, types.GuardianKey, "guardian", collections.StringValue),
+		ChannelRateLimits:           collections.NewMap(builder, types.RateLimitsPrefix, "rate_limits", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RateLimit](cdc)),
+		RateLimitUsages:             collections.NewMap(builder, types.RateLimitUsagesPrefix, "rate_limit_usages", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RateLimitUsage](cdc)),
+		DepositHolds:                collections.NewIndexedMap(builder, types.HeldDepositsPrefix, "held_deposits", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.HeldDeposit](cdc), NewHeldIndexes(builder)),
+		Batches:                     collections.NewIndexedMap(builder, types.BatchesPrefix, "batches", collections.StringKey, codec.CollValue[types.Batch](cdc), NewBatchIndexes(builder)),
 
 		PendingForwards: collections.NewMap(transientBuilder, types.PendingForwardsPrefix, "pending_forwards", collections.StringKey, codec.CollValue[types.ForwardingAccount](cdc)),
 
@@ -100,6 +182,13 @@ func NewKeeper(
 		bankKeeper:     bankKeeper,
 		channelKeeper:  channelKeeper,
 		transferKeeper: transferKeeper,
+
+		hooks: hooks,
+	}
+
+	keeper.routers = map[string]types.Router{
+		types.DestinationTypeIBC:   IBCRouter{keeper: keeper},
+		types.DestinationTypeLocal: LocalRouter{keeper: keeper},
 	}
 
 	schema, err := builder.Build()
@@ -116,65 +205,957 @@ func NewKeeper(
 	return keeper
 }
 
-// IsAllowedDenom checks if a specific denom is allowed to be forwarded.
-func (k *Keeper) IsAllowedDenom(ctx context.Context, denom string) bool {
-	has, _ := k.AllowedDenoms.Has(ctx, "*")
-	if has {
+// RetryIndexes defines the indexes of the retry queue, allowing retries that
+// are due to be efficiently iterated by block height.
+type RetryIndexes struct {
+	NextAttempt *indexes.Multi[int64, string, types.ForwardRetry]
+}
+
+func NewRetryIndexes(builder *collections.SchemaBuilder) RetryIndexes {
+	return RetryIndexes{
+		NextAttempt: indexes.NewMulti(
+			builder, types.RetryQueueByNextAttemptPrefix, "retry_queue_by_next_attempt",
+			collections.Int64Key, collections.StringKey,
+			func(_ string, retry types.ForwardRetry) (int64, error) {
+				return retry.NextAttempt, nil
+			},
+		),
+	}
+}
+
+func (i RetryIndexes) IndexesList() []collections.Index[string, types.ForwardRetry] {
+	return []collections.Index[string, types.ForwardRetry]{i.NextAttempt}
+}
+
+// BlockedIndexes defines the indexes of the blocked forwards, allowing them to
+// be efficiently iterated by the time they were first blocked, and by channel.
+type BlockedIndexes struct {
+	BlockedSince *indexes.Multi[int64, string, types.BlockedForward]
+	Channel      *indexes.Multi[string, string, types.BlockedForward]
+}
+
+func NewBlockedIndexes(builder *collections.SchemaBuilder) BlockedIndexes {
+	return BlockedIndexes{
+		BlockedSince: indexes.NewMulti(
+			builder, types.BlockedBySincePrefix, "blocked_forwards_by_since",
+			collections.Int64Key, collections.StringKey,
+			func(_ string, blocked types.BlockedForward) (int64, error) {
+				return blocked.BlockedSince, nil
+			},
+		),
+		Channel: indexes.NewMulti(
+			builder, types.BlockedByChannelPrefix, "blocked_forwards_by_channel",
+			collections.StringKey, collections.StringKey,
+			func(_ string, blocked types.BlockedForward) (string, error) {
+				return blocked.Channel, nil
+			},
+		),
+	}
+}
+
+func (i BlockedIndexes) IndexesList() []collections.Index[string, types.BlockedForward] {
+	return []collections.Index[string, types.BlockedForward]{i.BlockedSince, i.Channel}
+}
+
+// HeldIndexes defines the indexes of the held deposits, allowing deposits that
+// have become eligible to be efficiently iterated by time.
+type HeldIndexes struct {
+	EligibleAt *indexes.Multi[int64, collections.Pair[string, int64], types.HeldDeposit]
+}
+
+func NewHeldIndexes(builder *collections.SchemaBuilder) HeldIndexes {
+	return HeldIndexes{
+		EligibleAt: indexes.NewMulti(
+			builder, types.HeldByEligibilityPrefix, "held_deposits_by_eligibility",
+			collections.Int64Key, collections.PairKeyCodec(collections.StringKey, collections.Int64Key),
+			func(_ collections.Pair[string, int64], deposit types.HeldDeposit) (int64, error) {
+				return deposit.EligibleAt, nil
+			},
+		),
+	}
+}
+
+func (i HeldIndexes) IndexesList() []collections.Index[collections.Pair[string, int64], types.HeldDeposit] {
+	return []collections.Index[collections.Pair[string, int64], types.HeldDeposit]{i.EligibleAt}
+}
+
+// BatchIndexes defines the indexes of the batches, allowing batches that have
+// reached their maximum wait to be efficiently iterated by time.
+type BatchIndexes struct {
+	ForwardAt *indexes.Multi[int64, string, types.Batch]
+}
+
+func NewBatchIndexes(builder *collections.SchemaBuilder) BatchIndexes {
+	return BatchIndexes{
+		ForwardAt: indexes.NewMulti(
+			builder, types.BatchesByForwardAtPrefix, "batches_by_forward_at",
+			collections.Int64Key, collections.StringKey,
+			func(_ string, batch types.Batch) (int64, error) {
+				return batch.ForwardAt, nil
+			},
+		),
+	}
+}
+
+func (i BatchIndexes) IndexesList() []collections.Index[string, types.Batch] {
+	return []collections.Index[string, types.Batch]{i.ForwardAt}
+}
+
+// IsAllowedDenom checks if a specific denom is allowed to be forwarded
+// through a specific channel. Channels with their own allowed denoms only
+// allow these, while all other channels allow the globally allowed denoms.
+// Blocked denoms are never allowed, even if all denoms are allowed.
+func (k *Keeper) IsAllowedDenom(ctx context.Context, channel string, denom string) bool {
+	if blocked, _ := k.BlockedDenoms.Has(ctx, denom); blocked {
+		return false
+	}
+
+	if k.HasChannelAllowedDenoms(ctx, channel) {
+		has := func(entry string) bool {
+			found, _ := k.ChannelAllowedDenoms.Has(ctx, collections.Join(channel, entry))
+			return found
+		}
+
+		return k.matchesAllowedDenoms(ctx, has, k.GetChannelAllowedDenomPatterns(ctx, channel), denom)
+	}
+
+	has := func(entry string) bool {
+		found, _ := k.AllowedDenoms.Has(ctx, entry)
+		return found
+	}
+
+	return k.matchesAllowedDenoms(ctx, has, k.GetAllowedDenomPatterns(ctx), denom)
+}
+
+// matchesAllowedDenoms checks if a specific denom is allowed by a list of
+// allowed denoms, either exactly or by the wildcard, which are looked up
+// directly, or by one of the prefix or trace patterns of the list.
+func (k *Keeper) matchesAllowedDenoms(ctx context.Context, has func(entry string) bool, patterns []string, denom string) bool {
+	if has("*") || has(denom) {
 		return true
 	}
+	if len(patterns) == 0 {
+		return false
+	}
 
-	has, _ = k.AllowedDenoms.Has(ctx, denom)
-	return has
+	trace := k.getDenomTrace(ctx, denom)
+	for _, pattern := range patterns {
+		if types.MatchesDenomPattern(pattern, denom, trace) {
+			return true
+		}
+	}
+
+	return false
 }
 
-// ExecuteForwards is an end block hook that clears all pending forwards from transient state.
+// isUnwindingDenom checks if forwarding a specific denom from a forwarding
+// account doesn't create multi-hop vouchers, when unwind-only mode is enabled
+// for the account or module-wide. If any channel that the denom is forwarded
+// through doesn't unwind the denom, it is returned.
+func (k *Keeper) isUnwindingDenom(ctx context.Context, forward types.ForwardingAccount, denom string) (string, bool) {
+	if !forward.UnwindOnly && !k.IsUnwindOnlyEnabled(ctx) {
+		return "", true
+	}
+
+	trace := k.getDenomTrace(ctx, denom)
+	for _, route := range forward.Routes(denom) {
+		if !types.IsUnwinding(denom, trace, route.Channel) {
+			return route.Channel, false
+		}
+	}
+
+	return "", true
+}
+
+// rejectVoucher handles an IBC voucher of a forwarding account that can't be
+// forwarded in unwind-only mode, by sending it to the fallback address of the
+// account if one exists. Otherwise, the voucher is left in the account to be
+// cleared.
+func (k *Keeper) rejectVoucher(ctx context.Context, forward types.ForwardingAccount, channel string, balance sdk.Coin) {
+	fallback := forward.Fallback
+	if fallback != "" {
+		if err := k.sendToFallback(ctx, forward.Address, sdk.NewCoins(balance)); err != nil {
+			k.Logger().Error("unable to send rejected voucher to fallback", "channel", channel, "address", forward.Address, "amount", balance.String(), "err", err)
+			fallback = ""
+		}
+	}
+
+	err := k.eventService.EventManager(ctx).Emit(ctx, &types.VoucherRejected{
+		Address:  forward.Address,
+		Channel:  channel,
+		Amount:   balance,
+		Fallback: fallback,
+	})
+	if err != nil {
+		k.Logger().Error("unable to emit voucher rejected event", "channel", channel, "address", forward.Address, "err", err)
+	}
+}
+
+// getDenomTrace returns the denom trace of an IBC voucher, as resolved by the
+// transfer module. An empty trace is returned for all other denoms.
+func (k *Keeper) getDenomTrace(ctx context.Context, denom string) transfertypes.DenomTrace {
+	rawHash, found := strings.CutPrefix(denom, "ibc/")
+	if !found {
+		return transfertypes.DenomTrace{}
+	}
+
+	hash, err := transfertypes.ParseHexHash(rawHash)
+	if err != nil {
+		return transfertypes.DenomTrace{}
+	}
+
+	trace, _ := k.transferKeeper.GetDenomTrace(sdk.UnwrapSDKContext(ctx), hash)
+	return trace
+}
+
+// isForwardableDenom checks if a specific denom is forwarded by a forwarding
+// account, as it is both allowed by the account itself, and by the module on
+// every channel that the denom is forwarded through.
+func (k *Keeper) isForwardableDenom(ctx context.Context, forward types.ForwardingAccount, denom string) bool {
+	if !forward.AcceptsDenom(denom) {
+		return false
+	}
+
+	// NOTE: Denoms forwarded to non IBC destinations are only checked against
+	// the module-wide allowed and blocked denoms.
+	if !forward.IsIBC() {
+		return k.IsAllowedDenom(ctx, "", denom)
+	}
+
+	for _, route := range forward.Routes(denom) {
+		if !k.IsAllowedDenom(ctx, route.Channel, denom) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ExecuteForwards is an end block hook that executes all queued forwards from
+// previous blocks, clears all pending forwards from transient state, and
+// retries any previously failed forwards that are due. Forwards that exceed
+// the execution limits of the current block are queued for later blocks.
+// Deposits into scheduled accounts are held until they become eligible, and
+// funds of accounts with batching accumulate until their batch is released.
 func (k *Keeper) ExecuteForwards(ctx context.Context) {
-	forwards := k.GetPendingForwards(ctx)
+	// NOTE: New deposits must be held before releasing due deposits, so that
+	// released amounts aren't mistaken for new deposits.
+	forwards := k.holdDeposits(ctx, k.GetPendingForwards(ctx))
+	forwards = append(forwards, k.releaseHeldDeposits(ctx)...)
+	forwards = k.batchForwards(ctx, forwards)
+
+	if k.IsCircuitBreakerEnabled(ctx) {
+		// While the circuit breaker is enabled, pending forwards are moved
+		// into the persistent queue, so that they are executed in order once
+		// the circuit breaker has been lifted.
+		for _, forward := range forwards {
+			k.EnqueueForward(ctx, forward.Address)
+		}
+
+		telemetry.SetGauge(float32(k.GetQueueDepth(ctx)), types.ModuleName, "forward_queue_depth")
+		return
+	}
+
+	budget := &forwardBudget{limits: k.GetExecutionLimits(ctx)}
+	executed := make(map[string]bool)
+
+	k.executeQueuedForwards(ctx, budget, executed)
+
 	if len(forwards) > 0 {
 		k.Logger().Info(fmt.Sprintf("executing %d automatic forward(s)", len(forwards)))
 	}
 
 	for _, forward := range forwards {
-		channel, _ := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, forward.Channel)
-		if channel.State != channeltypes.OPEN {
-			k.Logger().Error("skipped automatic forward due to non open channel", "channel", forward.Channel, "address", forward.GetAddress().String(), "state", channel.State.String())
+		if executed[forward.Address] || k.IsQueuedForward(ctx, forward.Address) {
+			continue
+		}
+
+		if budget.Exhausted() || k.isPausedForward(ctx, forward) {
+			k.EnqueueForward(ctx, forward.Address)
+			continue
+		}
+
+		if k.queueRateLimitedForward(ctx, forward) {
+			continue
+		}
+
+		k.runForward(ctx, budget, forward)
+		executed[forward.Address] = true
+	}
+
+	k.executeRetries(ctx, budget, executed)
+
+	k.sweepBlockedForwards(ctx)
+
+	telemetry.SetGauge(float32(k.GetQueueDepth(ctx)), types.ModuleName, "forward_queue_depth")
+
+	// NOTE: As pending forwards are stored in transient state, they are automatically cleared at the end of the block lifecycle. No further action is required.
+}
+
+// forwardBudget tracks the forwards executed in the current block, against
+// the configured execution limits.
+type forwardBudget struct {
+	limits   types.ExecutionLimits
+	forwards uint64
+	gas      uint64
+}
+
+// Exhausted returns true if no further forwards can be executed in the
+// current block.
+func (b *forwardBudget) Exhausted() bool {
+	if b.limits.MaxForwardsPerBlock != 0 && b.forwards >= b.limits.MaxForwardsPerBlock {
+		return true
+	}
+
+	return b.limits.MaxGasPerBlock != 0 && b.gas >= b.limits.MaxGasPerBlock
+}
+
+// runForward executes a forward, recording it against the budget of the
+// current block, and updates the retry queue accordingly.
+func (k *Keeper) runForward(ctx context.Context, budget *forwardBudget, forward types.ForwardingAccount) {
+	gasMeter := sdk.UnwrapSDKContext(ctx).GasMeter()
+	gasBefore := gasMeter.GasConsumed()
+
+	if err := k.executeForward(ctx, forward); err != nil {
+		k.SetRetry(ctx, forward.Address, err)
+	} else {
+		k.RemoveRetry(ctx, forward.Address)
+	}
+
+	budget.forwards++
+	budget.gas += gasMeter.GasConsumed() - gasBefore
+}
+
+// executeQueuedForwards executes forwards queued in previous blocks, in the
+// order that they were queued, until the budget of the current block is
+// exhausted. Forwards are queued per channel, or destination type, so that
+// the queue of a blocked channel is skipped without scanning it.
+func (k *Keeper) executeQueuedForwards(ctx context.Context, budget *forwardBudget, executed map[string]bool) {
+	heads := make(map[string]QueuedForward)
+	for _, key := range k.GetQueueKeys(ctx) {
+		// NOTE: Queues of paused channels are skipped without reading them.
+		if k.IsPausedChannel(ctx, key) {
+			continue
+		}
+
+		if head, found := k.GetNextQueuedForward(ctx, key); found {
+			heads[key] = head
+		}
+	}
+
+	for !budget.Exhausted() && len(heads) > 0 {
+		var head QueuedForward
+		for _, candidate := range heads {
+			if head.Address == "" || candidate.Sequence < head.Sequence {
+				head = candidate
+			}
+		}
+
+		account, found := k.getForwardingAccount(ctx, head.Address)
+		// NOTE: Forwards onto a channel that was paused after they were
+		// queued are moved into the queue of that channel, keeping their
+		// position, so that they don't block the rest of their queue.
+		if channel, paused := k.getPausedChannel(ctx, account); found && paused && channel != head.Key {
+			k.MoveQueuedForward(ctx, head, channel)
+			k.advanceQueue(ctx, heads, head.Key)
+			continue
+		}
+		// NOTE: Forwards exceeding a rate limit remain queued, in order,
+		// until enough of the quota is available again. The rest of their
+		// queue is skipped in the current block.
+		if _, _, _, exceeded := k.exceededRateLimit(ctx, account); found && exceeded {
+			delete(heads, head.Key)
+			continue
+		}
+		k.DequeueForward(ctx, head)
+		k.advanceQueue(ctx, heads, head.Key)
+
+		if !found || executed[head.Address] {
+			continue
+		}
+
+		k.runForward(ctx, budget, account)
+		executed[head.Address] = true
+	}
+}
+
+// advanceQueue replaces the head of a forward queue with its next forward,
+// or removes it if the queue is empty.
+func (k *Keeper) advanceQueue(ctx context.Context, heads map[string]QueuedForward, key string) {
+	if next, found := k.GetNextQueuedForward(ctx, key); found {
+		heads[key] = next
+	} else {
+		delete(heads, key)
+	}
+}
+
+// holdDeposits holds new deposits into scheduled forwarding accounts until
+// they become eligible to be forwarded, returning all pending forwards that
+// can be executed in the current block.
+func (k *Keeper) holdDeposits(ctx context.Context, forwards []types.ForwardingAccount) (eligible []types.ForwardingAccount) {
+	now := k.headerService.GetHeaderInfo(ctx).Time.UnixNano()
+
+	for _, forward := range forwards {
+		eligibleAt := forward.Schedule.EligibleAt(now)
+		if forward.Schedule.IsZero() || eligibleAt <= now {
+			eligible = append(eligible, forward)
 			continue
 		}
 
-		balances := k.bankKeeper.GetAllBalances(ctx, forward.GetAddress())
+		deposit, _ := k.GetHeldDeposit(ctx, forward.Address, eligibleAt)
+		deposit.Address, deposit.EligibleAt = forward.Address, eligibleAt
 
-		for _, balance := range balances {
-			if !k.IsAllowedDenom(ctx, balance.Denom) {
+		// NOTE: Any allowed balance that isn't already held was deposited in
+		// the current block.
+		amount := sdk.NewCoins()
+		held := k.GetHeldAmount(ctx, forward.Address)
+		for _, balance := range k.bankKeeper.GetAllBalances(ctx, forward.GetAddress()) {
+			if !k.isForwardableDenom(ctx, forward, balance.Denom) {
 				continue
 			}
 
-			timeout := uint64(k.headerService.GetHeaderInfo(ctx).Time.UnixNano()) + transfertypes.DefaultRelativePacketTimeoutTimestamp
-			msg := &transfertypes.MsgTransfer{
-				SourcePort:       transfertypes.PortID,
-				SourceChannel:    forward.Channel,
-				Token:            balance,
-				Sender:           forward.Address,
-				Receiver:         forward.Recipient,
-				TimeoutHeight:    clienttypes.ZeroHeight(),
-				TimeoutTimestamp: timeout,
-				Memo:             "",
+			if unheld := balance.Amount.Sub(held.AmountOf(balance.Denom)); unheld.IsPositive() {
+				amount = amount.Add(sdk.NewCoin(balance.Denom, unheld))
 			}
-			if err := msg.ValidateBasic(); err != nil {
-				k.Logger().Error("ibc message validation failed", "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", err)
+		}
+
+		if amount.IsZero() {
+			if deposit.Amount.IsZero() {
+				k.RemoveHeldDeposit(ctx, forward.Address, eligibleAt)
+			}
+			continue
+		}
+
+		deposit.Amount = deposit.Amount.Add(amount...)
+		k.SetHeldDeposit(ctx, deposit)
+
+		err := k.eventService.EventManager(ctx).Emit(ctx, &types.DepositHeld{
+			Address:    forward.Address,
+			Amount:     amount,
+			EligibleAt: eligibleAt,
+		})
+		if err != nil {
+			k.Logger().Error("unable to emit deposit held event", "channel", forward.Channel, "address", forward.Address, "err", err)
+		}
+	}
+
+	return
+}
+
+// releaseHeldDeposits releases all held deposits that have become eligible to
+// be forwarded, returning the forwarding accounts that they were held in.
+func (k *Keeper) releaseHeldDeposits(ctx context.Context) (released []types.ForwardingAccount) {
+	seen := make(map[string]bool)
+
+	for _, deposit := range k.GetDueHeldDeposits(ctx, k.headerService.GetHeaderInfo(ctx).Time.UnixNano()) {
+		k.RemoveHeldDeposit(ctx, deposit.Address, deposit.EligibleAt)
+
+		account, found := k.getForwardingAccount(ctx, deposit.Address)
+		if !found || deposit.Amount.IsZero() {
+			continue
+		}
+
+		err := k.eventService.EventManager(ctx).Emit(ctx, &types.DepositReleased{
+			Address: deposit.Address,
+			Amount:  deposit.Amount,
+		})
+		if err != nil {
+			k.Logger().Error("unable to emit deposit released event", "channel", account.Channel, "address", deposit.Address, "err", err)
+		}
+
+		if !seen[deposit.Address] {
+			released = append(released, account)
+			seen[deposit.Address] = true
+		}
+	}
+
+	return
+}
+
+// batchForwards accumulates the funds of forwarding accounts with batching
+// until any of their targets is reached, or their maximum wait has passed,
+// returning all forwards that can be executed in the current block.
+func (k *Keeper) batchForwards(ctx context.Context, forwards []types.ForwardingAccount) (ready []types.ForwardingAccount) {
+	now := k.headerService.GetHeaderInfo(ctx).Time.UnixNano()
+	seen := make(map[string]bool)
+
+	for _, forward := range forwards {
+		if forward.Batching.IsZero() {
+			ready = append(ready, forward)
+			continue
+		}
+		if seen[forward.Address] {
+			continue
+		}
+
+		if forward.Batching.ReachedTarget(k.getForwardableBalances(ctx, forward)) {
+			k.releaseBatch(ctx, forward.Address, true)
+			ready = append(ready, forward)
+			seen[forward.Address] = true
+			continue
+		}
+
+		if _, found := k.GetBatch(ctx, forward.Address); found {
+			continue
+		}
+
+		batch := types.Batch{
+			Address:   forward.Address,
+			StartedAt: now,
+			ForwardAt: now + int64(forward.Batching.MaxWait),
+		}
+		k.SetBatch(ctx, batch)
+
+		err := k.eventService.EventManager(ctx).Emit(ctx, &types.BatchStarted{
+			Address:   batch.Address,
+			ForwardAt: batch.ForwardAt,
+		})
+		if err != nil {
+			k.Logger().Error("unable to emit batch started event", "channel", forward.Channel, "address", forward.Address, "err", err)
+		}
+	}
+
+	for _, batch := range k.GetDueBatches(ctx, now) {
+		k.releaseBatch(ctx, batch.Address, false)
+
+		account, found := k.getForwardingAccount(ctx, batch.Address)
+		if !found || seen[batch.Address] {
+			continue
+		}
+
+		ready = append(ready, account)
+		seen[batch.Address] = true
+	}
+
+	return
+}
+
+// releaseBatch stops accumulating the funds of a forwarding account with
+// batching, so that they are forwarded.
+func (k *Keeper) releaseBatch(ctx context.Context, address string, targetReached bool) {
+	if _, found := k.GetBatch(ctx, address); !found {
+		return
+	}
+	k.RemoveBatch(ctx, address)
+
+	err := k.eventService.EventManager(ctx).Emit(ctx, &types.BatchReleased{
+		Address:       address,
+		TargetReached: targetReached,
+	})
+	if err != nil {
+		k.Logger().Error("unable to emit batch released event", "address", address, "err", err)
+	}
+}
+
+// getForwardableBalances returns the balances of a forwarding account,
+// excluding any deposits that are held until a later time.
+func (k *Keeper) getForwardableBalances(ctx context.Context, forward types.ForwardingAccount) sdk.Coins {
+	balances := k.bankKeeper.GetAllBalances(ctx, forward.GetAddress())
+	if forward.Schedule.IsZero() {
+		return balances
+	}
+
+	held := k.GetHeldAmount(ctx, forward.Address)
+	forwardable := sdk.NewCoins()
+	for _, balance := range balances {
+		if amount := balance.Amount.Sub(held.AmountOf(balance.Denom)); amount.IsPositive() {
+			forwardable = forwardable.Add(sdk.NewCoin(balance.Denom, amount))
+		}
+	}
+
+	return forwardable
+}
+
+// isPausedForward returns true if any of the channels that a forwarding
+// account forwards through is paused.
+func (k *Keeper) isPausedForward(ctx context.Context, forward types.ForwardingAccount) bool {
+	_, paused := k.getPausedChannel(ctx, forward)
+	return paused
+}
+
+// getPausedChannel returns the first paused channel that a forwarding account
+// forwards through.
+func (k *Keeper) getPausedChannel(ctx context.Context, forward types.ForwardingAccount) (string, bool) {
+	for _, channel := range forward.Channels() {
+		if k.IsPausedChannel(ctx, channel) {
+			return channel, true
+		}
+	}
+
+	return "", false
+}
+
+// exceededRateLimit returns the first amount forwarded by a forwarding account
+// that would exceed the rate limit of its channel and denom if forwarded,
+// alongside the rate limit and its usage within the current window.
+func (k *Keeper) exceededRateLimit(ctx context.Context, forward types.ForwardingAccount) (sdk.Coin, types.RateLimit, types.RateLimitUsage, bool) {
+	for _, balance := range k.getForwardableBalances(ctx, forward) {
+		if _, unwinding := k.isUnwindingDenom(ctx, forward, balance.Denom); !unwinding {
+			continue
+		}
+
+		if !k.isForwardableDenom(ctx, forward, balance.Denom) || balance.Amount.LT(k.GetMinForwardAmount(ctx, balance.Denom)) {
+			continue
+		}
+		// NOTE: Balances that can never fit within a window are deferred when
+		// the forward is executed, so that they don't block the queue.
+		if k.aboveRateLimit(ctx, forward, balance) {
+			continue
+		}
+
+		channels, amounts := channelAmounts(forward, balance)
+		for _, channel := range channels {
+			limit, found := k.GetRateLimit(ctx, channel, balance.Denom)
+			if !found {
 				continue
 			}
-			_, err := k.transferKeeper.Transfer(ctx, msg)
-			if err != nil {
-				// TODO: Consider moving to persistent store in order to retry in future blocks?
-				k.Logger().Error("unable to execute automatic forward", "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", err)
-			} else {
-				k.IncrementNumOfForwards(ctx, forward.Channel)
-				k.IncrementTotalForwarded(ctx, forward.Channel, balance)
+
+			usage := k.GetRateLimitUsage(ctx, channel, balance.Denom)
+			if usage.Amount.Add(amounts[channel]).GT(limit.Amount) {
+				return sdk.NewCoin(balance.Denom, amounts[channel]), limit, usage, true
 			}
 		}
 	}
 
-	// NOTE: As pending forwards are stored in transient state, they are automatically cleared at the end of the block lifecycle. No further action is required.
+	return sdk.Coin{}, types.RateLimit{}, types.RateLimitUsage{}, false
+}
+
+// aboveRateLimit returns true if a balance of a forwarding account exceeds
+// the rate limit of any channel it is forwarded through, such that it can
+// never be forwarded within a single window.
+func (k *Keeper) aboveRateLimit(ctx context.Context, forward types.ForwardingAccount, balance sdk.Coin) bool {
+	channels, amounts := channelAmounts(forward, balance)
+	for _, channel := range channels {
+		if limit, found := k.GetRateLimit(ctx, channel, balance.Denom); found && amounts[channel].GT(limit.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// channelAmounts returns the amounts of a balance of a forwarding account
+// forwarded through each channel, alongside the channels in the order of the
+// splits. Shares forwarded through the same channel count towards the same
+// rate limit, so they are aggregated.
+func channelAmounts(forward types.ForwardingAccount, balance sdk.Coin) (channels []string, amounts map[string]sdkmath.Int) {
+	routes := forward.Routes(balance.Denom)
+
+	amounts = make(map[string]sdkmath.Int)
+	for i, share := range types.SplitAmount(balance.Amount, routes) {
+		channel := routes[i].Channel
+		if _, found := amounts[channel]; !found {
+			channels = append(channels, channel)
+			amounts[channel] = sdkmath.ZeroInt()
+		}
+		amounts[channel] = amounts[channel].Add(share)
+	}
+
+	return channels, amounts
+}
+
+// queueRateLimitedForward moves a forward that would exceed a rate limit into
+// the forward queue, where it remains until enough of the quota is available
+// again. It returns true if the forward was queued.
+func (k *Keeper) queueRateLimitedForward(ctx context.Context, forward types.ForwardingAccount) bool {
+	balance, limit, usage, exceeded := k.exceededRateLimit(ctx, forward)
+	if !exceeded {
+		return false
+	}
+
+	k.Logger().Info("queued automatic forward due to rate limit", "channel", limit.Channel, "address", forward.Address, "amount", balance.String())
+	k.EnqueueForward(ctx, forward.Address)
+
+	err := k.eventService.EventManager(ctx).Emit(ctx, &types.RateLimitExceeded{
+		Address: forward.Address,
+		Channel: limit.Channel,
+		Denom:   balance.Denom,
+		Amount:  balance.Amount,
+		Usage:   usage.Amount,
+		Limit:   limit.Amount,
+	})
+	if err != nil {
+		k.Logger().Error("unable to emit rate limit exceeded event", "channel", forward.Channel, "address", forward.Address, "err", err)
+	}
+
+	return true
+}
+
+// getForwardingAccount returns the forwarding account stored at an address.
+func (k *Keeper) getForwardingAccount(ctx context.Context, address string) (types.ForwardingAccount, bool) {
+	bz, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return types.ForwardingAccount{}, false
+	}
+
+	account, ok := k.accountKeeper.GetAccount(ctx, bz).(*types.ForwardingAccount)
+	if !ok {
+		return types.ForwardingAccount{}, false
+	}
+
+	return *account, true
+}
+
+// executeRetries retries failed forwards that are due in the current block,
+// until the budget of the current block is exhausted.
+func (k *Keeper) executeRetries(ctx context.Context, budget *forwardBudget, executed map[string]bool) {
+	retries := k.GetDueRetries(ctx, k.headerService.GetHeaderInfo(ctx).Height)
+	if len(retries) > 0 {
+		k.Logger().Info(fmt.Sprintf("retrying %d automatic forward(s)", len(retries)))
+	}
+
+	for _, retry := range retries {
+		// NOTE: If the account has already been attempted in this block, or
+		// is queued for a later block, it is not retried.
+		if executed[retry.Address] || k.IsQueuedForward(ctx, retry.Address) {
+			continue
+		}
+
+		// NOTE: Due retries that exceed the budget remain due in later blocks.
+		if budget.Exhausted() {
+			return
+		}
+
+		account, found := k.getForwardingAccount(ctx, retry.Address)
+		if !found {
+			k.RemoveRetry(ctx, retry.Address)
+			continue
+		}
+
+		// NOTE: Retries onto paused channels are moved to the forward queue,
+		// and executed once the channel is resumed.
+		if k.isPausedForward(ctx, account) {
+			k.RemoveRetry(ctx, retry.Address)
+			k.EnqueueForward(ctx, retry.Address)
+			continue
+		}
+
+		if k.queueRateLimitedForward(ctx, account) {
+			k.RemoveRetry(ctx, retry.Address)
+			continue
+		}
+
+		k.runForward(ctx, budget, account)
+		executed[retry.Address] = true
+	}
+}
+
+// executeForward forwards all allowed balances of a forwarding account to its
+// destination, using the router of its destination type. An error is returned
+// if any of the balances failed to be forwarded, in which case the forward
+// should be retried in a future block.
+func (k *Keeper) executeForward(ctx context.Context, forward types.ForwardingAccount) (err error) {
+	router, found := k.routers[forward.Destination()]
+	if !found {
+		k.Logger().Error("skipped automatic forward due to unknown destination type", "destination", forward.Destination(), "address", forward.GetAddress().String())
+		return fmt.Errorf("unknown destination type: %s", forward.Destination())
+	}
+
+	if channel, state, blocked := k.getBlockingChannel(ctx, forward); blocked {
+		k.Logger().Error("skipped automatic forward due to non open channel", "channel", channel, "address", forward.GetAddress().String(), "state", state.String())
+		k.handleBlockedForward(ctx, forward, channel, state)
+		return nil
+	}
+	k.RemoveBlockedForward(ctx, forward.Address)
+
+	balances := k.getForwardableBalances(ctx, forward)
+
+	for _, balance := range balances {
+		if !k.isForwardableDenom(ctx, forward, balance.Denom) {
+			continue
+		}
+
+		if channel, unwinding := k.isUnwindingDenom(ctx, forward, balance.Denom); !unwinding {
+			k.rejectVoucher(ctx, forward, channel, balance)
+			continue
+		}
+
+		coin, vetoErr := k.beforeForward(ctx, forward, balance)
+		if vetoErr != nil {
+			k.Logger().Info("automatic forward vetoed by hooks", "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", vetoErr)
+			k.deferForward(ctx, forward, balance, types.DeferReasonVetoed)
+			continue
+		}
+		balance = coin
+
+		if balance.Amount.LT(k.GetMinForwardAmount(ctx, balance.Denom)) {
+			k.deferForward(ctx, forward, balance, types.DeferReasonBelowMinimum)
+			continue
+		}
+
+		if k.aboveRateLimit(ctx, forward, balance) {
+			k.deferForward(ctx, forward, balance, types.DeferReasonAboveRateLimit)
+			continue
+		}
+
+		cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+		if forwardErr := router.Forward(cacheCtx, forward, balance); forwardErr != nil {
+			k.Logger().Error("unable to execute automatic forward", "destination", forward.Destination(), "channel", forward.Channel, "address", forward.GetAddress().String(), "amount", balance.String(), "err", forwardErr)
+			err = forwardErr
+
+			if hookErr := k.hooks.OnForwardFailed(ctx, forward, balance, forwardErr.Error()); hookErr != nil {
+				k.Logger().Error("forwarding hooks failed on failed forward", "channel", forward.Channel, "address", forward.GetAddress().String(), "err", hookErr)
+			}
+			continue
+		}
+		writeCache()
+
+		// NOTE: Forwards through IBC are recorded, and passed to the hooks,
+		// per transfer once they have been sent.
+		if !forward.IsIBC() {
+			k.IncrementNumOfForwards(ctx, forward.StatsKey())
+			k.IncrementTotalForwarded(ctx, forward.StatsKey(), balance)
+
+			if hookErr := k.hooks.AfterForward(ctx, forward, "", forward.Recipient, balance, 0); hookErr != nil {
+				k.Logger().Error("forwarding hooks failed after forward", "destination", forward.Destination(), "address", forward.GetAddress().String(), "err", hookErr)
+			}
+		}
+	}
+
+	return err
+}
+
+// forwardThroughIBC forwards a balance of a forwarding account through IBC.
+// Balances of accounts with splits are forwarded in several transfers, that
+// either all succeed or fail. Denoms with a denom route are forwarded through
+// it, without any hops.
+func (k *Keeper) forwardThroughIBC(ctx context.Context, forward types.ForwardingAccount, balance sdk.Coin) error {
+	memo := forward.Memo
+	if _, found := forward.GetDenomRoute(balance.Denom); !found {
+		var err error
+		memo, err = types.BuildForwardMemo(forward.Hops, forward.Memo)
+		if err != nil {
+			return err
+		}
+	}
+
+	// NOTE: If any share doesn't cover its fees, the entire balance is
+	// deferred, so that the balance is always split as defined. Shares that
+	// are rounded down to zero are skipped, as their amount is included in
+	// the remainder of the first share.
+	routes := forward.Routes(balance.Denom)
+	transfers := make([]*transfertypes.MsgTransfer, 0, len(routes))
+	fees := make([]sdk.Coin, 0, len(routes))
+	relayerFees := make([]types.RelayerFee, 0, len(routes))
+	belowFee := false
+	for i, share := range types.SplitAmount(balance.Amount, routes) {
+		if share.IsZero() {
+			continue
+		}
+		channel := routes[i].Channel
+		relayerFee := k.GetEscrowedRelayerFee(ctx, channel)
+
+		timeoutHeight, timeoutTimestamp, err := k.GetTimeout(ctx, channel)
+		if err != nil {
+			return err
+		}
+
+		fee := k.GetFee(ctx, sdk.NewCoin(balance.Denom, share), len(transfers) == 0)
+		deducted := fee
+		if relayerFee.Source != types.RelayerFeeSourcePool {
+			deducted = deducted.AddAmount(relayerFee.Total().AmountOf(balance.Denom))
+		}
+		if deducted.Amount.GTE(share) {
+			belowFee = true
+			break
+		}
+
+		transfers = append(transfers, &transfertypes.MsgTransfer{
+			SourcePort:       transfertypes.PortID,
+			SourceChannel:    channel,
+			Token:            sdk.NewCoin(balance.Denom, share.Sub(deducted.Amount)),
+			Sender:           forward.Address,
+			Receiver:         routes[i].Recipient,
+			TimeoutHeight:    timeoutHeight,
+			TimeoutTimestamp: timeoutTimestamp,
+			Memo:             memo,
+		})
+		fees = append(fees, fee)
+		relayerFees = append(relayerFees, relayerFee)
+	}
+	if belowFee {
+		k.deferForward(ctx, forward, balance, types.DeferReasonBelowFee)
+		return nil
+	}
+
+	return k.executeTransfers(ctx, forward, transfers, fees, relayerFees)
+}
+
+// executeTransfers executes the transfers of a forward of a single balance,
+// recording them once all of them have succeeded. If any of the transfers is
+// invalid or fails, none of them are executed.
+func (k *Keeper) executeTransfers(ctx context.Context, forward types.ForwardingAccount, transfers []*transfertypes.MsgTransfer, fees []sdk.Coin, relayerFees []types.RelayerFee) error {
+	for _, msg := range transfers {
+		if err := msg.ValidateBasic(); err != nil {
+			k.Logger().Error("ibc message validation failed", "channel", msg.SourceChannel, "address", forward.GetAddress().String(), "amount", msg.Token.String(), "err", err)
+			return nil
+		}
+	}
+
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+	sequences := make([]uint64, len(transfers))
+	for i, msg := range transfers {
+		res, err := k.transferWithFee(cacheCtx, forward, msg, fees[i], relayerFees[i])
+		if err != nil {
+			return err
+		}
+		sequences[i] = res.Sequence
+	}
+	writeCache()
+
+	for i, msg := range transfers {
+		k.IncrementNumOfForwards(ctx, msg.SourceChannel)
+		k.IncrementTotalForwarded(ctx, msg.SourceChannel, msg.Token)
+		k.IncrementTotalFees(ctx, msg.SourceChannel, fees[i])
+		k.IncrementRateLimitUsage(ctx, msg.SourceChannel, msg.Token)
+		k.SetInFlightPacket(ctx, msg.SourceChannel, sequences[i], forward.Address)
+
+		if err := k.hooks.AfterForward(ctx, forward, msg.SourceChannel, msg.Receiver, msg.Token, sequences[i]); err != nil {
+			k.Logger().Error("forwarding hooks failed after forward", "channel", msg.SourceChannel, "address", forward.GetAddress().String(), "sequence", sequences[i], "err", err)
+		}
+	}
+
+	return nil
+}
+
+// beforeForward calls the forwarding hooks before a balance of a forwarding
+// account is forwarded, returning the coin to forward instead. The hooks are
+// called in a cached context, so that the state changes of a vetoed forward
+// are discarded. The returned coin must be forwardable, and held by the
+// account.
+func (k *Keeper) beforeForward(ctx context.Context, forward types.ForwardingAccount, balance sdk.Coin) (sdk.Coin, error) {
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	coin, err := k.hooks.BeforeForward(cacheCtx, forward, balance)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !coin.IsValid() || !coin.IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("invalid coin returned by hooks: %s", coin)
+	}
+	if coin.Denom != balance.Denom && !k.isForwardableDenom(cacheCtx, forward, coin.Denom) {
+		return sdk.Coin{}, fmt.Errorf("denom returned by hooks is not forwardable: %s", coin.Denom)
+	}
+	if available := k.bankKeeper.GetAllBalances(cacheCtx, forward.GetAddress()).AmountOf(coin.Denom); coin.Amount.GT(available) {
+		return sdk.Coin{}, fmt.Errorf("coin returned by hooks exceeds balance: %s", coin)
+	}
+
+	writeCache()
+	return coin, nil
+}
+
+// getBlockingChannel returns the first channel that a forwarding account
+// forwards through that isn't open, alongside its state.
+func (k *Keeper) getBlockingChannel(ctx context.Context, forward types.ForwardingAccount) (string, channeltypes.State, bool) {
+	for _, channelID := range forward.Channels() {
+		channel, _ := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, channelID)
+		if channel.State != channeltypes.OPEN {
+			return channelID, channel.State, true
+		}
+	}
+
+	return "", channeltypes.OPEN, false
 }
 
 // SendRestrictionFn checks every transfer executed on the Noble chain to see if
@@ -190,14 +1171,365 @@ func (k *Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.Acc
 		return toAddr, nil
 	}
 
-	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, account.Channel)
-	if !fromAddr.Equals(escrow) {
-		k.SetPendingForward(ctx, account)
+	// NOTE: Refunds of failed forwards are sent from the escrow account of
+	// the channel that they were forwarded through.
+	for _, channel := range account.Channels() {
+		if fromAddr.Equals(transfertypes.GetEscrowAddress(transfertypes.PortID, channel)) {
+			return toAddr, nil
+		}
 	}
+	k.SetPendingForward(ctx, account)
 
 	return toAddr, nil
 }
 
+// transferWithFee sends the protocol fee of a forward to the fee recipient,
+// and executes the transfer. The fee is only deducted if the transfer
+// succeeds.
+func (k *Keeper) transferWithFee(ctx context.Context, forward types.ForwardingAccount, msg *transfertypes.MsgTransfer, fee sdk.Coin, relayerFee types.RelayerFee) (*transfertypes.MsgTransferResponse, error) {
+	cacheCtx, writeCache := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	if fee.IsPositive() {
+		recipient, err := k.accountKeeper.AddressCodec().StringToBytes(k.GetFeeRecipient(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := k.bankKeeper.SendCoins(cacheCtx, forward.GetAddress(), recipient, sdk.NewCoins(fee)); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := k.transferKeeper.Transfer(cacheCtx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.escrowRelayerFee(cacheCtx, forward, msg.SourceChannel, msg.Token.Denom, res.Sequence, relayerFee); err != nil {
+		return nil, err
+	}
+
+	writeCache()
+	return res, nil
+}
+
+// escrowRelayerFee escrows the ICS-29 relayer fee of a forward that has just
+// been sent. Fees paid from the forwarded amount are only escrowed for
+// forwards of the fee denom, and must be escrowed for the forward to succeed.
+// Fees paid from the module pool are escrowed on a best effort basis, so that
+// an underfunded pool doesn't block forwards.
+func (k *Keeper) escrowRelayerFee(ctx sdk.Context, forward types.ForwardingAccount, channel string, denom string, sequence uint64, relayerFee types.RelayerFee) error {
+	if relayerFee.IsZero() {
+		return nil
+	}
+	if k.feeKeeper == nil {
+		return sdkerrors.Wrapf(types.ErrFeeNotEnabled, "unable to escrow relayer fee on %s", channel)
+	}
+
+	payer := forward.GetAddress()
+	if relayerFee.Source == types.RelayerFeeSourcePool {
+		payer = authtypes.NewModuleAddress(types.ModuleName)
+	} else if relayerFee.Total().AmountOf(denom).IsZero() {
+		return nil
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	_, err := k.feeKeeper.PayPacketFeeAsync(cacheCtx, &feetypes.MsgPayPacketFeeAsync{
+		PacketId:  channeltypes.NewPacketID(transfertypes.PortID, channel, sequence),
+		PacketFee: feetypes.NewPacketFee(relayerFee.PacketFee(), payer.String(), nil),
+	})
+	if err != nil {
+		if relayerFee.Source == types.RelayerFeeSourcePool {
+			k.Logger().Error("unable to escrow relayer fee from pool", "channel", channel, "sequence", sequence, "err", err)
+			return nil
+		}
+
+		return err
+	}
+
+	writeCache()
+	return nil
+}
+
+// deferForward leaves a balance inside a forwarding account until a future
+// forward, recording why the forward was deferred.
+func (k *Keeper) deferForward(ctx context.Context, forward types.ForwardingAccount, balance sdk.Coin, reason types.DeferReason) {
+	k.IncrementNumOfDeferrals(ctx, forward.StatsKey(), reason)
+
+	err := k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardDeferred{
+		Address: forward.Address,
+		Channel: forward.Channel,
+		Amount:  balance,
+		Reason:  reason,
+	})
+	if err != nil {
+		k.Logger().Error("unable to emit deferred forward event", "channel", forward.Channel, "address", forward.Address, "err", err)
+	}
+}
+
+// GetTimeout computes the absolute timeout height and timestamp of packets
+// sent by automatic forwards through a channel, based on its timeout policy.
+func (k *Keeper) GetTimeout(ctx context.Context, channel string) (clienttypes.Height, uint64, error) {
+	policy := k.GetTimeoutPolicy(ctx, channel)
+
+	timeoutHeight := clienttypes.ZeroHeight()
+	if policy.Height != 0 {
+		_, clientState, err := k.channelKeeper.GetChannelClientState(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, channel)
+		if err != nil {
+			return timeoutHeight, 0, err
+		}
+		latestHeight := clientState.GetLatestHeight()
+		timeoutHeight = clienttypes.NewHeight(latestHeight.GetRevisionNumber(), latestHeight.GetRevisionHeight()+policy.Height)
+	}
+
+	var timeoutTimestamp uint64
+	if policy.Timestamp != 0 {
+		timeoutTimestamp = uint64(k.headerService.GetHeaderInfo(ctx).Time.UnixNano()) + policy.Timestamp
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
+
+// HasPendingForward returns whether the forwarding account that sent a packet
+// has already been marked for forwarding in the current block.
+func (k *Keeper) HasPendingForward(ctx context.Context, packet channeltypes.Packet) bool {
+	address, found := k.GetInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return false
+	}
+
+	has, _ := k.PendingForwards.Has(ctx, address)
+	return has
+}
+
+// HandleAcknowledgement is called whenever an acknowledgement is received for
+// a packet sent on a transfer channel. If the packet is a failed automatic
+// forward, the configured refund policy is applied to the refunded funds.
+//
+// NOTE: pending reports whether the forwarding account was already marked for
+// forwarding before the refund, see HasPendingForward.
+func (k *Keeper) HandleAcknowledgement(ctx context.Context, packet channeltypes.Packet, acknowledgement []byte, pending bool) {
+	address, found := k.GetInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.RemoveInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		k.Logger().Error("unable to unmarshal acknowledgement", "channel", packet.SourceChannel, "sequence", packet.Sequence, "err", err)
+		return
+	}
+	if ack.Success() {
+		return
+	}
+
+	k.handleFailedForward(ctx, packet, address, ack.GetError(), pending)
+}
+
+// HandleTimeout is called whenever a packet sent on a transfer channel times
+// out. If the packet is an automatic forward, the configured refund policy is
+// applied to the refunded funds.
+//
+// NOTE: pending reports whether the forwarding account was already marked for
+// forwarding before the refund, see HasPendingForward.
+func (k *Keeper) HandleTimeout(ctx context.Context, packet channeltypes.Packet, pending bool) {
+	address, found := k.GetInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.RemoveInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	k.handleFailedForward(ctx, packet, address, "packet timed out", pending)
+}
+
+// handleFailedForward applies the refund policy to the funds of a failed
+// automatic forward. At this point, the funds have already been refunded to
+// the forwarding account by the underlying transfer application. Errors are
+// logged, as they must not fail the processing of the acknowledgement.
+func (k *Keeper) handleFailedForward(ctx context.Context, packet channeltypes.Packet, address string, reason string, pending bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		k.Logger().Error("unable to unmarshal packet data of failed forward", "channel", packet.SourceChannel, "sequence", packet.Sequence, "err", err)
+		return
+	}
+	amount, ok := sdkmath.NewIntFromString(data.Amount)
+	if !ok {
+		k.Logger().Error("invalid amount in packet data of failed forward", "channel", packet.SourceChannel, "sequence", packet.Sequence, "amount", data.Amount)
+		return
+	}
+	coin := sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount)
+
+	// NOTE: Refunds of non native tokens are minted to the forwarding account,
+	// marking it for forwarding in the current block. We only unmark it if it
+	// wasn't already marked by another deposit, so that the refund policy is
+	// respected without dropping deposits that arrived while in flight.
+	if !pending {
+		_ = k.PendingForwards.Remove(ctx, address)
+	}
+
+	if account, found := k.getForwardingAccount(ctx, address); found {
+		if err := k.hooks.OnForwardFailed(ctx, account, coin, reason); err != nil {
+			k.Logger().Error("forwarding hooks failed on failed forward", "channel", packet.SourceChannel, "address", address, "err", err)
+		}
+	}
+
+	policy := k.GetRefundPolicy(ctx)
+	switch policy {
+	case types.RefundPolicyRetry:
+		k.SetRetry(ctx, address, errors.New(reason))
+	case types.RefundPolicyFallback:
+		if err := k.sendToFallback(ctx, address, sdk.NewCoins(coin)); err != nil {
+			k.Logger().Error("unable to send refunded forward to fallback", "address", address, "amount", coin.String(), "err", err)
+			policy = types.RefundPolicyHold
+		}
+	}
+
+	if err := k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardRefunded{
+		Address:  address,
+		Channel:  packet.SourceChannel,
+		Sequence: packet.Sequence,
+		Amount:   coin,
+		Error:    reason,
+		Policy:   policy,
+	}); err != nil {
+		k.Logger().Error("unable to emit forward refunded event", "address", address, "err", err)
+	}
+}
+
+// sendToFallback sends funds from a forwarding account to its fallback address.
+func (k *Keeper) sendToFallback(ctx context.Context, address string, coins sdk.Coins) error {
+	bz, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return err
+	}
+	account, ok := k.accountKeeper.GetAccount(ctx, bz).(*types.ForwardingAccount)
+	if !ok {
+		return errors.New("account is not a forwarding account")
+	}
+	if account.Fallback == "" {
+		return errors.New("account does not have a fallback address")
+	}
+	fallback, err := k.accountKeeper.AddressCodec().StringToBytes(account.Fallback)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoins(ctx, bz, fallback, coins)
+}
+
+// handleBlockedForward tracks a forwarding account whose forward was skipped
+// due to a non open channel. Accounts blocked by a closed channel, or for
+// longer than the fallback grace period, are swept to their fallback address.
+// As a closed channel can never be reopened, accounts blocked by one are swept
+// even if no grace period is configured. Otherwise, if no grace period is
+// configured, or the account doesn't have a fallback address, the funds are
+// held inside the account.
+func (k *Keeper) handleBlockedForward(ctx context.Context, forward types.ForwardingAccount, channel string, state channeltypes.State) {
+	if forward.Fallback == "" {
+		return
+	}
+
+	if state == channeltypes.CLOSED {
+		k.sweepToFallback(ctx, forward, "channel closed")
+		return
+	}
+
+	gracePeriod := k.GetFallbackGracePeriod(ctx)
+	if gracePeriod == 0 {
+		return
+	}
+
+	blocked, found := k.GetBlockedForward(ctx, forward.Address)
+	if !found {
+		blocked = types.BlockedForward{
+			Address:      forward.Address,
+			Channel:      channel,
+			BlockedSince: k.headerService.GetHeaderInfo(ctx).Time.UnixNano(),
+		}
+		k.SetBlockedForward(ctx, blocked)
+	}
+
+	if k.headerService.GetHeaderInfo(ctx).Time.UnixNano()-blocked.BlockedSince >= int64(gracePeriod) {
+		k.sweepToFallback(ctx, forward, "fallback grace period elapsed")
+	}
+}
+
+// sweepBlockedForwards sweeps all accounts that have been blocked for longer
+// than the fallback grace period to their fallback address. Accounts whose
+// channel has since been opened are instead queued for forwarding.
+func (k *Keeper) sweepBlockedForwards(ctx context.Context) {
+	gracePeriod := k.GetFallbackGracePeriod(ctx)
+	if gracePeriod == 0 {
+		return
+	}
+
+	cutoff := k.headerService.GetHeaderInfo(ctx).Time.UnixNano() - int64(gracePeriod)
+	for _, blocked := range k.GetBlockedForwardsSince(ctx, cutoff) {
+		account, found := k.getForwardingAccount(ctx, blocked.Address)
+		if !found {
+			k.RemoveBlockedForward(ctx, blocked.Address)
+			continue
+		}
+
+		if _, _, stillBlocked := k.getBlockingChannel(ctx, account); !stillBlocked {
+			k.RemoveBlockedForward(ctx, blocked.Address)
+			k.EnqueueForward(ctx, blocked.Address)
+			continue
+		}
+
+		k.sweepToFallback(ctx, account, "fallback grace period elapsed")
+	}
+}
+
+// HandleChannelClose immediately sweeps all accounts blocked by a channel
+// that has been closed to their fallback address, regardless of the fallback
+// grace period. Accounts that aren't tracked as blocked are swept on their next
+// forward. While the circuit breaker is enabled, accounts remain tracked and
+// are swept once it has been lifted.
+func (k *Keeper) HandleChannelClose(ctx context.Context, channel string) {
+	if k.IsCircuitBreakerEnabled(ctx) {
+		return
+	}
+
+	for _, blocked := range k.GetBlockedForwardsByChannel(ctx, channel) {
+		account, found := k.getForwardingAccount(ctx, blocked.Address)
+		if !found {
+			k.RemoveBlockedForward(ctx, blocked.Address)
+			continue
+		}
+
+		k.sweepToFallback(ctx, account, "channel closed")
+	}
+}
+
+// sweepToFallback sends the entire balance of a forwarding account to its
+// fallback address, and stops tracking it as blocked.
+func (k *Keeper) sweepToFallback(ctx context.Context, forward types.ForwardingAccount, reason string) {
+	k.RemoveBlockedForward(ctx, forward.Address)
+	k.RemoveRetry(ctx, forward.Address)
+	k.RemoveHeldDeposits(ctx, forward.Address)
+	k.RemoveBatch(ctx, forward.Address)
+
+	balances := k.bankKeeper.GetAllBalances(ctx, forward.GetAddress())
+	if balances.IsZero() {
+		return
+	}
+
+	if err := k.sendToFallback(ctx, forward.Address, balances); err != nil {
+		k.Logger().Error("unable to sweep blocked forward to fallback", "channel", forward.Channel, "address", forward.Address, "amount", balances.String(), "err", err)
+		return
+	}
+
+	_ = k.eventService.EventManager(ctx).Emit(ctx, &types.ForwardSweptToFallback{
+		Address:  forward.Address,
+		Channel:  forward.Channel,
+		Fallback: forward.Fallback,
+		Amount:   balances,
+		Reason:   reason,
+	})
+}
+
 // SetIBCKeepers allows us to set the relevant IBC keepers post dependency
 // injection, as IBC doesn't support dependency injection yet.
 func (k *Keeper) SetIBCKeepers(channelKeeper types.ChannelKeeper, transferKeeper types.TransferKeeper) {
@@ -205,6 +1537,37 @@ func (k *Keeper) SetIBCKeepers(channelKeeper types.ChannelKeeper, transferKeeper
 	k.transferKeeper = transferKeeper
 }
 
+// SetFeeKeeper allows us to set the ICS-29 fee keeper post dependency
+// injection. If no fee keeper is set, relayer fees are never escrowed.
+func (k *Keeper) SetFeeKeeper(feeKeeper types.FeeKeeper) {
+	k.feeKeeper = feeKeeper
+}
+
+// RegisterRouter registers the router of a destination type, allowing
+// forwarding accounts to forward to it. It panics if the name is invalid, or
+// a router is already registered under it.
+func (k *Keeper) RegisterRouter(name string, router types.Router) {
+	if err := types.ValidateDestinationType(name); err != nil {
+		panic(err)
+	}
+	if _, found := k.routers[name]; found {
+		panic(fmt.Sprintf("router already registered for destination type %s", name))
+	}
+
+	k.routers[name] = router
+}
+
+// SetHooks allows us to set the forwarding hooks post dependency injection,
+// replacing any hooks set in the constructor. This is required for hooks of
+// modules that themselves depend on this keeper.
+func (k *Keeper) SetHooks(hooks types.ForwardingHooks) {
+	if hooks == nil {
+		hooks = types.MultiForwardingHooks{}
+	}
+
+	k.hooks = hooks
+}
+
 func (k *Keeper) Logger() log.Logger {
 	return k.logger.With("module", types.ModuleName)
 }
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdkerrors "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -36,10 +37,6 @@ import (
 var _ types.MsgServer = &Keeper{}
 
 func (k *Keeper) RegisterAccount(ctx context.Context, msg *types.MsgRegisterAccount) (*types.MsgRegisterAccountResponse, error) {
-	if !channeltypes.IsValidChannelID(msg.Channel) {
-		return nil, errors.New("invalid channel")
-	}
-
 	if len(msg.Recipient) > transfertypes.MaximumReceiverLength {
 		return nil, fmt.Errorf("recipient address must not exceed %d bytes", transfertypes.MaximumReceiverLength)
 	}
@@ -49,76 +46,129 @@ func (k *Keeper) RegisterAccount(ctx context.Context, msg *types.MsgRegisterAcco
 			return nil, errors.New("invalid fallback address")
 		}
 	}
-	address := types.GenerateAddress(msg.Channel, msg.Recipient, msg.Fallback)
+	if maxMemoLength := k.GetMaxMemoLength(ctx); uint64(len(msg.Memo)) > maxMemoLength {
+		return nil, fmt.Errorf("memo must not exceed %d bytes", maxMemoLength)
+	}
 
-	channel, found := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, msg.Channel)
-	if !found {
-		return nil, fmt.Errorf("channel does not exist: %s", msg.Channel)
+	if err := types.ValidateHops(msg.Hops, msg.Memo); err != nil {
+		return nil, err
 	}
-	if channel.State != channeltypes.OPEN {
-		return nil, fmt.Errorf("channel is not open: %s, %s", msg.Channel, channel.State)
+
+	if err := types.ValidateSchedule(msg.Schedule); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateSplits(msg.Channel, msg.Recipient, msg.Hops, msg.Splits); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateBatching(msg.Batching); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDenomRoutes(msg.DenomRoutes); err != nil {
+		return nil, err
 	}
 
-	if k.accountKeeper.HasAccount(ctx, address) {
+	if err := types.ValidateAccountDenoms(msg.AllowedDenoms, msg.DenomRoutes); err != nil {
+		return nil, err
+	}
+
+	account := msg.ForwardingAccount()
+	address := types.GenerateAddress(account)
+	account.BaseAccount = authtypes.NewBaseAccountWithAddress(address)
+	account.CreatedAt = k.headerService.GetHeaderInfo(ctx).Height
+
+	if err := k.validateDestination(ctx, account); err != nil {
+		return nil, err
+	}
+
+	existing := k.accountKeeper.HasAccount(ctx, address)
+	if existing {
 		rawAccount := k.accountKeeper.GetAccount(ctx, address)
 
 		if err := ValidateAccountFields(rawAccount, address); err != nil {
 			return nil, err
 		}
 
-		switch account := rawAccount.(type) {
+		switch base := rawAccount.(type) {
 		case *authtypes.BaseAccount:
-			rawAccount = &types.ForwardingAccount{
-				BaseAccount: account,
-				Channel:     msg.Channel,
-				Recipient:   msg.Recipient,
-				CreatedAt:   k.headerService.GetHeaderInfo(ctx).Height,
-				Fallback:    msg.Fallback,
-			}
-			k.accountKeeper.SetAccount(ctx, rawAccount)
-
-			k.IncrementNumOfAccounts(ctx, msg.Channel)
+			account.BaseAccount = base
 		case *types.ForwardingAccount:
 			return nil, errors.New("account has already been registered")
 		default:
 			return nil, fmt.Errorf("unsupported account type: %T", rawAccount)
 		}
-
-		if !k.bankKeeper.GetAllBalances(ctx, address).IsZero() {
-			account, ok := rawAccount.(*types.ForwardingAccount)
-			if ok {
-				k.SetPendingForward(ctx, account)
-			}
-		}
-
-		return &types.MsgRegisterAccountResponse{Address: address.String()}, k.eventService.EventManager(ctx).Emit(ctx, &types.AccountRegistered{
-			Address:   address.String(),
-			Channel:   msg.Channel,
-			Recipient: msg.Recipient,
-			Fallback:  msg.Fallback,
-		})
+	} else {
+		base := k.accountKeeper.NewAccountWithAddress(ctx, address)
+		account.BaseAccount = authtypes.NewBaseAccount(base.GetAddress(), base.GetPubKey(), base.GetAccountNumber(), base.GetSequence())
 	}
 
-	base := k.accountKeeper.NewAccountWithAddress(ctx, address)
-	account := types.ForwardingAccount{
-		BaseAccount: authtypes.NewBaseAccount(base.GetAddress(), base.GetPubKey(), base.GetAccountNumber(), base.GetSequence()),
-		Channel:     msg.Channel,
-		Recipient:   msg.Recipient,
-		CreatedAt:   k.headerService.GetHeaderInfo(ctx).Height,
-		Fallback:    msg.Fallback,
+	if err := k.hooks.BeforeRegister(ctx, account); err != nil {
+		return nil, err
 	}
 
 	k.accountKeeper.SetAccount(ctx, &account)
-	k.IncrementNumOfAccounts(ctx, msg.Channel)
+	k.IncrementNumOfAccounts(ctx, account.StatsKey())
+
+	if err := k.hooks.AfterRegister(ctx, account); err != nil {
+		return nil, err
+	}
+
+	if existing && !k.bankKeeper.GetAllBalances(ctx, address).IsZero() {
+		k.SetPendingForward(ctx, &account)
+	}
 
 	return &types.MsgRegisterAccountResponse{Address: address.String()}, k.eventService.EventManager(ctx).Emit(ctx, &types.AccountRegistered{
-		Address:   address.String(),
-		Channel:   account.Channel,
-		Recipient: account.Recipient,
-		Fallback:  account.Fallback,
+		Address:         address.String(),
+		Channel:         account.Channel,
+		Recipient:       account.Recipient,
+		Fallback:        account.Fallback,
+		Memo:            account.Memo,
+		Hops:            account.Hops,
+		Schedule:        account.Schedule,
+		Splits:          account.Splits,
+		Batching:        account.Batching,
+		DenomRoutes:     account.DenomRoutes,
+		AllowedDenoms:   account.AllowedDenoms,
+		UnwindOnly:      account.UnwindOnly,
+		DestinationType: account.DestinationType,
+		RouteData:       account.RouteData,
 	})
 }
 
+// validateDestination checks that a forwarding account forwards to a valid
+// destination, of a destination type with a registered router.
+func (k *Keeper) validateDestination(ctx context.Context, account types.ForwardingAccount) error {
+	if err := account.ValidateDestination(); err != nil {
+		return err
+	}
+
+	router, found := k.routers[account.Destination()]
+	if !found {
+		return fmt.Errorf("unknown destination type: %s", account.Destination())
+	}
+
+	return router.ValidateRoute(ctx, account)
+}
+
+// validateRegistrationChannel checks that forwarding accounts can be
+// registered on a channel.
+func (k *Keeper) validateRegistrationChannel(ctx context.Context, channelID string) error {
+	channel, found := k.channelKeeper.GetChannel(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, channelID)
+	if !found {
+		return fmt.Errorf("channel does not exist: %s", channelID)
+	}
+	if channel.State != channeltypes.OPEN {
+		return fmt.Errorf("channel is not open: %s, %s", channelID, channel.State)
+	}
+	if rejectRegistrations, err := k.ChannelPauses.Get(ctx, channelID); err == nil && rejectRegistrations {
+		return sdkerrors.Wrapf(types.ErrPausedChannel, "registrations are rejected on %s", channelID)
+	}
+
+	return nil
+}
+
 func (k *Keeper) ClearAccount(ctx context.Context, msg *types.MsgClearAccount) (*types.MsgClearAccountResponse, error) {
 	address, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Address)
 	if err != nil {
@@ -152,6 +202,9 @@ func (k *Keeper) ClearAccount(ctx context.Context, msg *types.MsgClearAccount) (
 	if err != nil {
 		return nil, errors.New("failed to clear balance to fallback account")
 	}
+	k.RemoveHeldDeposits(ctx, msg.Address)
+	k.RemoveBatch(ctx, msg.Address)
+	k.RemoveRetry(ctx, msg.Address)
 
 	return &types.MsgClearAccountResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.AccountCleared{
 		Address:   msg.Address,
@@ -172,8 +225,11 @@ func (k *Keeper) SetAllowedDenoms(ctx context.Context, msg *types.MsgSetAllowedD
 	if err := k.AllowedDenoms.Clear(ctx, nil); err != nil {
 		return nil, errors.New("failed to clear allowed denoms from state")
 	}
+	if err := k.AllowedDenomPatterns.Clear(ctx, nil); err != nil {
+		return nil, errors.New("failed to clear allowed denom patterns from state")
+	}
 	for _, denom := range msg.Denoms {
-		err := k.AllowedDenoms.Set(ctx, denom)
+		err := k.SetAllowedDenom(ctx, denom)
 		if err != nil {
 			return nil, fmt.Errorf("failed to set %s as allowed denom in state", denom)
 		}
@@ -185,6 +241,437 @@ func (k *Keeper) SetAllowedDenoms(ctx context.Context, msg *types.MsgSetAllowedD
 	})
 }
 
+func (k *Keeper) SetBlockedDenoms(ctx context.Context, msg *types.MsgSetBlockedDenoms) (*types.MsgSetBlockedDenomsResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := types.ValidateBlockedDenoms(msg.Denoms); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidBlockedDenoms, err.Error())
+	}
+
+	previousDenoms := k.GetBlockedDenoms(ctx)
+	if err := k.BlockedDenoms.Clear(ctx, nil); err != nil {
+		return nil, errors.New("failed to clear blocked denoms from state")
+	}
+	for _, denom := range msg.Denoms {
+		err := k.BlockedDenoms.Set(ctx, denom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set %s as blocked denom in state", denom)
+		}
+	}
+
+	return &types.MsgSetBlockedDenomsResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.BlockedDenomsConfigured{
+		PreviousDenoms: previousDenoms,
+		CurrentDenoms:  msg.Denoms,
+	})
+}
+
+func (k *Keeper) SetRefundPolicy(ctx context.Context, msg *types.MsgSetRefundPolicy) (*types.MsgSetRefundPolicyResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := types.ValidateRefundPolicy(msg.Policy); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidPolicy, err.Error())
+	}
+
+	previousPolicy := k.GetRefundPolicy(ctx)
+	if err := k.ConfiguredRefundPolicy.Set(ctx, int32(msg.Policy)); err != nil {
+		return nil, errors.New("failed to set refund policy in state")
+	}
+
+	return &types.MsgSetRefundPolicyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RefundPolicyConfigured{
+		PreviousPolicy: previousPolicy,
+		CurrentPolicy:  msg.Policy,
+	})
+}
+
+func (k *Keeper) SetTimeoutPolicy(ctx context.Context, msg *types.MsgSetTimeoutPolicy) (*types.MsgSetTimeoutPolicyResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if !channeltypes.IsValidChannelID(msg.Channel) {
+		return nil, errors.New("invalid channel")
+	}
+
+	policy := types.TimeoutPolicy{Timestamp: msg.Timestamp, Height: msg.Height}
+
+	previousPolicy := k.GetTimeoutPolicy(ctx, msg.Channel)
+	if policy.IsZero() {
+		if err := k.ConfiguredTimeoutPolicies.Remove(ctx, msg.Channel); err != nil {
+			return nil, errors.New("failed to remove timeout policy from state")
+		}
+	} else {
+		if err := k.ConfiguredTimeoutPolicies.Set(ctx, msg.Channel, policy); err != nil {
+			return nil, errors.New("failed to set timeout policy in state")
+		}
+	}
+
+	return &types.MsgSetTimeoutPolicyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.TimeoutPolicyConfigured{
+		Channel:        msg.Channel,
+		PreviousPolicy: previousPolicy,
+		CurrentPolicy:  k.GetTimeoutPolicy(ctx, msg.Channel),
+	})
+}
+
+func (k *Keeper) SetMaxMemoLength(ctx context.Context, msg *types.MsgSetMaxMemoLength) (*types.MsgSetMaxMemoLengthResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := types.ValidateMaxMemoLength(msg.MaxMemoLength); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMemoLength, err.Error())
+	}
+
+	previousMaxMemoLength := k.GetMaxMemoLength(ctx)
+	if msg.MaxMemoLength == 0 {
+		if err := k.ConfiguredMaxMemoLength.Remove(ctx); err != nil {
+			return nil, errors.New("failed to remove max memo length from state")
+		}
+	} else {
+		if err := k.ConfiguredMaxMemoLength.Set(ctx, msg.MaxMemoLength); err != nil {
+			return nil, errors.New("failed to set max memo length in state")
+		}
+	}
+
+	return &types.MsgSetMaxMemoLengthResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MaxMemoLengthConfigured{
+		PreviousMaxMemoLength: previousMaxMemoLength,
+		CurrentMaxMemoLength:  k.GetMaxMemoLength(ctx),
+	})
+}
+
+func (k *Keeper) SetMinForwardAmount(ctx context.Context, msg *types.MsgSetMinForwardAmount) (*types.MsgSetMinForwardAmountResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if err := types.ValidateMinForwardAmount(msg.Denom, msg.Amount); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidMinForwardAmount, err.Error())
+	}
+
+	previousAmount := k.GetMinForwardAmount(ctx, msg.Denom)
+	if msg.Amount.IsZero() {
+		if err := k.MinForwardAmounts.Remove(ctx, msg.Denom); err != nil {
+			return nil, errors.New("failed to remove min forward amount from state")
+		}
+	} else {
+		if err := k.MinForwardAmounts.Set(ctx, msg.Denom, msg.Amount); err != nil {
+			return nil, errors.New("failed to set min forward amount in state")
+		}
+	}
+
+	return &types.MsgSetMinForwardAmountResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.MinForwardAmountConfigured{
+		Denom:          msg.Denom,
+		PreviousAmount: previousAmount,
+		CurrentAmount:  msg.Amount,
+	})
+}
+
+func (k *Keeper) SetExecutionLimits(ctx context.Context, msg *types.MsgSetExecutionLimits) (*types.MsgSetExecutionLimitsResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	limits := types.ExecutionLimits{
+		MaxForwardsPerBlock: msg.MaxForwardsPerBlock,
+		MaxGasPerBlock:      msg.MaxGasPerBlock,
+	}
+
+	previousLimits := k.GetExecutionLimits(ctx)
+	if err := k.ConfiguredExecutionLimits.Set(ctx, limits); err != nil {
+		return nil, errors.New("failed to set execution limits in state")
+	}
+
+	return &types.MsgSetExecutionLimitsResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ExecutionLimitsConfigured{
+		PreviousLimits: previousLimits,
+		CurrentLimits:  limits,
+	})
+}
+
+func (k *Keeper) SetFeeSchedule(ctx context.Context, msg *types.MsgSetFeeSchedule) (*types.MsgSetFeeScheduleResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	schedule := types.FeeSchedule{
+		Percentage: msg.Percentage,
+		Flat:       msg.Flat,
+	}
+	if err := types.ValidateFeeSchedule(msg.Denom, schedule); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidFeeSchedule, err.Error())
+	}
+
+	previousSchedule := k.GetFeeSchedule(ctx, msg.Denom)
+	if schedule.IsZero() {
+		if err := k.FeeSchedules.Remove(ctx, msg.Denom); err != nil {
+			return nil, errors.New("failed to remove fee schedule from state")
+		}
+	} else {
+		if err := k.FeeSchedules.Set(ctx, msg.Denom, schedule); err != nil {
+			return nil, errors.New("failed to set fee schedule in state")
+		}
+	}
+
+	return &types.MsgSetFeeScheduleResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.FeeScheduleConfigured{
+		Denom:            msg.Denom,
+		PreviousSchedule: previousSchedule,
+		CurrentSchedule:  schedule,
+	})
+}
+
+func (k *Keeper) SetFeeRecipient(ctx context.Context, msg *types.MsgSetFeeRecipient) (*types.MsgSetFeeRecipientResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	previousRecipient := k.GetFeeRecipient(ctx)
+	if msg.Recipient == "" {
+		if err := k.ConfiguredFeeRecipient.Remove(ctx); err != nil {
+			return nil, errors.New("failed to remove fee recipient from state")
+		}
+	} else {
+		if _, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Recipient); err != nil {
+			return nil, errors.New("invalid fee recipient address")
+		}
+
+		if err := k.ConfiguredFeeRecipient.Set(ctx, msg.Recipient); err != nil {
+			return nil, errors.New("failed to set fee recipient in state")
+		}
+	}
+
+	return &types.MsgSetFeeRecipientResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.FeeRecipientConfigured{
+		PreviousRecipient: previousRecipient,
+		CurrentRecipient:  k.GetFeeRecipient(ctx),
+	})
+}
+
+func (k *Keeper) SetRelayerFee(ctx context.Context, msg *types.MsgSetRelayerFee) (*types.MsgSetRelayerFeeResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	fee := types.RelayerFee{
+		RecvFee:    msg.RecvFee,
+		AckFee:     msg.AckFee,
+		TimeoutFee: msg.TimeoutFee,
+		Source:     msg.Source,
+	}
+	if err := types.ValidateRelayerFee(msg.Channel, fee); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidRelayerFee, err.Error())
+	}
+	if !fee.IsZero() && (k.feeKeeper == nil || !k.feeKeeper.IsFeeEnabled(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, msg.Channel)) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidRelayerFee, "channel %s is not fee enabled", msg.Channel)
+	}
+
+	previousFee := k.GetRelayerFee(ctx, msg.Channel)
+	if fee.IsZero() {
+		if err := k.RelayerFees.Remove(ctx, msg.Channel); err != nil {
+			return nil, errors.New("failed to remove relayer fee from state")
+		}
+	} else {
+		if err := k.RelayerFees.Set(ctx, msg.Channel, fee); err != nil {
+			return nil, errors.New("failed to set relayer fee in state")
+		}
+	}
+
+	return &types.MsgSetRelayerFeeResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RelayerFeeConfigured{
+		Channel:     msg.Channel,
+		PreviousFee: previousFee,
+		CurrentFee:  fee,
+	})
+}
+
+func (k *Keeper) SetFallbackGracePeriod(ctx context.Context, msg *types.MsgSetFallbackGracePeriod) (*types.MsgSetFallbackGracePeriodResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	previousGracePeriod := k.GetFallbackGracePeriod(ctx)
+	if err := k.ConfiguredGracePeriod.Set(ctx, msg.GracePeriod); err != nil {
+		return nil, errors.New("failed to set fallback grace period in state")
+	}
+
+	return &types.MsgSetFallbackGracePeriodResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.FallbackGracePeriodConfigured{
+		PreviousGracePeriod: previousGracePeriod,
+		CurrentGracePeriod:  msg.GracePeriod,
+	})
+}
+
+func (k *Keeper) PauseChannel(ctx context.Context, msg *types.MsgPauseChannel) (*types.MsgPauseChannelResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if !channeltypes.IsValidChannelID(msg.Channel) {
+		return nil, errors.New("invalid channel")
+	}
+
+	if err := k.ChannelPauses.Set(ctx, msg.Channel, msg.RejectRegistrations); err != nil {
+		return nil, errors.New("failed to set paused channel in state")
+	}
+
+	return &types.MsgPauseChannelResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ChannelPaused{
+		Channel:             msg.Channel,
+		RejectRegistrations: msg.RejectRegistrations,
+	})
+}
+
+func (k *Keeper) ResumeChannel(ctx context.Context, msg *types.MsgResumeChannel) (*types.MsgResumeChannelResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if !k.IsPausedChannel(ctx, msg.Channel) {
+		return nil, fmt.Errorf("channel is not paused: %s", msg.Channel)
+	}
+
+	if err := k.ChannelPauses.Remove(ctx, msg.Channel); err != nil {
+		return nil, errors.New("failed to remove paused channel from state")
+	}
+
+	return &types.MsgResumeChannelResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ChannelResumed{
+		Channel: msg.Channel,
+	})
+}
+
+func (k *Keeper) SetCircuitBreaker(ctx context.Context, msg *types.MsgSetCircuitBreaker) (*types.MsgSetCircuitBreakerResponse, error) {
+	if guardian := k.GetGuardian(ctx); msg.Signer != k.authority && (guardian == "" || msg.Signer != guardian) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s or guardian, got %s", k.authority, msg.Signer)
+	}
+
+	if err := k.ConfiguredCircuitBreaker.Set(ctx, msg.Enabled); err != nil {
+		return nil, errors.New("failed to set circuit breaker in state")
+	}
+
+	if msg.Enabled {
+		return &types.MsgSetCircuitBreakerResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.CircuitBreakerEnabled{
+			Signer: msg.Signer,
+		})
+	}
+
+	return &types.MsgSetCircuitBreakerResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.CircuitBreakerDisabled{
+		Signer: msg.Signer,
+	})
+}
+
+func (k *Keeper) SetGuardian(ctx context.Context, msg *types.MsgSetGuardian) (*types.MsgSetGuardianResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if msg.Guardian != "" {
+		if _, err := k.accountKeeper.AddressCodec().StringToBytes(msg.Guardian); err != nil {
+			return nil, errors.New("invalid guardian address")
+		}
+	}
+
+	previousGuardian := k.GetGuardian(ctx)
+	if err := k.ConfiguredGuardian.Set(ctx, msg.Guardian); err != nil {
+		return nil, errors.New("failed to set guardian in state")
+	}
+
+	return &types.MsgSetGuardianResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.GuardianConfigured{
+		PreviousGuardian: previousGuardian,
+		CurrentGuardian:  msg.Guardian,
+	})
+}
+
+func (k *Keeper) SetRateLimit(ctx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	limit := types.RateLimit{
+		Channel: msg.Channel,
+		Denom:   msg.Denom,
+		Amount:  msg.Amount,
+		Window:  msg.Window,
+	}
+
+	previousLimit, _ := k.GetRateLimit(ctx, msg.Channel, msg.Denom)
+	if msg.Amount.IsNil() || msg.Amount.IsZero() {
+		if !channeltypes.IsValidChannelID(msg.Channel) {
+			return nil, sdkerrors.Wrap(types.ErrInvalidRateLimit, "invalid channel")
+		}
+
+		key := collections.Join(msg.Channel, msg.Denom)
+		if err := k.ChannelRateLimits.Remove(ctx, key); err != nil {
+			return nil, errors.New("failed to remove rate limit from state")
+		}
+		if err := k.RateLimitUsages.Remove(ctx, key); err != nil {
+			return nil, errors.New("failed to remove rate limit usage from state")
+		}
+	} else {
+		if err := types.ValidateRateLimit(limit); err != nil {
+			return nil, sdkerrors.Wrap(types.ErrInvalidRateLimit, err.Error())
+		}
+
+		if err := k.ChannelRateLimits.Set(ctx, collections.Join(msg.Channel, msg.Denom), limit); err != nil {
+			return nil, errors.New("failed to set rate limit in state")
+		}
+	}
+
+	return &types.MsgSetRateLimitResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.RateLimitConfigured{
+		Channel:       msg.Channel,
+		Denom:         msg.Denom,
+		PreviousLimit: previousLimit,
+		CurrentLimit:  limit,
+	})
+}
+
+func (k *Keeper) SetChannelAllowedDenoms(ctx context.Context, msg *types.MsgSetChannelAllowedDenoms) (*types.MsgSetChannelAllowedDenomsResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	if !channeltypes.IsValidChannelID(msg.Channel) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidDenoms, "invalid channel")
+	}
+
+	if err := types.ValidateAllowedDenoms(msg.Denoms); err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInvalidDenoms, err.Error())
+	}
+
+	previousDenoms := k.GetChannelAllowedDenoms(ctx, msg.Channel)
+	rng := collections.NewPrefixedPairRange[string, string](msg.Channel)
+	if err := k.ChannelAllowedDenoms.Clear(ctx, rng); err != nil {
+		return nil, errors.New("failed to clear channel allowed denoms from state")
+	}
+	if err := k.ChannelAllowedDenomPatterns.Clear(ctx, rng); err != nil {
+		return nil, errors.New("failed to clear channel allowed denom patterns from state")
+	}
+	for _, denom := range msg.Denoms {
+		err := k.SetChannelAllowedDenom(ctx, msg.Channel, denom)
+		if err != nil {
+			return nil, fmt.Errorf("failed to set %s as allowed denom of %s in state", denom, msg.Channel)
+		}
+	}
+
+	return &types.MsgSetChannelAllowedDenomsResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.ChannelAllowedDenomsConfigured{
+		Channel:        msg.Channel,
+		PreviousDenoms: previousDenoms,
+		CurrentDenoms:  msg.Denoms,
+	})
+}
+
+func (k *Keeper) SetUnwindOnly(ctx context.Context, msg *types.MsgSetUnwindOnly) (*types.MsgSetUnwindOnlyResponse, error) {
+	if msg.Signer != k.authority {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAuthority, "expected %s, got %s", k.authority, msg.Signer)
+	}
+
+	previousEnabled := k.IsUnwindOnlyEnabled(ctx)
+	if err := k.ConfiguredUnwindOnly.Set(ctx, msg.Enabled); err != nil {
+		return nil, errors.New("failed to set unwind-only mode in state")
+	}
+
+	return &types.MsgSetUnwindOnlyResponse{}, k.eventService.EventManager(ctx).Emit(ctx, &types.UnwindOnlyConfigured{
+		PreviousEnabled: previousEnabled,
+		CurrentEnabled:  msg.Enabled,
+	})
+}
+
 // ValidateAccountFields is a utility for checking if an account is eligible to be registered.
 //
 // A valid account must satisfy one of the following conditions.
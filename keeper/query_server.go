@@ -23,10 +23,14 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errorstypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/noble-assets/forwarding/v2/types"
 )
@@ -39,8 +43,9 @@ func (k *Keeper) Denoms(ctx context.Context, req *types.QueryDenoms) (*types.Que
 	}
 
 	allowedDenoms := k.GetAllowedDenoms(ctx)
+	blockedDenoms := k.GetBlockedDenoms(ctx)
 
-	return &types.QueryDenomsResponse{AllowedDenoms: allowedDenoms}, nil
+	return &types.QueryDenomsResponse{AllowedDenoms: allowedDenoms, BlockedDenoms: blockedDenoms}, nil
 }
 
 func (k *Keeper) Address(ctx context.Context, req *types.QueryAddress) (*types.QueryAddressResponse, error) {
@@ -59,7 +64,39 @@ func (k *Keeper) Address(ctx context.Context, req *types.QueryAddress) (*types.Q
 		}
 	}
 
-	address := types.GenerateAddress(req.Channel, req.Recipient, req.Fallback)
+	if maxMemoLength := k.GetMaxMemoLength(ctx); uint64(len(req.Memo)) > maxMemoLength {
+		return nil, fmt.Errorf("memo must not exceed %d bytes", maxMemoLength)
+	}
+
+	if err := types.ValidateHops(req.Hops, req.Memo); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateSchedule(req.Schedule); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateSplits(req.Channel, req.Recipient, req.Hops, req.Splits); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateBatching(req.Batching); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateDenomRoutes(req.DenomRoutes); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateAccountDenoms(req.AllowedDenoms, req.DenomRoutes); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateRouteData(req.DestinationType, req.RouteData); err != nil {
+		return nil, err
+	}
+
+	address := types.GenerateAddress(req.ForwardingAccount())
 
 	exists := false
 	if k.accountKeeper.HasAccount(ctx, address) {
@@ -91,6 +128,8 @@ func (k *Keeper) Stats(ctx context.Context, req *types.QueryStats) (*types.Query
 			NumOfAccounts:  numOfAccounts,
 			NumOfForwards:  numOfForwards,
 			TotalForwarded: totalForwarded,
+			NumOfDeferrals: k.GetNumOfDeferrals(ctx, channel),
+			TotalFees:      k.GetTotalFees(ctx, channel),
 		}
 	}
 
@@ -109,5 +148,299 @@ func (k *Keeper) StatsByChannel(ctx context.Context, req *types.QueryStatsByChan
 		NumOfAccounts:  numOfAccounts,
 		NumOfForwards:  numOfForwards,
 		TotalForwarded: k.GetTotalForwarded(ctx, req.Channel),
+		NumOfDeferrals: k.GetNumOfDeferrals(ctx, req.Channel),
+		TotalFees:      k.GetTotalFees(ctx, req.Channel),
+	}, nil
+}
+
+func (k *Keeper) TimeoutPolicy(ctx context.Context, req *types.QueryTimeoutPolicy) (*types.QueryTimeoutPolicyResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryTimeoutPolicyResponse{Policy: k.GetTimeoutPolicy(ctx, req.Channel)}, nil
+}
+
+func (k *Keeper) TimeoutPolicies(ctx context.Context, req *types.QueryTimeoutPolicies) (*types.QueryTimeoutPoliciesResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	var policies []types.ChannelTimeoutPolicy
+	for _, channel := range k.channelKeeper.GetAllChannelsWithPortPrefix(sdk.UnwrapSDKContext(ctx), transfertypes.PortID) {
+		if channel.PortId != transfertypes.PortID {
+			continue
+		}
+
+		policy, err := k.ConfiguredTimeoutPolicies.Get(ctx, channel.ChannelId)
+		if err != nil {
+			policy = types.DefaultTimeoutPolicy()
+		}
+
+		policies = append(policies, types.ChannelTimeoutPolicy{
+			Channel:    channel.ChannelId,
+			Policy:     policy,
+			Configured: err == nil,
+		})
+	}
+
+	slices.SortFunc(policies, func(a, b types.ChannelTimeoutPolicy) int {
+		return strings.Compare(a.Channel, b.Channel)
+	})
+	policies, pagination := paginate(policies, func(policy types.ChannelTimeoutPolicy) string {
+		return policy.Channel
+	}, req.Pagination)
+
+	return &types.QueryTimeoutPoliciesResponse{
+		Policies:   policies,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k *Keeper) MaxMemoLength(ctx context.Context, req *types.QueryMaxMemoLength) (*types.QueryMaxMemoLengthResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryMaxMemoLengthResponse{MaxMemoLength: k.GetMaxMemoLength(ctx)}, nil
+}
+
+func (k *Keeper) MinForwardAmount(ctx context.Context, req *types.QueryMinForwardAmount) (*types.QueryMinForwardAmountResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryMinForwardAmountResponse{Amount: k.GetMinForwardAmount(ctx, req.Denom)}, nil
+}
+
+func (k *Keeper) ExecutionLimits(ctx context.Context, req *types.QueryExecutionLimits) (*types.QueryExecutionLimitsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryExecutionLimitsResponse{Limits: k.GetExecutionLimits(ctx)}, nil
+}
+
+func (k *Keeper) QueueDepth(ctx context.Context, req *types.QueryQueueDepth) (*types.QueryQueueDepthResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryQueueDepthResponse{Depth: k.GetQueueDepth(ctx)}, nil
+}
+
+func (k *Keeper) FeeSchedule(ctx context.Context, req *types.QueryFeeSchedule) (*types.QueryFeeScheduleResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryFeeScheduleResponse{Schedule: k.GetFeeSchedule(ctx, req.Denom)}, nil
+}
+
+func (k *Keeper) FeeRecipient(ctx context.Context, req *types.QueryFeeRecipient) (*types.QueryFeeRecipientResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryFeeRecipientResponse{Recipient: k.GetFeeRecipient(ctx)}, nil
+}
+
+func (k *Keeper) RelayerFee(ctx context.Context, req *types.QueryRelayerFee) (*types.QueryRelayerFeeResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryRelayerFeeResponse{Fee: k.GetRelayerFee(ctx, req.Channel)}, nil
+}
+
+func (k *Keeper) Retries(ctx context.Context, req *types.QueryRetries) (*types.QueryRetriesResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	retries, pagination, err := query.CollectionPaginate(ctx, k.RetryQueue, req.Pagination, func(_ string, retry types.ForwardRetry) (types.ForwardRetry, error) {
+		return retry, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRetriesResponse{
+		Retries:    retries,
+		Pagination: pagination,
+	}, nil
+}
+
+func (k *Keeper) FallbackGracePeriod(ctx context.Context, req *types.QueryFallbackGracePeriod) (*types.QueryFallbackGracePeriodResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryFallbackGracePeriodResponse{GracePeriod: k.GetFallbackGracePeriod(ctx)}, nil
+}
+
+func (k *Keeper) BlockedForwards(ctx context.Context, req *types.QueryBlockedForwards) (*types.QueryBlockedForwardsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	blocked, pagination, err := query.CollectionPaginate(ctx, k.BlockedAccounts, req.Pagination, func(_ string, value types.BlockedForward) (types.BlockedForward, error) {
+		return value, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryBlockedForwardsResponse{
+		BlockedForwards: blocked,
+		Pagination:      pagination,
+	}, nil
+}
+
+func (k *Keeper) PausedChannels(ctx context.Context, req *types.QueryPausedChannels) (*types.QueryPausedChannelsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryPausedChannelsResponse{PausedChannels: k.GetPausedChannels(ctx)}, nil
+}
+
+func (k *Keeper) CircuitBreaker(ctx context.Context, req *types.QueryCircuitBreaker) (*types.QueryCircuitBreakerResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryCircuitBreakerResponse{
+		Enabled:  k.IsCircuitBreakerEnabled(ctx),
+		Guardian: k.GetGuardian(ctx),
+	}, nil
+}
+
+func (k *Keeper) RateLimits(ctx context.Context, req *types.QueryRateLimits) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryRateLimitsResponse{RateLimits: k.GetAllRateLimits(ctx)}, nil
+}
+
+func (k *Keeper) RateLimitUsage(ctx context.Context, req *types.QueryRateLimitUsage) (*types.QueryRateLimitUsageResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	limit, found := k.GetRateLimit(ctx, req.Channel, req.Denom)
+	if !found {
+		return nil, fmt.Errorf("no rate limit configured for %s on %s", req.Denom, req.Channel)
+	}
+
+	usage := k.GetRateLimitUsage(ctx, req.Channel, req.Denom)
+	remaining := limit.Amount.Sub(usage.Amount)
+	if remaining.IsNegative() {
+		remaining = sdkmath.ZeroInt()
+	}
+
+	return &types.QueryRateLimitUsageResponse{
+		Limit:     limit,
+		Usage:     usage,
+		Remaining: remaining,
+	}, nil
+}
+
+func (k *Keeper) HeldDeposits(ctx context.Context, req *types.QueryHeldDeposits) (*types.QueryHeldDepositsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	if _, err := k.accountKeeper.AddressCodec().StringToBytes(req.Address); err != nil {
+		return nil, errors.Wrap(err, "invalid account address")
+	}
+
+	return &types.QueryHeldDepositsResponse{HeldDeposits: k.GetHeldDeposits(ctx, req.Address)}, nil
+}
+
+func (k *Keeper) Batch(ctx context.Context, req *types.QueryBatch) (*types.QueryBatchResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	if _, err := k.accountKeeper.AddressCodec().StringToBytes(req.Address); err != nil {
+		return nil, errors.Wrap(err, "invalid account address")
+	}
+
+	batch, found := k.GetBatch(ctx, req.Address)
+
+	return &types.QueryBatchResponse{Batch: batch, Exists: found}, nil
+}
+
+func (k *Keeper) RefundPolicy(ctx context.Context, req *types.QueryRefundPolicy) (*types.QueryRefundPolicyResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryRefundPolicyResponse{Policy: k.GetRefundPolicy(ctx)}, nil
+}
+
+func (k *Keeper) ChannelDenoms(ctx context.Context, req *types.QueryChannelDenoms) (*types.QueryChannelDenomsResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	allowedDenoms := k.GetChannelAllowedDenoms(ctx, req.Channel)
+
+	return &types.QueryChannelDenomsResponse{
+		AllowedDenoms: allowedDenoms,
+		Scoped:        len(allowedDenoms) > 0,
 	}, nil
 }
+
+func (k *Keeper) UnwindOnly(ctx context.Context, req *types.QueryUnwindOnly) (*types.QueryUnwindOnlyResponse, error) {
+	if req == nil {
+		return nil, errorstypes.ErrInvalidRequest
+	}
+
+	return &types.QueryUnwindOnlyResponse{Enabled: k.IsUnwindOnlyEnabled(ctx)}, nil
+}
+
+// paginate applies a page request to results sorted by key, for results that
+// aren't read from the module's own store, such as the channels of the IBC
+// module.
+func paginate[T any](items []T, key func(T) string, req *query.PageRequest) ([]T, *query.PageResponse) {
+	if req == nil {
+		req = &query.PageRequest{}
+	}
+	if req.Reverse {
+		slices.Reverse(items)
+	}
+
+	start := 0
+	switch {
+	case req.Key != nil:
+		start = len(items)
+		for i, item := range items {
+			cmp := strings.Compare(key(item), string(req.Key))
+			if (!req.Reverse && cmp >= 0) || (req.Reverse && cmp <= 0) {
+				start = i
+				break
+			}
+		}
+	case req.Offset > 0:
+		start = int(min(req.Offset, uint64(len(items))))
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	end := start + int(min(limit, uint64(len(items)-start)))
+
+	res := &query.PageResponse{}
+	if end < len(items) {
+		res.NextKey = []byte(key(items[end]))
+	}
+	if req.CountTotal {
+		res.Total = uint64(len(items))
+	}
+
+	return items[start:end], res
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+var (
+	_ types.Router = IBCRouter{}
+	_ types.Router = LocalRouter{}
+)
+
+// IBCRouter forwards the funds of forwarding accounts through ICS-20
+// channels, with support for hops, splits and denom routes.
+type IBCRouter struct {
+	keeper *Keeper
+}
+
+// ValidateRoute checks that every channel the account forwards through
+// exists, is open, and isn't rejecting registrations.
+func (r IBCRouter) ValidateRoute(ctx context.Context, account types.ForwardingAccount) error {
+	for _, channel := range account.Channels() {
+		if err := r.keeper.validateRegistrationChannel(ctx, channel); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r IBCRouter) Forward(ctx context.Context, account types.ForwardingAccount, balance sdk.Coin) error {
+	return r.keeper.forwardThroughIBC(ctx, account, balance)
+}
+
+// LocalRouter forwards the funds of forwarding accounts to a native address,
+// defined by the recipient of the account, using a bank send.
+type LocalRouter struct {
+	keeper *Keeper
+}
+
+// ValidateRoute checks that the recipient of the account is a valid native
+// address, that it isn't a forwarding account, and that it isn't blocked from
+// receiving funds. Fields that only apply to IBC destinations are rejected,
+// as they would otherwise be silently ignored.
+func (r LocalRouter) ValidateRoute(ctx context.Context, account types.ForwardingAccount) error {
+	if len(account.RouteData) > 0 {
+		return errors.New("route data is not supported by local destinations")
+	}
+	if account.Memo != "" || len(account.Hops) > 0 || len(account.Splits) > 0 || len(account.DenomRoutes) > 0 {
+		return errors.New("memos, hops, splits and denom routes are not supported by local destinations")
+	}
+
+	recipient, err := r.keeper.accountKeeper.AddressCodec().StringToBytes(account.Recipient)
+	if err != nil {
+		return fmt.Errorf("invalid local recipient address: %w", err)
+	}
+
+	return r.validateRecipient(ctx, account, recipient)
+}
+
+// Forward sends the balance to the recipient of the account. The recipient
+// is checked again, as it could have been registered as a forwarding account
+// after the account itself was registered.
+func (r LocalRouter) Forward(ctx context.Context, account types.ForwardingAccount, balance sdk.Coin) error {
+	recipient, err := r.keeper.accountKeeper.AddressCodec().StringToBytes(account.Recipient)
+	if err != nil {
+		return err
+	}
+
+	if err := r.validateRecipient(ctx, account, recipient); err != nil {
+		return err
+	}
+
+	return r.keeper.bankKeeper.SendCoins(ctx, account.GetAddress(), recipient, sdk.NewCoins(balance))
+}
+
+// validateRecipient checks that funds sent to the recipient of a local
+// forwarding account aren't forwarded again. Sending into a forwarding
+// account triggers its own forward, which could loop back into the account.
+// It also checks that the recipient isn't blocked, as bank sends from the
+// keeper don't enforce this, unlike MsgSend.
+func (r LocalRouter) validateRecipient(ctx context.Context, account types.ForwardingAccount, recipient sdk.AccAddress) error {
+	if r.keeper.bankKeeper.BlockedAddr(recipient) {
+		return fmt.Errorf("recipient %s is not allowed to receive funds", account.Recipient)
+	}
+
+	if recipient.Equals(account.GetAddress()) {
+		return sdkerrors.Wrap(types.ErrForwardingLoop, "recipient is the forwarding account itself")
+	}
+
+	if _, ok := r.keeper.accountKeeper.GetAccount(ctx, recipient).(*types.ForwardingAccount); ok {
+		return sdkerrors.Wrapf(types.ErrForwardingLoop, "recipient %s is a forwarding account", account.Recipient)
+	}
+
+	return nil
+}
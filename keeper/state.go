@@ -21,9 +21,15 @@
 package keeper
 
 import (
+	"cmp"
 	"context"
+	"slices"
 
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/noble-assets/forwarding/v2/types"
 )
 
@@ -40,6 +46,102 @@ func (k *Keeper) GetAllowedDenoms(ctx context.Context) []string {
 	return denoms
 }
 
+func (k *Keeper) GetAllowedDenomPatterns(ctx context.Context) []string {
+	var patterns []string
+
+	_ = k.AllowedDenomPatterns.Walk(ctx, nil, func(pattern string) (stop bool, err error) {
+		patterns = append(patterns, pattern)
+		return false, nil
+	})
+
+	return patterns
+}
+
+// SetAllowedDenom allows a denom to be forwarded through all channels without
+// their own allowed denoms, indexing it if it is a pattern.
+func (k *Keeper) SetAllowedDenom(ctx context.Context, denom string) error {
+	if types.IsDenomPattern(denom) {
+		if err := k.AllowedDenomPatterns.Set(ctx, denom); err != nil {
+			return err
+		}
+	}
+
+	return k.AllowedDenoms.Set(ctx, denom)
+}
+
+func (k *Keeper) GetBlockedDenoms(ctx context.Context) []string {
+	var denoms []string
+
+	_ = k.BlockedDenoms.Walk(ctx, nil, func(denom string) (stop bool, err error) {
+		denoms = append(denoms, denom)
+		return false, nil
+	})
+
+	return denoms
+}
+
+// GetChannelAllowedDenoms returns the denoms that are allowed to be forwarded
+// through a specific channel, if the channel has its own allowed denoms.
+func (k *Keeper) GetChannelAllowedDenoms(ctx context.Context, channel string) []string {
+	var denoms []string
+
+	rng := collections.NewPrefixedPairRange[string, string](channel)
+	_ = k.ChannelAllowedDenoms.Walk(ctx, rng, func(key collections.Pair[string, string]) (stop bool, err error) {
+		denoms = append(denoms, key.K2())
+		return false, nil
+	})
+
+	return denoms
+}
+
+// HasChannelAllowedDenoms returns whether a channel has its own allowed
+// denoms, without reading all of them.
+func (k *Keeper) HasChannelAllowedDenoms(ctx context.Context, channel string) bool {
+	iter, err := k.ChannelAllowedDenoms.Iterate(ctx, collections.NewPrefixedPairRange[string, string](channel))
+	if err != nil {
+		return false
+	}
+	defer iter.Close()
+
+	return iter.Valid()
+}
+
+func (k *Keeper) GetChannelAllowedDenomPatterns(ctx context.Context, channel string) []string {
+	var patterns []string
+
+	rng := collections.NewPrefixedPairRange[string, string](channel)
+	_ = k.ChannelAllowedDenomPatterns.Walk(ctx, rng, func(key collections.Pair[string, string]) (stop bool, err error) {
+		patterns = append(patterns, key.K2())
+		return false, nil
+	})
+
+	return patterns
+}
+
+// SetChannelAllowedDenom allows a denom to be forwarded through a specific
+// channel, indexing it if it is a pattern.
+func (k *Keeper) SetChannelAllowedDenom(ctx context.Context, channel string, denom string) error {
+	if types.IsDenomPattern(denom) {
+		if err := k.ChannelAllowedDenomPatterns.Set(ctx, collections.Join(channel, denom)); err != nil {
+			return err
+		}
+	}
+
+	return k.ChannelAllowedDenoms.Set(ctx, collections.Join(channel, denom))
+}
+
+func (k *Keeper) GetAllChannelAllowedDenoms(ctx context.Context) (entries []types.ChannelAllowedDenoms) {
+	_ = k.ChannelAllowedDenoms.Walk(ctx, nil, func(key collections.Pair[string, string]) (stop bool, err error) {
+		if len(entries) == 0 || entries[len(entries)-1].Channel != key.K1() {
+			entries = append(entries, types.ChannelAllowedDenoms{Channel: key.K1()})
+		}
+		entries[len(entries)-1].Denoms = append(entries[len(entries)-1].Denoms, key.K2())
+		return false, nil
+	})
+
+	return
+}
+
 func (k *Keeper) GetAllNumOfAccounts(ctx context.Context) map[string]uint64 {
 	counts := make(map[string]uint64)
 
@@ -99,6 +201,765 @@ func (k *Keeper) IncrementTotalForwarded(ctx context.Context, channel string, co
 	_ = k.TotalForwarded.Set(ctx, channel, total.Add(coin).String())
 }
 
+func (k *Keeper) GetNumOfDeferrals(ctx context.Context, channel string) map[string]uint64 {
+	counts := make(map[string]uint64)
+
+	rng := collections.NewPrefixedPairRange[string, int32](channel)
+	_ = k.NumOfDeferrals.Walk(ctx, rng, func(key collections.Pair[string, int32], value uint64) (stop bool, err error) {
+		counts[types.DeferReason(key.K2()).String()] = value
+
+		return false, nil
+	})
+
+	return counts
+}
+
+func (k *Keeper) GetAllNumOfDeferrals(ctx context.Context) (counts []types.DeferralCount) {
+	_ = k.NumOfDeferrals.Walk(ctx, nil, func(key collections.Pair[string, int32], value uint64) (stop bool, err error) {
+		counts = append(counts, types.DeferralCount{
+			Channel: key.K1(),
+			Reason:  types.DeferReason(key.K2()),
+			Count:   value,
+		})
+
+		return false, nil
+	})
+
+	return counts
+}
+
+func (k *Keeper) IncrementNumOfDeferrals(ctx context.Context, channel string, reason types.DeferReason) {
+	key := collections.Join(channel, int32(reason))
+	count, _ := k.NumOfDeferrals.Get(ctx, key)
+	_ = k.NumOfDeferrals.Set(ctx, key, count+1)
+}
+
+func (k *Keeper) GetTotalFees(ctx context.Context, channel string) sdk.Coins {
+	rawTotal, _ := k.TotalFees.Get(ctx, channel)
+	total, _ := sdk.ParseCoinsNormalized(rawTotal)
+	return total
+}
+
+func (k *Keeper) GetAllTotalFees(ctx context.Context) map[string]string {
+	totals := make(map[string]string)
+
+	_ = k.TotalFees.Walk(ctx, nil, func(key string, value string) (stop bool, err error) {
+		totals[key] = value
+
+		return false, nil
+	})
+
+	return totals
+}
+
+func (k *Keeper) IncrementTotalFees(ctx context.Context, channel string, coin sdk.Coin) {
+	if !coin.IsPositive() {
+		return
+	}
+
+	total := k.GetTotalFees(ctx, channel)
+	_ = k.TotalFees.Set(ctx, channel, total.Add(coin).String())
+}
+
+// GetDueRetries returns all failed forwards that are due to be retried at the
+// specified block height, ordered by when they became due.
+func (k *Keeper) GetDueRetries(ctx context.Context, height int64) (retries []types.ForwardRetry) {
+	rng := collections.NewPrefixUntilPairRange[int64, string](height)
+	iterator, err := k.RetryQueue.Indexes.NextAttempt.Iterate(ctx, rng)
+	if err != nil {
+		return
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address, err := iterator.PrimaryKey()
+		if err != nil {
+			continue
+		}
+		retry, err := k.RetryQueue.Get(ctx, address)
+		if err != nil {
+			continue
+		}
+
+		retries = append(retries, retry)
+	}
+
+	return
+}
+
+// SetRetry records a failed forward in the retry queue, scheduling the next
+// attempt with an exponential backoff. Accounts that have exhausted all their
+// attempts are removed from the queue.
+func (k *Keeper) SetRetry(ctx context.Context, address string, reason error) {
+	retry, err := k.RetryQueue.Get(ctx, address)
+	if err != nil {
+		retry = types.ForwardRetry{Address: address}
+	}
+
+	retry.Attempts++
+	if retry.Attempts > types.MaxRetryAttempts {
+		k.Logger().Error("dropped automatic forward after exhausting all retries", "address", address, "attempts", types.MaxRetryAttempts)
+		k.RemoveRetry(ctx, address)
+		return
+	}
+
+	retry.NextAttempt = k.headerService.GetHeaderInfo(ctx).Height + types.RetryDelay(retry.Attempts)
+	retry.LastError = reason.Error()
+
+	_ = k.RetryQueue.Set(ctx, address, retry)
+}
+
+func (k *Keeper) GetAllRetries(ctx context.Context) (retries []types.ForwardRetry) {
+	_ = k.RetryQueue.Walk(ctx, nil, func(_ string, retry types.ForwardRetry) (stop bool, err error) {
+		retries = append(retries, retry)
+		return false, nil
+	})
+
+	return retries
+}
+
+func (k *Keeper) RemoveRetry(ctx context.Context, address string) {
+	_ = k.RetryQueue.Remove(ctx, address)
+}
+
+func (k *Keeper) GetInFlightPacket(ctx context.Context, channel string, sequence uint64) (string, bool) {
+	address, err := k.InFlightPackets.Get(ctx, collections.Join(channel, sequence))
+	return address, err == nil
+}
+
+func (k *Keeper) SetInFlightPacket(ctx context.Context, channel string, sequence uint64, address string) {
+	_ = k.InFlightPackets.Set(ctx, collections.Join(channel, sequence), address)
+}
+
+func (k *Keeper) GetAllInFlightPackets(ctx context.Context) (packets []types.InFlightPacket) {
+	_ = k.InFlightPackets.Walk(ctx, nil, func(key collections.Pair[string, uint64], address string) (stop bool, err error) {
+		packets = append(packets, types.InFlightPacket{
+			Channel:  key.K1(),
+			Sequence: key.K2(),
+			Address:  address,
+		})
+		return false, nil
+	})
+
+	return packets
+}
+
+func (k *Keeper) RemoveInFlightPacket(ctx context.Context, channel string, sequence uint64) {
+	_ = k.InFlightPackets.Remove(ctx, collections.Join(channel, sequence))
+}
+
+// GetRefundPolicy returns the policy applied to refunds of failed forwards.
+// If no policy has been configured, refunded funds are held.
+func (k *Keeper) GetRefundPolicy(ctx context.Context) types.RefundPolicy {
+	policy, _ := k.ConfiguredRefundPolicy.Get(ctx)
+	if types.RefundPolicy(policy) == types.RefundPolicyUnspecified {
+		return types.RefundPolicyHold
+	}
+
+	return types.RefundPolicy(policy)
+}
+
+// GetTimeoutPolicy returns the timeout policy of a channel. If no policy has
+// been configured, the default timeout policy is returned.
+func (k *Keeper) GetTimeoutPolicy(ctx context.Context, channel string) types.TimeoutPolicy {
+	policy, err := k.ConfiguredTimeoutPolicies.Get(ctx, channel)
+	if err != nil {
+		return types.DefaultTimeoutPolicy()
+	}
+
+	return policy
+}
+
+func (k *Keeper) GetAllTimeoutPolicies(ctx context.Context) map[string]types.TimeoutPolicy {
+	policies := make(map[string]types.TimeoutPolicy)
+
+	_ = k.ConfiguredTimeoutPolicies.Walk(ctx, nil, func(channel string, policy types.TimeoutPolicy) (stop bool, err error) {
+		policies[channel] = policy
+		return false, nil
+	})
+
+	return policies
+}
+
+// GetMaxMemoLength returns the maximum length of forwarding account memos. If
+// no maximum has been configured, the default maximum is returned.
+func (k *Keeper) GetMaxMemoLength(ctx context.Context) uint64 {
+	maxMemoLength, err := k.ConfiguredMaxMemoLength.Get(ctx)
+	if err != nil || maxMemoLength == 0 {
+		return types.DefaultMaxMemoLength
+	}
+
+	return maxMemoLength
+}
+
+// GetMinForwardAmount returns the minimum amount of a denom that is forwarded.
+// If no minimum has been configured, zero is returned.
+func (k *Keeper) GetMinForwardAmount(ctx context.Context, denom string) sdkmath.Int {
+	amount, err := k.MinForwardAmounts.Get(ctx, denom)
+	if err != nil {
+		return sdkmath.ZeroInt()
+	}
+
+	return amount
+}
+
+func (k *Keeper) GetAllMinForwardAmounts(ctx context.Context) map[string]string {
+	amounts := make(map[string]string)
+
+	_ = k.MinForwardAmounts.Walk(ctx, nil, func(denom string, amount sdkmath.Int) (stop bool, err error) {
+		amounts[denom] = amount.String()
+		return false, nil
+	})
+
+	return amounts
+}
+
+// GetExecutionLimits returns the limits of forwards executed per block. If no
+// limits have been configured, for example on chains that upgraded from a
+// version without limits, forwards are not limited.
+func (k *Keeper) GetExecutionLimits(ctx context.Context) types.ExecutionLimits {
+	limits, err := k.ConfiguredExecutionLimits.Get(ctx)
+	if err != nil {
+		return types.ExecutionLimits{}
+	}
+
+	return limits
+}
+
+// QueuedForward is an entry of the forward queue.
+type QueuedForward struct {
+	Key      string
+	Sequence uint64
+	Address  string
+}
+
+// EnqueueForward adds a forwarding account to the end of the forward queue
+// of its channel, or destination type, if it isn't already queued. Accounts
+// that forward through a paused channel are queued on that channel instead,
+// so that they don't block the queue of their own channel.
+func (k *Keeper) EnqueueForward(ctx context.Context, address string) {
+	if k.IsQueuedForward(ctx, address) {
+		return
+	}
+
+	// NOTE: Accounts that no longer exist are queued without a key, and are
+	// removed from the queue once they are reached.
+	var key string
+	if account, found := k.getForwardingAccount(ctx, address); found {
+		key = account.StatsKey()
+		if channel, paused := k.getPausedChannel(ctx, account); paused {
+			key = channel
+		}
+	}
+
+	sequence, err := k.ForwardQueueSequence.Next(ctx)
+	if err != nil {
+		return
+	}
+
+	_ = k.ForwardQueue.Set(ctx, collections.Join(key, sequence), address)
+	_ = k.QueuedForwards.Set(ctx, address)
+
+	length, _ := k.ForwardQueueLengths.Get(ctx, key)
+	_ = k.ForwardQueueLengths.Set(ctx, key, length+1)
+	_ = k.ForwardQueueDepth.Set(ctx, k.GetQueueDepth(ctx)+1)
+}
+
+// DequeueForward removes a forwarding account from the forward queue.
+func (k *Keeper) DequeueForward(ctx context.Context, forward QueuedForward) {
+	if has, _ := k.ForwardQueue.Has(ctx, collections.Join(forward.Key, forward.Sequence)); !has {
+		return
+	}
+
+	_ = k.ForwardQueue.Remove(ctx, collections.Join(forward.Key, forward.Sequence))
+	_ = k.QueuedForwards.Remove(ctx, forward.Address)
+
+	if length, _ := k.ForwardQueueLengths.Get(ctx, forward.Key); length > 1 {
+		_ = k.ForwardQueueLengths.Set(ctx, forward.Key, length-1)
+	} else {
+		_ = k.ForwardQueueLengths.Remove(ctx, forward.Key)
+	}
+	if depth := k.GetQueueDepth(ctx); depth > 0 {
+		_ = k.ForwardQueueDepth.Set(ctx, depth-1)
+	}
+}
+
+// MoveQueuedForward moves a queued forwarding account into the forward queue
+// of another channel, keeping its position relative to all other queues.
+func (k *Keeper) MoveQueuedForward(ctx context.Context, forward QueuedForward, key string) {
+	if has, _ := k.ForwardQueue.Has(ctx, collections.Join(forward.Key, forward.Sequence)); !has {
+		return
+	}
+
+	_ = k.ForwardQueue.Remove(ctx, collections.Join(forward.Key, forward.Sequence))
+	_ = k.ForwardQueue.Set(ctx, collections.Join(key, forward.Sequence), forward.Address)
+
+	if length, _ := k.ForwardQueueLengths.Get(ctx, forward.Key); length > 1 {
+		_ = k.ForwardQueueLengths.Set(ctx, forward.Key, length-1)
+	} else {
+		_ = k.ForwardQueueLengths.Remove(ctx, forward.Key)
+	}
+	length, _ := k.ForwardQueueLengths.Get(ctx, key)
+	_ = k.ForwardQueueLengths.Set(ctx, key, length+1)
+}
+
+// GetNextQueuedForward returns the first forwarding account in the forward
+// queue of a channel, or destination type.
+func (k *Keeper) GetNextQueuedForward(ctx context.Context, key string) (QueuedForward, bool) {
+	iterator, err := k.ForwardQueue.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](key))
+	if err != nil {
+		return QueuedForward{}, false
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return QueuedForward{}, false
+	}
+
+	kv, err := iterator.KeyValue()
+	if err != nil {
+		return QueuedForward{}, false
+	}
+
+	return QueuedForward{Key: kv.Key.K1(), Sequence: kv.Key.K2(), Address: kv.Value}, true
+}
+
+// GetQueueKeys returns the channels, and destination types, that have any
+// forwards queued, in alphabetical order.
+func (k *Keeper) GetQueueKeys(ctx context.Context) (keys []string) {
+	_ = k.ForwardQueueLengths.Walk(ctx, nil, func(key string, _ uint64) (stop bool, err error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+
+	return keys
+}
+
+func (k *Keeper) IsQueuedForward(ctx context.Context, address string) bool {
+	has, _ := k.QueuedForwards.Has(ctx, address)
+	return has
+}
+
+// GetQueuedForwards returns all queued forwarding accounts, in the order that
+// they were queued.
+func (k *Keeper) GetQueuedForwards(ctx context.Context) (addresses []string) {
+	var forwards []QueuedForward
+	_ = k.ForwardQueue.Walk(ctx, nil, func(key collections.Pair[string, uint64], address string) (stop bool, err error) {
+		forwards = append(forwards, QueuedForward{Key: key.K1(), Sequence: key.K2(), Address: address})
+		return false, nil
+	})
+
+	slices.SortFunc(forwards, func(a, b QueuedForward) int {
+		return cmp.Compare(a.Sequence, b.Sequence)
+	})
+	for _, forward := range forwards {
+		addresses = append(addresses, forward.Address)
+	}
+
+	return addresses
+}
+
+// GetQueueDepth returns the number of forwards in the forward queue.
+func (k *Keeper) GetQueueDepth(ctx context.Context) uint64 {
+	depth, _ := k.ForwardQueueDepth.Get(ctx)
+	return depth
+}
+
+// GetFeeSchedule returns the fee schedule of a denom. If no schedule has been
+// configured, an empty schedule is returned.
+func (k *Keeper) GetFeeSchedule(ctx context.Context, denom string) types.FeeSchedule {
+	schedule, err := k.FeeSchedules.Get(ctx, denom)
+	if err != nil {
+		return types.EmptyFeeSchedule()
+	}
+
+	return schedule
+}
+
+func (k *Keeper) GetAllFeeSchedules(ctx context.Context) map[string]types.FeeSchedule {
+	schedules := make(map[string]types.FeeSchedule)
+
+	_ = k.FeeSchedules.Walk(ctx, nil, func(denom string, schedule types.FeeSchedule) (stop bool, err error) {
+		schedules[denom] = schedule
+		return false, nil
+	})
+
+	return schedules
+}
+
+// GetFee returns the protocol fee deducted from a share of a forward of a
+// specific coin. The flat fee is only deducted from the first share.
+func (k *Keeper) GetFee(ctx context.Context, share sdk.Coin, first bool) sdk.Coin {
+	return sdk.NewCoin(share.Denom, k.GetFeeSchedule(ctx, share.Denom).ShareFee(share.Amount, first))
+}
+
+// GetFeeRecipient returns the address that protocol fees are sent to. If no
+// recipient has been configured, fees are sent to the fee collector.
+func (k *Keeper) GetFeeRecipient(ctx context.Context) string {
+	recipient, err := k.ConfiguredFeeRecipient.Get(ctx)
+	if err != nil || recipient == "" {
+		return authtypes.NewModuleAddress(authtypes.FeeCollectorName).String()
+	}
+
+	return recipient
+}
+
+// GetRelayerFee returns the relayer fee of a channel. If no fee has been
+// configured, an empty fee is returned.
+func (k *Keeper) GetRelayerFee(ctx context.Context, channel string) types.RelayerFee {
+	fee, err := k.RelayerFees.Get(ctx, channel)
+	if err != nil {
+		return types.RelayerFee{}
+	}
+
+	return fee
+}
+
+func (k *Keeper) GetAllRelayerFees(ctx context.Context) map[string]types.RelayerFee {
+	fees := make(map[string]types.RelayerFee)
+
+	_ = k.RelayerFees.Walk(ctx, nil, func(channel string, fee types.RelayerFee) (stop bool, err error) {
+		fees[channel] = fee
+		return false, nil
+	})
+
+	return fees
+}
+
+// GetEscrowedRelayerFee returns the relayer fee escrowed for forwards through
+// a channel. An empty fee is returned if no fee keeper is set, or the channel
+// isn't fee enabled.
+func (k *Keeper) GetEscrowedRelayerFee(ctx context.Context, channel string) types.RelayerFee {
+	if k.feeKeeper == nil || !k.feeKeeper.IsFeeEnabled(sdk.UnwrapSDKContext(ctx), transfertypes.PortID, channel) {
+		return types.RelayerFee{}
+	}
+
+	return k.GetRelayerFee(ctx, channel)
+}
+
+// GetFallbackGracePeriod returns how long, in nanoseconds, an account can be
+// blocked by a non open channel before its funds are swept to its fallback
+// address. A zero value disables the automatic fallback.
+func (k *Keeper) GetFallbackGracePeriod(ctx context.Context) uint64 {
+	gracePeriod, _ := k.ConfiguredGracePeriod.Get(ctx)
+	return gracePeriod
+}
+
+func (k *Keeper) GetBlockedForward(ctx context.Context, address string) (types.BlockedForward, bool) {
+	blocked, err := k.BlockedAccounts.Get(ctx, address)
+	return blocked, err == nil
+}
+
+func (k *Keeper) SetBlockedForward(ctx context.Context, blocked types.BlockedForward) {
+	_ = k.BlockedAccounts.Set(ctx, blocked.Address, blocked)
+}
+
+func (k *Keeper) RemoveBlockedForward(ctx context.Context, address string) {
+	_ = k.BlockedAccounts.Remove(ctx, address)
+}
+
+func (k *Keeper) GetAllBlockedForwards(ctx context.Context) (blocked []types.BlockedForward) {
+	_ = k.BlockedAccounts.Walk(ctx, nil, func(_ string, value types.BlockedForward) (stop bool, err error) {
+		blocked = append(blocked, value)
+		return false, nil
+	})
+
+	return
+}
+
+// GetBlockedForwardsSince returns all accounts that were first blocked at or
+// before a specific time, in unix nanoseconds.
+func (k *Keeper) GetBlockedForwardsSince(ctx context.Context, cutoff int64) (blocked []types.BlockedForward) {
+	rng := collections.NewPrefixUntilPairRange[int64, string](cutoff)
+	iterator, err := k.BlockedAccounts.Indexes.BlockedSince.Iterate(ctx, rng)
+	if err != nil {
+		return
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address, err := iterator.PrimaryKey()
+		if err != nil {
+			continue
+		}
+		value, err := k.BlockedAccounts.Get(ctx, address)
+		if err != nil {
+			continue
+		}
+
+		blocked = append(blocked, value)
+	}
+
+	return
+}
+
+// GetBlockedForwardsByChannel returns all accounts blocked by a specific channel.
+func (k *Keeper) GetBlockedForwardsByChannel(ctx context.Context, channel string) (blocked []types.BlockedForward) {
+	iterator, err := k.BlockedAccounts.Indexes.Channel.MatchExact(ctx, channel)
+	if err != nil {
+		return
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address, err := iterator.PrimaryKey()
+		if err != nil {
+			continue
+		}
+		value, err := k.BlockedAccounts.Get(ctx, address)
+		if err != nil {
+			continue
+		}
+
+		blocked = append(blocked, value)
+	}
+
+	return
+}
+
+func (k *Keeper) IsPausedChannel(ctx context.Context, channel string) bool {
+	has, _ := k.ChannelPauses.Has(ctx, channel)
+	return has
+}
+
+func (k *Keeper) GetPausedChannels(ctx context.Context) (channels []types.PausedChannel) {
+	_ = k.ChannelPauses.Walk(ctx, nil, func(channel string, rejectRegistrations bool) (stop bool, err error) {
+		channels = append(channels, types.PausedChannel{
+			Channel:             channel,
+			RejectRegistrations: rejectRegistrations,
+		})
+		return false, nil
+	})
+
+	return
+}
+
+// IsCircuitBreakerEnabled returns whether the module-wide circuit breaker is
+// enabled, suspending all automatic forwards and signerless registrations.
+func (k *Keeper) IsCircuitBreakerEnabled(ctx context.Context) bool {
+	enabled, _ := k.ConfiguredCircuitBreaker.Get(ctx)
+	return enabled
+}
+
+// IsUnwindOnlyEnabled returns whether the module-wide unwind-only mode is
+// enabled, only forwarding IBC vouchers that unwind along their own trace.
+func (k *Keeper) IsUnwindOnlyEnabled(ctx context.Context) bool {
+	enabled, _ := k.ConfiguredUnwindOnly.Get(ctx)
+	return enabled
+}
+
+// GetGuardian returns the address that, alongside the authority, is allowed
+// to toggle the circuit breaker. If no guardian has been configured, an empty
+// string is returned.
+func (k *Keeper) GetGuardian(ctx context.Context) string {
+	guardian, _ := k.ConfiguredGuardian.Get(ctx)
+	return guardian
+}
+
+func (k *Keeper) GetRateLimit(ctx context.Context, channel string, denom string) (types.RateLimit, bool) {
+	limit, err := k.ChannelRateLimits.Get(ctx, collections.Join(channel, denom))
+	return limit, err == nil
+}
+
+func (k *Keeper) GetAllRateLimits(ctx context.Context) (limits []types.RateLimit) {
+	_ = k.ChannelRateLimits.Walk(ctx, nil, func(_ collections.Pair[string, string], limit types.RateLimit) (stop bool, err error) {
+		limits = append(limits, limit)
+		return false, nil
+	})
+
+	return
+}
+
+// GetRateLimitUsage returns the usage of a channel and denom within the
+// trailing window of its rate limit. Buckets whose last forward is before the
+// start of the window are no longer included.
+func (k *Keeper) GetRateLimitUsage(ctx context.Context, channel string, denom string) types.RateLimitUsage {
+	now := k.headerService.GetHeaderInfo(ctx).Time.UnixNano()
+	current := types.RateLimitUsage{
+		Channel:     channel,
+		Denom:       denom,
+		Amount:      sdkmath.ZeroInt(),
+		WindowStart: now,
+	}
+
+	limit, found := k.GetRateLimit(ctx, channel, denom)
+	if !found {
+		return current
+	}
+	current.WindowStart = now - int64(limit.Window)
+
+	usage, err := k.RateLimitUsages.Get(ctx, collections.Join(channel, denom))
+	if err != nil {
+		return current
+	}
+
+	for _, bucket := range usage.Buckets {
+		if bucket.End > current.WindowStart {
+			current.Amount = current.Amount.Add(bucket.Amount)
+			current.Buckets = append(current.Buckets, bucket)
+		}
+	}
+
+	return current
+}
+
+func (k *Keeper) GetAllRateLimitUsages(ctx context.Context) (usages []types.RateLimitUsage) {
+	_ = k.RateLimitUsages.Walk(ctx, nil, func(_ collections.Pair[string, string], usage types.RateLimitUsage) (stop bool, err error) {
+		usages = append(usages, usage)
+		return false, nil
+	})
+
+	return
+}
+
+// IncrementRateLimitUsage records an amount forwarded through a channel
+// against its rate limit. Nothing is recorded if the channel and denom aren't
+// rate limited.
+func (k *Keeper) IncrementRateLimitUsage(ctx context.Context, channel string, coin sdk.Coin) {
+	limit, found := k.GetRateLimit(ctx, channel, coin.Denom)
+	if !found {
+		return
+	}
+
+	now := k.headerService.GetHeaderInfo(ctx).Time.UnixNano()
+	usage := k.GetRateLimitUsage(ctx, channel, coin.Denom)
+	usage.Amount = usage.Amount.Add(coin.Amount)
+
+	if last := len(usage.Buckets) - 1; last >= 0 && now-usage.Buckets[last].Start < limit.BucketWidth() {
+		usage.Buckets[last].End = now
+		usage.Buckets[last].Amount = usage.Buckets[last].Amount.Add(coin.Amount)
+	} else {
+		usage.Buckets = append(usage.Buckets, types.RateLimitBucket{Start: now, End: now, Amount: coin.Amount})
+	}
+
+	_ = k.RateLimitUsages.Set(ctx, collections.Join(channel, coin.Denom), usage)
+}
+
+func (k *Keeper) GetHeldDeposit(ctx context.Context, address string, eligibleAt int64) (types.HeldDeposit, bool) {
+	deposit, err := k.DepositHolds.Get(ctx, collections.Join(address, eligibleAt))
+	return deposit, err == nil
+}
+
+func (k *Keeper) SetHeldDeposit(ctx context.Context, deposit types.HeldDeposit) {
+	_ = k.DepositHolds.Set(ctx, collections.Join(deposit.Address, deposit.EligibleAt), deposit)
+}
+
+func (k *Keeper) RemoveHeldDeposit(ctx context.Context, address string, eligibleAt int64) {
+	_ = k.DepositHolds.Remove(ctx, collections.Join(address, eligibleAt))
+}
+
+// GetHeldDeposits returns all held deposits of a forwarding account, ordered
+// by the time they become eligible to be forwarded.
+func (k *Keeper) GetHeldDeposits(ctx context.Context, address string) (deposits []types.HeldDeposit) {
+	rng := collections.NewPrefixedPairRange[string, int64](address)
+	_ = k.DepositHolds.Walk(ctx, rng, func(_ collections.Pair[string, int64], deposit types.HeldDeposit) (stop bool, err error) {
+		deposits = append(deposits, deposit)
+		return false, nil
+	})
+
+	return
+}
+
+func (k *Keeper) GetAllHeldDeposits(ctx context.Context) (deposits []types.HeldDeposit) {
+	_ = k.DepositHolds.Walk(ctx, nil, func(_ collections.Pair[string, int64], deposit types.HeldDeposit) (stop bool, err error) {
+		deposits = append(deposits, deposit)
+		return false, nil
+	})
+
+	return
+}
+
+// GetHeldAmount returns the total amount held inside a forwarding account.
+func (k *Keeper) GetHeldAmount(ctx context.Context, address string) sdk.Coins {
+	held := sdk.NewCoins()
+	for _, deposit := range k.GetHeldDeposits(ctx, address) {
+		held = held.Add(deposit.Amount...)
+	}
+
+	return held
+}
+
+// GetDueHeldDeposits returns all held deposits that are eligible to be
+// forwarded at the specified time, in unix nanoseconds.
+func (k *Keeper) GetDueHeldDeposits(ctx context.Context, now int64) (deposits []types.HeldDeposit) {
+	rng := collections.NewPrefixUntilPairRange[int64, collections.Pair[string, int64]](now)
+	iterator, err := k.DepositHolds.Indexes.EligibleAt.Iterate(ctx, rng)
+	if err != nil {
+		return
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key, err := iterator.PrimaryKey()
+		if err != nil {
+			continue
+		}
+		value, err := k.DepositHolds.Get(ctx, key)
+		if err != nil {
+			continue
+		}
+
+		deposits = append(deposits, value)
+	}
+
+	return
+}
+
+// RemoveHeldDeposits removes all held deposits of a forwarding account.
+func (k *Keeper) RemoveHeldDeposits(ctx context.Context, address string) {
+	for _, deposit := range k.GetHeldDeposits(ctx, address) {
+		k.RemoveHeldDeposit(ctx, address, deposit.EligibleAt)
+	}
+}
+
+func (k *Keeper) GetBatch(ctx context.Context, address string) (types.Batch, bool) {
+	batch, err := k.Batches.Get(ctx, address)
+	return batch, err == nil
+}
+
+func (k *Keeper) SetBatch(ctx context.Context, batch types.Batch) {
+	_ = k.Batches.Set(ctx, batch.Address, batch)
+}
+
+func (k *Keeper) RemoveBatch(ctx context.Context, address string) {
+	_ = k.Batches.Remove(ctx, address)
+}
+
+func (k *Keeper) GetAllBatches(ctx context.Context) (batches []types.Batch) {
+	_ = k.Batches.Walk(ctx, nil, func(_ string, batch types.Batch) (stop bool, err error) {
+		batches = append(batches, batch)
+		return false, nil
+	})
+
+	return
+}
+
+// GetDueBatches returns all batches that have reached their maximum wait at
+// the specified time, in unix nanoseconds.
+func (k *Keeper) GetDueBatches(ctx context.Context, now int64) (batches []types.Batch) {
+	rng := collections.NewPrefixUntilPairRange[int64, string](now)
+	iterator, err := k.Batches.Indexes.ForwardAt.Iterate(ctx, rng)
+	if err != nil {
+		return
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key, err := iterator.PrimaryKey()
+		if err != nil {
+			continue
+		}
+		value, err := k.Batches.Get(ctx, key)
+		if err != nil {
+			continue
+		}
+
+		batches = append(batches, value)
+	}
+
+	return
+}
+
 // TRANSIENT STATE
 
 func (k *Keeper) GetPendingForwards(ctx context.Context) (accounts []types.ForwardingAccount) {
@@ -111,10 +972,25 @@ func (k *Keeper) GetPendingForwards(ctx context.Context) (accounts []types.Forwa
 	return
 }
 
+// SetPendingForward marks a forwarding account for forwarding at the end of
+// the current block. For accounts with a schedule, it additionally records
+// when deposits made in the current block become eligible to be forwarded.
 func (k *Keeper) SetPendingForward(ctx context.Context, account *types.ForwardingAccount) {
 	if found, err := k.PendingForwards.Has(ctx, account.Address); err != nil || found {
 		return
 	}
 
 	_ = k.PendingForwards.Set(ctx, account.Address, *account)
+
+	if !account.Schedule.IsZero() {
+		now := k.headerService.GetHeaderInfo(ctx).Time.UnixNano()
+		eligibleAt := account.Schedule.EligibleAt(now)
+		if _, found := k.GetHeldDeposit(ctx, account.Address, eligibleAt); eligibleAt > now && !found {
+			k.SetHeldDeposit(ctx, types.HeldDeposit{
+				Address:    account.Address,
+				EligibleAt: eligibleAt,
+				Amount:     sdk.NewCoins(),
+			})
+		}
+	}
 }
@@ -66,7 +66,12 @@ func (m Middleware) OnChanCloseInit(ctx sdk.Context, portID string, channelID st
 }
 
 func (m Middleware) OnChanCloseConfirm(ctx sdk.Context, portID string, channelID string) error {
-	return m.app.OnChanCloseConfirm(ctx, portID, channelID)
+	if err := m.app.OnChanCloseConfirm(ctx, portID, channelID); err != nil {
+		return err
+	}
+
+	m.keeper.HandleChannelClose(ctx, channelID)
+	return nil
 }
 
 // OnRecvPacket implements the porttypes.IBCModule interface.
@@ -90,16 +95,32 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, re
 		var memo types.RegisterAccountMemo
 		if err := types.ModuleCdc.UnmarshalJSON([]byte(transferData.GetMemo()), &memo); err == nil {
 			if memo.Noble != nil && memo.Noble.Forwarding != nil {
+				if m.keeper.IsCircuitBreakerEnabled(ctx) {
+					return channeltypes.NewErrorAcknowledgement(types.ErrCircuitBreakerEnabled)
+				}
+
+				// NOTE: Only accounts forwarding through IBC default to the
+				// channel that the registration was received on.
 				channel := packet.DestinationChannel
-				if memo.Noble.Forwarding.Channel != "" {
+				if memo.Noble.Forwarding.Channel != "" || !types.IsIBCDestination(memo.Noble.Forwarding.DestinationType) {
 					channel = memo.Noble.Forwarding.Channel
 				}
 
 				req := &types.MsgRegisterAccount{
-					Signer:    authtypes.NewModuleAddress(types.ModuleName).String(),
-					Recipient: memo.Noble.Forwarding.Recipient,
-					Channel:   channel,
-					Fallback:  memo.Noble.Forwarding.Fallback,
+					Signer:          authtypes.NewModuleAddress(types.ModuleName).String(),
+					Recipient:       memo.Noble.Forwarding.Recipient,
+					Channel:         channel,
+					Fallback:        memo.Noble.Forwarding.Fallback,
+					Memo:            memo.Noble.Forwarding.Memo,
+					Hops:            memo.Noble.Forwarding.Hops,
+					Schedule:        memo.Noble.Forwarding.Schedule,
+					Splits:          memo.Noble.Forwarding.Splits,
+					Batching:        memo.Noble.Forwarding.Batching,
+					DenomRoutes:     memo.Noble.Forwarding.DenomRoutes,
+					AllowedDenoms:   memo.Noble.Forwarding.AllowedDenoms,
+					UnwindOnly:      memo.Noble.Forwarding.UnwindOnly,
+					DestinationType: memo.Noble.Forwarding.DestinationType,
+					RouteData:       memo.Noble.Forwarding.RouteData,
 				}
 
 				_, err := m.keeper.RegisterAccount(ctx, req)
@@ -132,15 +153,29 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, re
 		return m.app.OnRecvPacket(ctx, packet, relayer)
 	}
 
+	if m.keeper.IsCircuitBreakerEnabled(ctx) {
+		return channeltypes.NewErrorAcknowledgement(types.ErrCircuitBreakerEnabled)
+	}
+
 	channel := packet.DestinationChannel
-	if data.Channel != "" {
+	if data.Channel != "" || !types.IsIBCDestination(data.DestinationType) {
 		channel = data.Channel
 	}
 
 	req := &types.MsgRegisterAccount{
-		Recipient: data.Recipient,
-		Channel:   channel,
-		Fallback:  data.Fallback,
+		Recipient:       data.Recipient,
+		Channel:         channel,
+		Fallback:        data.Fallback,
+		Memo:            data.Memo,
+		Hops:            data.Hops,
+		Schedule:        data.Schedule,
+		Splits:          data.Splits,
+		Batching:        data.Batching,
+		DenomRoutes:     data.DenomRoutes,
+		AllowedDenoms:   data.AllowedDenoms,
+		UnwindOnly:      data.UnwindOnly,
+		DestinationType: data.DestinationType,
+		RouteData:       data.RouteData,
 	}
 
 	res, err := m.keeper.RegisterAccount(ctx, req)
@@ -152,9 +187,21 @@ func (m Middleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, re
 }
 
 func (m Middleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
-	return m.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+	pending := m.keeper.HasPendingForward(ctx, packet)
+	if err := m.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	m.keeper.HandleAcknowledgement(ctx, packet, acknowledgement, pending)
+	return nil
 }
 
 func (m Middleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
-	return m.app.OnTimeoutPacket(ctx, packet, relayer)
+	pending := m.keeper.HasPendingForward(ctx, packet)
+	if err := m.app.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	m.keeper.HandleTimeout(ctx, packet, pending)
+	return nil
 }
@@ -24,6 +24,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/core/appmodule"
@@ -155,9 +156,41 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
 				{
 					RpcMethod: "RegisterAccount",
-					Use:       "register-account [channel] [recipient] (fallback)",
+					Use:       "register-account [channel] [recipient] (fallback) (--memo) (--hops) (--schedule) (--splits) (--batching) (--denom-routes) (--allowed-denoms) (--unwind-only) (--destination-type) (--route-data)",
 					Short:     "Register a forwarding account for a channel and recipient",
-					Long:      "Register a forwarding account for a channel and recipient, with an optional fallback address",
+					Long:      "Register a forwarding account for a channel and recipient, with an optional fallback address, memo, hops, schedule, splits, batching, denom routes, allowed denoms, unwind-only mode and non IBC destination",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"memo": {
+							Usage: "Memo included in forwards from the account",
+						},
+						"hops": {
+							Usage: `Additional hop executed after each forward, e.g. '{"channel":"channel-0","receiver":"cosmos1..."}'`,
+						},
+						"schedule": {
+							Usage: `Schedule of when deposits are forwarded, e.g. '{"hold_duration":"3600000000000"}'`,
+						},
+						"splits": {
+							Usage: `Share that deposits are split into, in basis points, e.g. '{"channel":"channel-0","recipient":"cosmos1...","bps":7000}'`,
+						},
+						"batching": {
+							Usage: `Batching of deposits before they are forwarded, e.g. '{"targets":[{"denom":"uusdc","amount":"1000000000"}],"max_wait":"86400000000000"}'`,
+						},
+						"denom_routes": {
+							Usage: `Route of a specific denom, e.g. '{"denom":"uusdc","channel":"channel-1","recipient":"osmo1..."}'`,
+						},
+						"allowed_denoms": {
+							Usage: "Denoms that are forwarded from the account, all other denoms are left in the account",
+						},
+						"unwind_only": {
+							Usage: "Only forward IBC vouchers that unwind along their own trace",
+						},
+						"destination_type": {
+							Usage: "Type of destination that the account forwards to, e.g. local, defaults to ibc",
+						},
+						"route_data": {
+							Usage: "Hex encoded route data interpreted by the destination type",
+						},
+					},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "channel"},
 						{ProtoField: "recipient"},
@@ -184,6 +217,147 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						Varargs:    true,
 					}},
 				},
+				{
+					RpcMethod: "SetBlockedDenoms",
+					Use:       "set-blocked-denoms [denoms ...]",
+					Short:     "Set the list of denoms that are blocked from being forwarded",
+					Long:      "Set the list of denoms that are blocked from being forwarded, even if all denoms are allowed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{
+						ProtoField: "denoms",
+						Varargs:    true,
+					}},
+				},
+				{
+					RpcMethod:      "SetRefundPolicy",
+					Use:            "set-refund-policy [policy]",
+					Short:          "Set the policy applied to refunds of failed forwards",
+					Long:           "Set the policy applied to refunds of failed forwards, one of hold, retry or fallback",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "policy"}},
+				},
+				{
+					RpcMethod: "SetTimeoutPolicy",
+					Use:       "set-timeout-policy [channel] [timestamp] [height]",
+					Short:     "Set the timeout policy of automatic forwards through a channel",
+					Long:      "Set the relative timeout timestamp (in nanoseconds) and height of automatic forwards through a channel. Setting both to zero restores the default timeout policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "timestamp"},
+						{ProtoField: "height"},
+					},
+				},
+				{
+					RpcMethod:      "SetMaxMemoLength",
+					Use:            "set-max-memo-length [max-memo-length]",
+					Short:          "Set the maximum length of forwarding account memos",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "max_memo_length"}},
+				},
+				{
+					RpcMethod: "SetMinForwardAmount",
+					Use:       "set-min-forward-amount [denom] [amount]",
+					Short:     "Set the minimum amount of a denom that is forwarded",
+					Long:      "Set the minimum amount of a denom that is forwarded, below which balances remain in forwarding accounts. Setting the amount to zero removes the minimum",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "amount"},
+					},
+				},
+				{
+					RpcMethod: "SetExecutionLimits",
+					Use:       "set-execution-limits [max-forwards-per-block] [max-gas-per-block]",
+					Short:     "Set the limits of automatic forwards executed per block",
+					Long:      "Set the maximum number of, and gas consumed by, automatic forwards executed per block. Forwards exceeding the limits are queued for later blocks. Setting a limit to zero disables it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "max_forwards_per_block"},
+						{ProtoField: "max_gas_per_block"},
+					},
+				},
+				{
+					RpcMethod: "SetFeeSchedule",
+					Use:       "set-fee-schedule [denom] [percentage] [flat]",
+					Short:     "Set the protocol fee deducted from automatic forwards of a denom",
+					Long:      "Set the percentage and flat amount deducted from automatic forwards of a denom. Setting both to zero removes the fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "denom"},
+						{ProtoField: "percentage"},
+						{ProtoField: "flat"},
+					},
+				},
+				{
+					RpcMethod:      "SetFeeRecipient",
+					Use:            "set-fee-recipient [recipient]",
+					Short:          "Set the address that protocol fees are sent to",
+					Long:           "Set the address that protocol fees are sent to. Setting an empty recipient sends fees to the fee collector again",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "SetRelayerFee",
+					Use:            "set-relayer-fee [channel]",
+					Short:          "Set the ICS-29 relayer fees escrowed for automatic forwards through a channel",
+					Long:           "Set the recv, ack and timeout fees escrowed for automatic forwards through a fee enabled channel, and whether they are paid from the forwarded amount or the module account. Setting all fees to empty removes the relayer fee",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod:      "SetFallbackGracePeriod",
+					Use:            "set-fallback-grace-period [grace-period]",
+					Short:          "Set how long an account can be blocked by a non open channel before its funds are sent to its fallback address",
+					Long:           "Set how long, in nanoseconds, an account can be blocked by a non open channel before its funds are swept to its fallback address. Setting the grace period to zero disables the automatic fallback",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "grace_period"}},
+				},
+				{
+					RpcMethod:      "PauseChannel",
+					Use:            "pause-channel [channel]",
+					Short:          "Pause automatic forwards through a channel",
+					Long:           "Pause automatic forwards through a channel. Forwards are queued until the channel is resumed. Optionally, new registrations on the channel can be rejected while it is paused",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod:      "ResumeChannel",
+					Use:            "resume-channel [channel]",
+					Short:          "Resume automatic forwards through a paused channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod:      "SetCircuitBreaker",
+					Use:            "set-circuit-breaker [enabled]",
+					Short:          "Enable or disable the module-wide circuit breaker",
+					Long:           "Enable or disable the module-wide circuit breaker. While enabled, all automatic forwards are queued, and signerless and IBC registrations are rejected. Can be signed by either the authority or the guardian",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "enabled"}},
+				},
+				{
+					RpcMethod:      "SetGuardian",
+					Use:            "set-guardian [guardian]",
+					Short:          "Set the guardian allowed to toggle the circuit breaker",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "guardian"}},
+				},
+				{
+					RpcMethod: "SetRateLimit",
+					Use:       "set-rate-limit [channel] [denom] [amount] [window]",
+					Short:     "Set the maximum amount of a denom forwarded through a channel within a window",
+					Long:      "Set the maximum amount of a denom forwarded through a channel within a window, in nanoseconds. Forwards exceeding the limit are queued until enough of the quota is available again. Setting the amount to zero removes the limit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "denom"},
+						{ProtoField: "amount"},
+						{ProtoField: "window"},
+					},
+				},
+				{
+					RpcMethod: "SetChannelAllowedDenoms",
+					Use:       "set-channel-allowed-denoms [channel] [denoms ...]",
+					Short:     "Set the list of denoms that are allowed to be forwarded through a channel",
+					Long:      "Set the list of denoms that are allowed to be forwarded through a channel, taking precedence over the globally allowed denoms. Setting no denoms removes the list",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "denoms", Varargs: true},
+					},
+				},
+				{
+					RpcMethod:      "SetUnwindOnly",
+					Use:            "set-unwind-only [enabled]",
+					Short:          "Enable or disable the module-wide unwind-only mode",
+					Long:           "Enable or disable the module-wide unwind-only mode. While it is enabled, IBC vouchers are only forwarded if they unwind along their own trace, and are otherwise sent to the fallback address or left in the account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "enabled"}},
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -193,13 +367,45 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				{
 					RpcMethod: "Denoms",
 					Use:       "denoms",
-					Short:     "Query denoms that are allowed to be forwarded",
+					Short:     "Query denoms that are allowed and blocked from being forwarded",
 				},
 				{
 					RpcMethod: "Address",
-					Use:       "address [channel] [recipient] (fallback)",
+					Use:       "address [channel] [recipient] (fallback) (--memo) (--hops) (--schedule) (--splits) (--batching) (--denom-routes) (--allowed-denoms) (--unwind-only) (--destination-type) (--route-data)",
 					Short:     "Query forwarding address by channel and recipient",
-					Long:      "Query forwarding address by channel and recipient, with an optional fallback address",
+					Long:      "Query forwarding address by channel and recipient, with an optional fallback address, memo, hops, schedule, splits, batching, denom routes, allowed denoms, unwind-only mode and non IBC destination",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"memo": {
+							Usage: "Memo included in forwards from the account",
+						},
+						"hops": {
+							Usage: `Additional hop executed after each forward, e.g. '{"channel":"channel-0","receiver":"cosmos1..."}'`,
+						},
+						"schedule": {
+							Usage: `Schedule of when deposits are forwarded, e.g. '{"hold_duration":"3600000000000"}'`,
+						},
+						"splits": {
+							Usage: `Share that deposits are split into, in basis points, e.g. '{"channel":"channel-0","recipient":"cosmos1...","bps":7000}'`,
+						},
+						"batching": {
+							Usage: `Batching of deposits before they are forwarded, e.g. '{"targets":[{"denom":"uusdc","amount":"1000000000"}],"max_wait":"86400000000000"}'`,
+						},
+						"denom_routes": {
+							Usage: `Route of a specific denom, e.g. '{"denom":"uusdc","channel":"channel-1","recipient":"osmo1..."}'`,
+						},
+						"allowed_denoms": {
+							Usage: "Denoms that are forwarded from the account, all other denoms are left in the account",
+						},
+						"unwind_only": {
+							Usage: "Only forward IBC vouchers that unwind along their own trace",
+						},
+						"destination_type": {
+							Usage: "Type of destination that the account forwards to, e.g. local, defaults to ibc",
+						},
+						"route_data": {
+							Usage: "Hex encoded route data interpreted by the destination type",
+						},
+					},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "channel"},
 						{ProtoField: "recipient"},
@@ -215,6 +421,122 @@ func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "StatsByChannel",
 					Skip:      true,
 				},
+				{
+					RpcMethod: "Retries",
+					Use:       "retries",
+					Short:     "Query failed forwards that are queued for retry",
+				},
+				{
+					RpcMethod: "RefundPolicy",
+					Use:       "refund-policy",
+					Short:     "Query the policy applied to refunds of failed forwards",
+				},
+				{
+					RpcMethod: "MaxMemoLength",
+					Use:       "max-memo-length",
+					Short:     "Query the maximum length of forwarding account memos",
+				},
+				{
+					RpcMethod:      "MinForwardAmount",
+					Use:            "min-forward-amount [denom]",
+					Short:          "Query the minimum amount of a denom that is forwarded",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "ExecutionLimits",
+					Use:       "execution-limits",
+					Short:     "Query the limits of automatic forwards executed per block",
+				},
+				{
+					RpcMethod: "QueueDepth",
+					Use:       "queue-depth",
+					Short:     "Query the number of automatic forwards queued for later blocks",
+				},
+				{
+					RpcMethod:      "FeeSchedule",
+					Use:            "fee-schedule [denom]",
+					Short:          "Query the protocol fee deducted from automatic forwards of a denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+				{
+					RpcMethod: "FeeRecipient",
+					Use:       "fee-recipient",
+					Short:     "Query the address that protocol fees are sent to",
+				},
+				{
+					RpcMethod:      "RelayerFee",
+					Use:            "relayer-fee [channel]",
+					Short:          "Query the ICS-29 relayer fees escrowed for automatic forwards through a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod: "FallbackGracePeriod",
+					Use:       "fallback-grace-period",
+					Short:     "Query how long an account can be blocked by a non open channel before its funds are sent to its fallback address",
+				},
+				{
+					RpcMethod: "BlockedForwards",
+					Use:       "blocked-forwards",
+					Short:     "Query forwarding accounts blocked by a non open channel",
+				},
+				{
+					RpcMethod: "PausedChannels",
+					Use:       "paused-channels",
+					Short:     "Query channels that automatic forwards are paused on",
+				},
+				{
+					RpcMethod: "CircuitBreaker",
+					Use:       "circuit-breaker",
+					Short:     "Query the status of the circuit breaker and the guardian",
+				},
+				{
+					RpcMethod: "RateLimits",
+					Use:       "rate-limits",
+					Short:     "Query the rate limits of automatic forwards",
+				},
+				{
+					RpcMethod: "RateLimitUsage",
+					Use:       "rate-limit-usage [channel] [denom]",
+					Short:     "Query the usage of a rate limit within its current window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "channel"},
+						{ProtoField: "denom"},
+					},
+				},
+				{
+					RpcMethod:      "HeldDeposits",
+					Use:            "held-deposits [address]",
+					Short:          "Query the deposits held inside a scheduled forwarding account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Batch",
+					Use:            "batch [address]",
+					Short:          "Query the funds accumulating inside a forwarding account with batching",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "ChannelDenoms",
+					Use:            "channel-denoms [channel]",
+					Short:          "Query denoms that are allowed to be forwarded through a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod: "UnwindOnly",
+					Use:       "unwind-only",
+					Short:     "Query whether the module-wide unwind-only mode is enabled",
+				},
+				{
+					RpcMethod:      "TimeoutPolicy",
+					Use:            "timeout-policy [channel]",
+					Short:          "Query the timeout policy of automatic forwards through a channel",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel"}},
+				},
+				{
+					RpcMethod: "TimeoutPolicies",
+					Use:       "timeout-policies",
+					Short:     "Query the effective timeout policy of automatic forwards through every transfer channel",
+				},
 			},
 			EnhanceCustomCommand: true,
 		},
@@ -234,6 +556,7 @@ func (AppModule) GetQueryCmd() *cobra.Command {
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetForwardingHooks, InvokeRegisterRouters),
 	)
 }
 
@@ -278,8 +601,58 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		nil,
 		nil,
+		nil,
 	)
 	m := NewAppModule(k)
 
 	return ModuleOutputs{Keeper: k, Module: m, Restriction: k.SendRestrictionFn}
 }
+
+// InvokeSetForwardingHooks sets the forwarding hooks provided by other
+// modules. It is invoked after all modules are provided, so that modules whose
+// hooks depend on this keeper don't create a dependency cycle. The hooks are
+// called in the alphabetical order of their modules, so that the order is
+// deterministic.
+func InvokeSetForwardingHooks(k *keeper.Keeper, wrappers map[string]types.ForwardingHooksWrapper) error {
+	// NOTE: All arguments to invokers are optional.
+	if k == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(wrappers))
+	for name := range wrappers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	hooks := make(types.MultiForwardingHooks, 0, len(names))
+	for _, name := range names {
+		hooks = append(hooks, wrappers[name])
+	}
+	k.SetHooks(hooks)
+
+	return nil
+}
+
+// InvokeRegisterRouters registers the routers provided by other modules, each
+// under the name of its module. Like the hooks, routers are registered after
+// all modules are provided, so that routers depending on this keeper don't
+// create a dependency cycle.
+func InvokeRegisterRouters(k *keeper.Keeper, wrappers map[string]types.ForwardingRouterWrapper) error {
+	// NOTE: All arguments to invokers are optional.
+	if k == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(wrappers))
+	for name := range wrappers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		k.RegisterRouter(name, wrappers[name].Router)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountAllowedDenoms(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+	fallback := chain.SenderAccounts[1].SenderAccount.GetAddress()
+
+	denoms := []string{sdk.DefaultBondDenom}
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient", Fallback: fallback.String(), AllowedDenoms: denoms})
+	require.NotEqual(t, types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient", Fallback: fallback.String()}), address)
+
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:        chain.SenderAccount.GetAddress().String(),
+		Recipient:     "cosmos1recipient",
+		Channel:       channel,
+		Fallback:      fallback.String(),
+		AllowedDenoms: denoms,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account with an allowed denom and a spam denom, and
+	// execute the forward.
+	ctx := chain.GetContext()
+	spam := sdk.NewCoins(sdk.NewInt64Coin("uspam", 300))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, spam))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, address, spam))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: Only the allowed denom was forwarded.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, sdk.DefaultBondDenom, packets[0].Denom)
+	require.Equal(t, spam, app.BankKeeper.GetAllBalances(ctx, address))
+
+	// ACT: Clear the account to its fallback.
+	_, err = app.ForwardingKeeper.ClearAccount(ctx, &types.MsgClearAccount{
+		Signer:   chain.SenderAccount.GetAddress().String(),
+		Address:  address.String(),
+		Fallback: true,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The spam denom was sent to the fallback.
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, "300", app.BankKeeper.GetBalance(ctx, fallback, "uspam").Amount.String())
+}
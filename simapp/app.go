@@ -41,8 +41,10 @@ import (
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	// IBC Modules
 	capabilitykeeper "github.com/cosmos/ibc-go/modules/capability/keeper"
+	ibcfeekeeper "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/keeper"
 	transferkeeper "github.com/cosmos/ibc-go/v8/modules/apps/transfer/keeper"
 	ibckeeper "github.com/cosmos/ibc-go/v8/modules/core/keeper"
+	ibctestingtypes "github.com/cosmos/ibc-go/v8/testing/types"
 	// Custom Modules
 	forwardingkeeper "github.com/noble-assets/forwarding/v2/keeper"
 )
@@ -75,9 +77,12 @@ type SimApp struct {
 	StakingKeeper   *stakingkeeper.Keeper
 	UpgradeKeeper   *upgradekeeper.Keeper
 	// IBC Modules
-	CapabilityKeeper *capabilitykeeper.Keeper
-	IBCKeeper        *ibckeeper.Keeper
-	TransferKeeper   transferkeeper.Keeper
+	CapabilityKeeper     *capabilitykeeper.Keeper
+	IBCKeeper            *ibckeeper.Keeper
+	IBCFeeKeeper         ibcfeekeeper.Keeper
+	TransferKeeper       transferkeeper.Keeper
+	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
 	// Custom Modules
 	ForwardingKeeper *forwardingkeeper.Keeper
 }
@@ -156,7 +161,8 @@ func NewSimApp(
 			SignModeHandler: app.txConfig.SignModeHandler(),
 			SigGasConsumer:  forwarding.SigVerificationGasConsumer,
 		},
-		BankKeeper: app.BankKeeper,
+		BankKeeper:       app.BankKeeper,
+		ForwardingKeeper: app.ForwardingKeeper,
 	})
 	if err != nil {
 		return nil, err
@@ -182,6 +188,32 @@ func (app *SimApp) SimulationManager() *module.SimulationManager {
 	return nil
 }
 
+// IBC TESTING
+
+func (app *SimApp) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
+func (app *SimApp) GetBaseApp() *baseapp.BaseApp {
+	return app.App.BaseApp
+}
+
+func (app *SimApp) GetStakingKeeper() ibctestingtypes.StakingKeeper {
+	return app.StakingKeeper
+}
+
+func (app *SimApp) GetIBCKeeper() *ibckeeper.Keeper {
+	return app.IBCKeeper
+}
+
+func (app *SimApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
+	return app.ScopedIBCKeeper
+}
+
+func (app *SimApp) GetTxConfig() client.TxConfig {
+	return app.txConfig
+}
+
 //
 
 func (app *SimApp) GetKey(storeKey string) *storetypes.KVStoreKey {
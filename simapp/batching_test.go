@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchedForward(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+
+	batching := types.Batching{
+		Targets: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)),
+		MaxWait: uint64(time.Hour),
+	}
+	recipient := path.EndpointB.Chain.SenderAccount.GetAddress().String()
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: recipient, Batching: batching})
+
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: recipient,
+		Channel:   path.EndpointA.ChannelID,
+		Batching:  batching,
+	})
+	require.NoError(t, err)
+
+	// ACT: Deposit less than the target.
+	deposit(t, chain, address, 400)
+
+	// ASSERT: The deposit accumulates inside the account.
+	ctx := chain.GetContext()
+	_, found := app.ForwardingKeeper.GetBatch(ctx, address.String())
+	require.True(t, found)
+	require.True(t, app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).IsZero())
+
+	// ACT: Deposit enough to reach the target.
+	deposit(t, chain, address, 700)
+
+	// ASSERT: The entire balance was forwarded.
+	ctx = chain.GetContext()
+	_, found = app.ForwardingKeeper.GetBatch(ctx, address.String())
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(1_100), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Deposit less than the target, and wait for the maximum wait.
+	deposit(t, chain, address, 100)
+	require.Equal(t, sdkmath.NewInt(100), app.BankKeeper.GetBalance(chain.GetContext(), address, sdk.DefaultBondDenom).Amount)
+	chain.Coordinator.IncrementTimeBy(time.Hour)
+	chain.NextBlock()
+
+	// ASSERT: The balance was forwarded once the maximum wait passed.
+	ctx = chain.GetContext()
+	_, found = app.ForwardingKeeper.GetBatch(ctx, address.String())
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(1_200), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+}
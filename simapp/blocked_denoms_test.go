@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockedDenoms(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+
+	// ACT: Attempt to block denoms without the authority, or block the
+	// wildcard.
+	_, err := app.ForwardingKeeper.SetBlockedDenoms(chain.GetContext(), &types.MsgSetBlockedDenoms{
+		Signer: chain.SenderAccount.GetAddress().String(),
+		Denoms: []string{sdk.DefaultBondDenom},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = app.ForwardingKeeper.SetBlockedDenoms(chain.GetContext(), &types.MsgSetBlockedDenoms{
+		Signer: authority,
+		Denoms: []string{"*"},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidBlockedDenoms)
+
+	// ACT: Block a denom, while all denoms are allowed.
+	ctx := chain.GetContext()
+	_, err = app.ForwardingKeeper.SetBlockedDenoms(ctx, &types.MsgSetBlockedDenoms{
+		Signer: authority,
+		Denoms: []string{sdk.DefaultBondDenom},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"*"}, app.ForwardingKeeper.GetAllowedDenoms(ctx))
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, path.EndpointA.ChannelID, "uusdc"))
+	require.Equal(t, []string{sdk.DefaultBondDenom}, forwarding.ExportGenesis(ctx, app.ForwardingKeeper).BlockedDenoms)
+
+	address := forward(t, path, 1_000)
+	chain.NextBlock()
+
+	// ASSERT: The blocked denom wasn't forwarded.
+	ctx = chain.GetContext()
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Unblock the denom, and clear the account.
+	_, err = app.ForwardingKeeper.SetBlockedDenoms(ctx, &types.MsgSetBlockedDenoms{Signer: authority})
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(&types.MsgClearAccount{
+		Signer:  chain.SenderAccount.GetAddress().String(),
+		Address: address.String(),
+	})
+	require.NoError(t, err)
+	chain.NextBlock()
+
+	// ASSERT: The denom was forwarded.
+	require.True(t, app.BankKeeper.GetAllBalances(chain.GetContext(), address).IsZero())
+}
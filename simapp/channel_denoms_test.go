@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelAllowedDenoms(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	_, err := app.ForwardingKeeper.SetChannelAllowedDenoms(chain.GetContext(), &types.MsgSetChannelAllowedDenoms{
+		Signer:  chain.SenderAccount.GetAddress().String(),
+		Channel: channel,
+		Denoms:  []string{"uusdc"},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Only allow USDC on the channel, while all denoms are allowed
+	// globally.
+	ctx := chain.GetContext()
+	_, err = app.ForwardingKeeper.SetChannelAllowedDenoms(ctx, &types.MsgSetChannelAllowedDenoms{
+		Signer:  authority,
+		Channel: channel,
+		Denoms:  []string{"uusdc"},
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The channel scoped denoms take precedence over the global ones.
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, "uusdc"))
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, sdk.DefaultBondDenom))
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, "channel-99", sdk.DefaultBondDenom))
+
+	res, err := app.ForwardingKeeper.ChannelDenoms(ctx, &types.QueryChannelDenoms{Channel: channel})
+	require.NoError(t, err)
+	require.Equal(t, []string{"uusdc"}, res.AllowedDenoms)
+	require.True(t, res.Scoped)
+	require.Equal(t, []types.ChannelAllowedDenoms{{Channel: channel, Denoms: []string{"uusdc"}}}, forwarding.ExportGenesis(ctx, app.ForwardingKeeper).ChannelAllowedDenoms)
+
+	address := forward(t, path, 1_000)
+	chain.NextBlock()
+
+	// ASSERT: The denom that isn't allowed on the channel wasn't forwarded.
+	ctx = chain.GetContext()
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Remove the channel scoped denoms.
+	_, err = app.ForwardingKeeper.SetChannelAllowedDenoms(ctx, &types.MsgSetChannelAllowedDenoms{
+		Signer:  authority,
+		Channel: channel,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The channel falls back to the global allowed denoms.
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, sdk.DefaultBondDenom))
+	res, err = app.ForwardingKeeper.ChannelDenoms(ctx, &types.QueryChannelDenoms{Channel: channel})
+	require.NoError(t, err)
+	require.False(t, res.Scoped)
+
+	// ACT: Allow denoms by a pattern on the channel.
+	_, err = app.ForwardingKeeper.SetChannelAllowedDenoms(ctx, &types.MsgSetChannelAllowedDenoms{
+		Signer:  authority,
+		Channel: channel,
+		Denoms:  []string{"factory/noble1creator/*", "uusdc"},
+	})
+	require.NoError(t, err)
+
+	// ASSERT: Only the pattern is indexed, and denoms are matched by it.
+	require.Equal(t, []string{"factory/noble1creator/*"}, app.ForwardingKeeper.GetChannelAllowedDenomPatterns(ctx, channel))
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, "factory/noble1creator/ufoo"))
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, "uusdc"))
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, sdk.DefaultBondDenom))
+
+	// ACT: Remove the channel scoped denoms.
+	_, err = app.ForwardingKeeper.SetChannelAllowedDenoms(ctx, &types.MsgSetChannelAllowedDenoms{
+		Signer:  authority,
+		Channel: channel,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The pattern was removed from the index.
+	require.Empty(t, app.ForwardingKeeper.GetChannelAllowedDenomPatterns(ctx, channel))
+}
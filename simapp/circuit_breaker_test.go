@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	guardian := chain.SenderAccount.GetAddress().String()
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+
+	// ARRANGE: Only the authority can toggle the circuit breaker.
+	_, err := app.ForwardingKeeper.SetCircuitBreaker(chain.GetContext(), &types.MsgSetCircuitBreaker{
+		Signer:  guardian,
+		Enabled: true,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Configure a guardian, and enable the circuit breaker.
+	_, err = app.ForwardingKeeper.SetGuardian(chain.GetContext(), &types.MsgSetGuardian{
+		Signer:   authority,
+		Guardian: guardian,
+	})
+	require.NoError(t, err)
+	_, err = app.ForwardingKeeper.SetCircuitBreaker(chain.GetContext(), &types.MsgSetCircuitBreaker{
+		Signer:  guardian,
+		Enabled: true,
+	})
+	require.NoError(t, err)
+
+	address := forward(t, path, 1_000)
+	chain.NextBlock()
+
+	// ASSERT: The forward is queued, and the funds are held.
+	ctx := chain.GetContext()
+	require.True(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).IsZero())
+
+	// ACT: Lift the circuit breaker.
+	_, err = app.ForwardingKeeper.SetCircuitBreaker(ctx, &types.MsgSetCircuitBreaker{
+		Signer:  authority,
+		Enabled: false,
+	})
+	require.NoError(t, err)
+	chain.NextBlock()
+
+	// ASSERT: The queued forward was executed.
+	ctx = chain.GetContext()
+	require.False(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+}
+
+func TestCircuitBreakerRejectsPacketRegistrations(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	ctx := chain.GetContext()
+
+	_, err := app.ForwardingKeeper.SetCircuitBreaker(ctx, &types.MsgSetCircuitBreaker{
+		Signer:  authority,
+		Enabled: true,
+	})
+	require.NoError(t, err)
+
+	data := types.RegisterAccountData{
+		Recipient: path.EndpointB.Chain.SenderAccount.GetAddress().String(),
+		Channel:   path.EndpointA.ChannelID,
+	}
+	packet := channeltypes.NewPacket(
+		types.ModuleCdc.MustMarshalJSON(&data), 1,
+		transfertypes.PortID, path.EndpointB.ChannelID,
+		transfertypes.PortID, path.EndpointA.ChannelID,
+		clienttypes.NewHeight(1, 100), 0,
+	)
+
+	// ACT: Receive a registration packet through the transfer stack.
+	module, found := app.IBCKeeper.Router.GetRoute(transfertypes.ModuleName)
+	require.True(t, found)
+	ack := module.OnRecvPacket(ctx, packet, chain.SenderAccount.GetAddress())
+
+	// ASSERT: The registration was rejected.
+	require.False(t, ack.Success())
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: data.Recipient})
+	require.Nil(t, app.AccountKeeper.GetAccount(ctx, address))
+}
+
+// registrationTx is a transaction that only contains a registration.
+type registrationTx struct {
+	msg *types.MsgRegisterAccount
+}
+
+func (tx registrationTx) GetMsgs() []sdk.Msg { return []sdk.Msg{tx.msg} }
+
+func (tx registrationTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+// rejectingDecorator is an ante decorator that rejects every transaction.
+type rejectingDecorator struct{}
+
+func (rejectingDecorator) AnteHandle(ctx sdk.Context, _ sdk.Tx, _ bool, _ sdk.AnteHandler) (sdk.Context, error) {
+	return ctx, errors.New("rejected by underlying decorator")
+}
+
+func TestSigVerificationDecoratorCircuitBreaker(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	ctx := chain.GetContext()
+
+	// ARRANGE: Fund a forwarding account that can be registered signerlessly.
+	msg := &types.MsgRegisterAccount{
+		Recipient: "cosmos1recipient",
+		Channel:   path.EndpointA.ChannelID,
+	}
+	address := types.GenerateAddress(msg.ForwardingAccount())
+	msg.Signer = address.String()
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+
+	_, err := app.ForwardingKeeper.SetCircuitBreaker(ctx, &types.MsgSetCircuitBreaker{
+		Signer:  authority,
+		Enabled: true,
+	})
+	require.NoError(t, err)
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	// ACT: Verify the registration while the circuit breaker is enabled.
+	decorator := forwarding.NewSigVerificationDecorator(app.BankKeeper, app.ForwardingKeeper, rejectingDecorator{})
+	_, err = decorator.AnteHandle(ctx, registrationTx{msg}, false, next)
+
+	// ASSERT: The registration was rejected.
+	require.ErrorIs(t, err, types.ErrCircuitBreakerEnabled)
+
+	// ACT: Verify the registration without a circuit breaker.
+	decorator = forwarding.NewSigVerificationDecorator(app.BankKeeper, nil, rejectingDecorator{})
+	_, err = decorator.AnteHandle(ctx, registrationTx{msg}, false, next)
+
+	// ASSERT: The registration was accepted without signature verification.
+	require.NoError(t, err)
+}
@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllowedDenomPatterns(t *testing.T) {
+	path, app := setupTransferPath(t)
+	ctx := path.EndpointA.Chain.GetContext()
+	channel := path.EndpointA.ChannelID
+
+	atom := transfertypes.DenomTrace{Path: "transfer/channel-4", BaseDenom: "uatom"}
+	osmo := transfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uosmo"}
+	app.TransferKeeper.SetDenomTrace(ctx, atom)
+	app.TransferKeeper.SetDenomTrace(ctx, osmo)
+
+	_, err := app.ForwardingKeeper.SetAllowedDenoms(ctx, &types.MsgSetAllowedDenoms{
+		Signer: authority,
+		Denoms: []string{"factory/noble1creator/*", "base:uatom", "uusdc"},
+	})
+	require.NoError(t, err)
+
+	// ASSERT: Only the patterns are indexed, so that exact denoms are looked
+	// up directly.
+	require.ElementsMatch(t, []string{"factory/noble1creator/*", "base:uatom"}, app.ForwardingKeeper.GetAllowedDenomPatterns(ctx))
+
+	// ASSERT: Denoms are matched exactly, by prefix, or by base denom.
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, "uusdc"))
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, "factory/noble1creator/ufoo"))
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, "factory/noble1other/ufoo"))
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, atom.IBCDenom()))
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, osmo.IBCDenom()))
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, "uatom"))
+
+	// ACT: Allow vouchers by their path.
+	_, err = app.ForwardingKeeper.SetAllowedDenoms(ctx, &types.MsgSetAllowedDenoms{
+		Signer: authority,
+		Denoms: []string{"path:transfer/channel-1"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"path:transfer/channel-1"}, app.ForwardingKeeper.GetAllowedDenomPatterns(ctx))
+
+	// ASSERT: Only vouchers received through the path are allowed.
+	require.True(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, osmo.IBCDenom()))
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, atom.IBCDenom()))
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, transfertypes.DenomTrace{Path: "transfer/channel-1", BaseDenom: "unknown"}.IBCDenom()))
+
+	// ACT: Block a voucher matched by a pattern.
+	_, err = app.ForwardingKeeper.SetBlockedDenoms(ctx, &types.MsgSetBlockedDenoms{
+		Signer: authority,
+		Denoms: []string{osmo.IBCDenom()},
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The blocked denom takes precedence.
+	require.False(t, app.ForwardingKeeper.IsAllowedDenom(ctx, channel, osmo.IBCDenom()))
+}
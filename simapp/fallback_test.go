@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/noble-assets/forwarding/v2/simapp"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+const gracePeriod = time.Hour
+
+// setupBlockedForward registers a forwarding account with a fallback address
+// on the first chain of a path, moves its channel into a specific state, and
+// funds the account.
+func setupBlockedForward(t *testing.T, state channeltypes.State) (*ibctesting.Path, *simapp.SimApp, sdk.AccAddress, sdk.AccAddress) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	coordinator.Setup(path)
+
+	app := chainA.App.(*simapp.SimApp)
+	require.NoError(t, app.ForwardingKeeper.ConfiguredGracePeriod.Set(chainA.GetContext(), uint64(gracePeriod)))
+
+	recipient := chainB.SenderAccount.GetAddress().String()
+	fallback := chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: recipient, Fallback: fallback.String()})
+
+	_, err := chainA.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chainA.SenderAccount.GetAddress().String(),
+		Recipient: recipient,
+		Channel:   path.EndpointA.ChannelID,
+		Fallback:  fallback.String(),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, path.EndpointA.SetChannelState(state))
+
+	_, err = chainA.SendMsgs(banktypes.NewMsgSend(
+		chainA.SenderAccount.GetAddress(),
+		address,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)),
+	))
+	require.NoError(t, err)
+
+	return path, app, address, fallback
+}
+
+func TestFallbackAfterGracePeriod(t *testing.T) {
+	path, app, address, fallback := setupBlockedForward(t, channeltypes.INIT)
+	chain := path.EndpointA.Chain
+	fallbackBalance := app.BankKeeper.GetBalance(chain.GetContext(), fallback, sdk.DefaultBondDenom)
+
+	// ASSERT: The account is tracked as blocked, and the funds are held.
+	_, found := app.ForwardingKeeper.GetBlockedForward(chain.GetContext(), address.String())
+	require.True(t, found)
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(chain.GetContext(), address, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Advance past the grace period.
+	chain.Coordinator.IncrementTimeBy(gracePeriod)
+	chain.NextBlock()
+
+	// ASSERT: The funds were swept to the fallback address.
+	ctx := chain.GetContext()
+	_, found = app.ForwardingKeeper.GetBlockedForward(ctx, address.String())
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, fallbackBalance.AddAmount(sdkmath.NewInt(1_000)), app.BankKeeper.GetBalance(ctx, fallback, sdk.DefaultBondDenom))
+}
+
+func TestFallbackOnClosedChannel(t *testing.T) {
+	path, app, address, _ := setupBlockedForward(t, channeltypes.CLOSED)
+
+	// ASSERT: The funds were swept immediately.
+	ctx := path.EndpointA.Chain.GetContext()
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
+
+func TestFallbackOnChanCloseConfirm(t *testing.T) {
+	path, app, address, _ := setupBlockedForward(t, channeltypes.INIT)
+	ctx := path.EndpointA.Chain.GetContext()
+
+	// ACT: Close the channel through the transfer stack.
+	module, found := app.IBCKeeper.Router.GetRoute(transfertypes.ModuleName)
+	require.True(t, found)
+	require.NoError(t, module.OnChanCloseConfirm(ctx, transfertypes.PortID, path.EndpointA.ChannelID))
+
+	// ASSERT: The funds were swept to the fallback address.
+	_, found = app.ForwardingKeeper.GetBlockedForward(ctx, address.String())
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
+
+func TestFallbackOnClosedChannelWithoutGracePeriod(t *testing.T) {
+	path, app, address, fallback := setupBlockedForward(t, channeltypes.INIT)
+	chain := path.EndpointA.Chain
+	ctx := chain.GetContext()
+	require.NoError(t, app.ForwardingKeeper.ConfiguredGracePeriod.Set(ctx, 0))
+
+	// ACT: Close the channel through the transfer stack.
+	module, found := app.IBCKeeper.Router.GetRoute(transfertypes.ModuleName)
+	require.True(t, found)
+	require.NoError(t, module.OnChanCloseConfirm(ctx, transfertypes.PortID, path.EndpointA.ChannelID))
+
+	// ASSERT: The tracked account was swept, even without a grace period.
+	_, found = app.ForwardingKeeper.GetBlockedForward(ctx, address.String())
+	require.False(t, found)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+
+	// ACT: Close the channel, and fund the account again.
+	require.NoError(t, path.EndpointA.SetChannelState(channeltypes.CLOSED))
+	fallbackBalance := app.BankKeeper.GetBalance(chain.GetContext(), fallback, sdk.DefaultBondDenom)
+	_, err := chain.SendMsgs(banktypes.NewMsgSend(
+		chain.SenderAccount.GetAddress(),
+		address,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)),
+	))
+	require.NoError(t, err)
+
+	// ASSERT: The funds were swept on the next forward.
+	ctx = chain.GetContext()
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, fallbackBalance.AddAmount(sdkmath.NewInt(1_000)), app.BankKeeper.GetBalance(ctx, fallback, sdk.DefaultBondDenom))
+}
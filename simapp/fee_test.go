@@ -0,0 +1,239 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/noble-assets/forwarding/v2/simapp"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	config := sdk.GetConfig()
+	config.SetBech32PrefixForAccount("noble", "noblepub")
+	config.SetBech32PrefixForValidator("noblevaloper", "noblevaloperpub")
+	config.SetBech32PrefixForConsensusNode("noblevalcons", "noblevalconspub")
+
+	ibctesting.DefaultTestingAppInit = func() (ibctesting.TestingApp, map[string]json.RawMessage) {
+		app, err := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{})
+		if err != nil {
+			panic(err)
+		}
+
+		return app, app.DefaultGenesis()
+	}
+}
+
+var (
+	recvFee    = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	ackFee     = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+	timeoutFee = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 25))
+)
+
+// setupFeePath opens a fee enabled transfer channel between two chains, and
+// configures a relayer fee for forwards through it on the first chain.
+func setupFeePath(t *testing.T, source types.RelayerFeeSource) (*ibctesting.Path, *simapp.SimApp) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	version := string(feetypes.ModuleCdc.MustMarshalJSON(&feetypes.Metadata{
+		FeeVersion: feetypes.Version,
+		AppVersion: transfertypes.Version,
+	}))
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.Version = version
+	path.EndpointB.ChannelConfig.Version = version
+	coordinator.Setup(path)
+
+	app := chainA.App.(*simapp.SimApp)
+	ctx := chainA.GetContext()
+	require.True(t, app.IBCFeeKeeper.IsFeeEnabled(ctx, transfertypes.PortID, path.EndpointA.ChannelID))
+
+	require.NoError(t, app.ForwardingKeeper.RelayerFees.Set(ctx, path.EndpointA.ChannelID, types.RelayerFee{
+		RecvFee:    recvFee,
+		AckFee:     ackFee,
+		TimeoutFee: timeoutFee,
+		Source:     source,
+	}))
+
+	return path, app
+}
+
+// forward registers a forwarding account on the first chain of a path, and
+// funds it so that it is forwarded in the same block.
+func forward(t *testing.T, path *ibctesting.Path, amount int64) sdk.AccAddress {
+	chain := path.EndpointA.Chain
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: path.EndpointB.Chain.SenderAccount.GetAddress().String()})
+
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: path.EndpointB.Chain.SenderAccount.GetAddress().String(),
+		Channel:   path.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+
+	_, err = chain.SendMsgs(banktypes.NewMsgSend(
+		chain.SenderAccount.GetAddress(),
+		address,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+	))
+	require.NoError(t, err)
+
+	return address
+}
+
+func TestRelayerFeeFromAccount(t *testing.T) {
+	path, app := setupFeePath(t, types.RelayerFeeSourceAccount)
+	address := forward(t, path, 1_000)
+
+	// ASSERT: The relayer fee is escrowed from the forwarding account.
+	ctx := path.EndpointA.Chain.GetContext()
+	packetID := channeltypes.NewPacketID(transfertypes.PortID, path.EndpointA.ChannelID, 1)
+	fees, found := app.IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+	require.True(t, found)
+	require.Len(t, fees.PacketFees, 1)
+	require.Equal(t, address.String(), fees.PacketFees[0].RefundAddress)
+	require.Equal(t, recvFee, fees.PacketFees[0].Fee.RecvFee)
+	require.Equal(t, ackFee, fees.PacketFees[0].Fee.AckFee)
+	require.Equal(t, timeoutFee, fees.PacketFees[0].Fee.TimeoutFee)
+
+	// ASSERT: The relayer fee was deducted from the forwarded amount.
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+	require.Equal(t, sdkmath.NewInt(850), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
+
+func TestRelayerFeeBelowAmount(t *testing.T) {
+	path, app := setupFeePath(t, types.RelayerFeeSourceAccount)
+	address := forward(t, path, 150)
+
+	// ASSERT: The forward was deferred, as the amount doesn't cover the relayer fee.
+	ctx := path.EndpointA.Chain.GetContext()
+	packetID := channeltypes.NewPacketID(transfertypes.PortID, path.EndpointA.ChannelID, 1)
+	require.False(t, app.IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+	require.Equal(t, sdkmath.NewInt(150), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+}
+
+func TestRelayerFeeFromPool(t *testing.T) {
+	path, app := setupFeePath(t, types.RelayerFeeSourcePool)
+	chain := path.EndpointA.Chain
+
+	// ARRANGE: Fund the module account.
+	pool := authtypes.NewModuleAddress(types.ModuleName)
+	_, err := chain.SendMsgs(banktypes.NewMsgSend(
+		chain.SenderAccount.GetAddress(),
+		pool,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)),
+	))
+	require.NoError(t, err)
+
+	forward(t, path, 1_000)
+
+	// ASSERT: The relayer fee is escrowed from the module account.
+	ctx := chain.GetContext()
+	packetID := channeltypes.NewPacketID(transfertypes.PortID, path.EndpointA.ChannelID, 1)
+	fees, found := app.IBCFeeKeeper.GetFeesInEscrow(ctx, packetID)
+	require.True(t, found)
+	require.Equal(t, pool.String(), fees.PacketFees[0].RefundAddress)
+	require.Equal(t, sdkmath.NewInt(850), app.BankKeeper.GetBalance(ctx, pool, sdk.DefaultBondDenom).Amount)
+
+	// ASSERT: The full amount was forwarded.
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+}
+
+func TestRelayerFeeFromEmptyPool(t *testing.T) {
+	path, app := setupFeePath(t, types.RelayerFeeSourcePool)
+	forward(t, path, 1_000)
+
+	// ASSERT: The forward was sent without a relayer fee.
+	ctx := path.EndpointA.Chain.GetContext()
+	packetID := channeltypes.NewPacketID(transfertypes.PortID, path.EndpointA.ChannelID, 1)
+	require.False(t, app.IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+}
+
+func TestSetRelayerFee(t *testing.T) {
+	feePath, feeApp := setupFeePath(t, types.RelayerFeeSourceAccount)
+	path, app := setupTransferPath(t)
+	msg := types.MsgSetRelayerFee{
+		Signer:  authority,
+		RecvFee: recvFee,
+		Source:  types.RelayerFeeSourceAccount,
+	}
+
+	// ACT: Configure a relayer fee on a fee enabled channel.
+	msg.Channel = feePath.EndpointA.ChannelID
+	_, err := feeApp.ForwardingKeeper.SetRelayerFee(feePath.EndpointA.Chain.GetContext(), &msg)
+	require.NoError(t, err)
+
+	// ACT: Attempt to configure a relayer fee on a channel that isn't fee
+	// enabled.
+	msg.Channel = path.EndpointA.ChannelID
+	_, err = app.ForwardingKeeper.SetRelayerFee(path.EndpointA.Chain.GetContext(), &msg)
+	require.ErrorIs(t, err, types.ErrInvalidRelayerFee)
+
+	// ACT: Remove the relayer fee of a channel that isn't fee enabled.
+	_, err = app.ForwardingKeeper.SetRelayerFee(path.EndpointA.Chain.GetContext(), &types.MsgSetRelayerFee{
+		Signer:  authority,
+		Channel: path.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+}
+
+func TestRelayerFeeNotFeeEnabled(t *testing.T) {
+	path, app := setupTransferPath(t)
+
+	// ARRANGE: Store a relayer fee for a channel that isn't fee enabled, like
+	// it could be through genesis.
+	require.NoError(t, app.ForwardingKeeper.RelayerFees.Set(path.EndpointA.Chain.GetContext(), path.EndpointA.ChannelID, types.RelayerFee{
+		RecvFee: recvFee,
+		Source:  types.RelayerFeeSourceAccount,
+	}))
+
+	address := forward(t, path, 1_000)
+
+	// ASSERT: The full amount was forwarded without a relayer fee.
+	ctx := path.EndpointA.Chain.GetContext()
+	packetID := channeltypes.NewPacketID(transfertypes.PortID, path.EndpointA.ChannelID, 1)
+	require.False(t, app.IBCFeeKeeper.HasFeesInEscrow(ctx, packetID))
+
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
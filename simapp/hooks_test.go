@@ -0,0 +1,159 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockHooks records every call of the forwarding hooks, rejecting
+// registrations of a specific recipient, and vetoing forwards of a specific
+// denom.
+type mockHooks struct {
+	blockedRecipient string
+	vetoedDenom      string
+	halve            bool
+
+	registered []string
+	forwarded  []sdk.Coin
+	sequences  []uint64
+	failed     []string
+}
+
+var _ types.ForwardingHooks = &mockHooks{}
+
+func (h *mockHooks) BeforeRegister(_ context.Context, account types.ForwardingAccount) error {
+	if account.Recipient == h.blockedRecipient {
+		return errors.New("recipient is blocked")
+	}
+
+	return nil
+}
+
+func (h *mockHooks) AfterRegister(_ context.Context, account types.ForwardingAccount) error {
+	h.registered = append(h.registered, account.Address)
+	return nil
+}
+
+func (h *mockHooks) BeforeForward(_ context.Context, _ types.ForwardingAccount, coin sdk.Coin) (sdk.Coin, error) {
+	if coin.Denom == h.vetoedDenom {
+		return sdk.Coin{}, errors.New("denom is vetoed")
+	}
+
+	if h.halve {
+		return sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(2)), nil
+	}
+
+	return coin, nil
+}
+
+func (h *mockHooks) AfterForward(_ context.Context, _ types.ForwardingAccount, _ string, _ string, coin sdk.Coin, sequence uint64) error {
+	h.forwarded = append(h.forwarded, coin)
+	h.sequences = append(h.sequences, sequence)
+	return nil
+}
+
+func (h *mockHooks) OnForwardFailed(_ context.Context, _ types.ForwardingAccount, coin sdk.Coin, reason string) error {
+	h.failed = append(h.failed, coin.String()+": "+reason)
+	return nil
+}
+
+func TestForwardingHooks(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	compliance := &mockHooks{blockedRecipient: "cosmos1blocked", vetoedDenom: "uspam"}
+	swap := &mockHooks{halve: true}
+	require.NoError(t, forwarding.InvokeSetForwardingHooks(app.ForwardingKeeper, map[string]types.ForwardingHooksWrapper{
+		"swap":       {ForwardingHooks: swap},
+		"compliance": {ForwardingHooks: compliance},
+	}))
+
+	// ACT: Attempt to register an account for a blocked recipient.
+	_, err := app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: "cosmos1blocked",
+		Channel:   channel,
+	})
+
+	// ASSERT: The registration was rejected.
+	require.ErrorContains(t, err, "recipient is blocked")
+	require.Empty(t, compliance.registered)
+
+	// ACT: Register an account.
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient"})
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: "cosmos1recipient",
+		Channel:   channel,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: Both hooks were called after the registration.
+	require.Equal(t, []string{address.String()}, compliance.registered)
+	require.Equal(t, []string{address.String()}, swap.registered)
+
+	// ACT: Fund the account with a vetoed denom and an allowed denom, and
+	// execute the forward.
+	ctx := chain.GetContext()
+	spam := sdk.NewCoins(sdk.NewInt64Coin("uspam", 300))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, spam))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, address, spam))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The vetoed denom was deferred, and the modified amount of the
+	// allowed denom was forwarded.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, sdk.DefaultBondDenom, packets[0].Denom)
+	require.Equal(t, "500", packets[0].Amount)
+	require.Equal(t, "300", app.BankKeeper.GetBalance(ctx, address, "uspam").Amount.String())
+	require.Equal(t, "500", app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount.String())
+	require.Equal(t, uint64(1), app.ForwardingKeeper.GetNumOfDeferrals(ctx, channel)[types.DeferReasonVetoed.String()])
+
+	require.Equal(t, []sdk.Coin{sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)}, compliance.forwarded)
+	require.Equal(t, compliance.forwarded, swap.forwarded)
+	require.Len(t, compliance.sequences, 1)
+
+	// ACT: Time out the forward.
+	packet := channeltypes.Packet{
+		Sequence:      compliance.sequences[0],
+		SourcePort:    transfertypes.PortID,
+		SourceChannel: channel,
+		Data:          packets[0].GetBytes(),
+	}
+	app.ForwardingKeeper.HandleTimeout(ctx, packet, false)
+
+	// ASSERT: Both hooks were notified of the failed forward.
+	require.Equal(t, []string{"500" + sdk.DefaultBondDenom + ": packet timed out"}, compliance.failed)
+	require.Equal(t, compliance.failed, swap.failed)
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardHops(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+	signer := chain.SenderAccount.GetAddress().String()
+
+	// ACT: Attempt to register accounts with invalid hops.
+	_, err := app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "osmo1intermediate",
+		Channel:   channel,
+		Hops:      []types.Hop{{Channel: "channel", Receiver: "juno1final"}},
+	})
+	require.ErrorContains(t, err, "invalid hop channel")
+
+	_, err = app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "osmo1intermediate",
+		Channel:   channel,
+		Hops:      make([]types.Hop, types.MaxHops+1),
+	})
+	require.ErrorContains(t, err, "hops must not exceed")
+
+	_, err = app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "osmo1intermediate",
+		Channel:   channel,
+		Memo:      "tag",
+		Hops:      []types.Hop{{Channel: "channel-7", Receiver: "juno1final"}},
+	})
+	require.ErrorContains(t, err, "memo must be a JSON object")
+
+	// ACT: Register an account forwarding through an intermediate chain.
+	account := types.ForwardingAccount{
+		Channel:   channel,
+		Recipient: "osmo1intermediate",
+		Memo:      `{"wasm":{"contract":"juno1contract"}}`,
+		Hops:      []types.Hop{{Channel: "channel-7", Receiver: "juno1final"}},
+	}
+	address := types.GenerateAddress(account)
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: account.Recipient,
+		Channel:   account.Channel,
+		Memo:      account.Memo,
+		Hops:      account.Hops,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The hops are part of the address derivation.
+	require.NotEqual(t, types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: account.Recipient, Memo: account.Memo}), address)
+	other := account
+	other.Hops = []types.Hop{{Channel: "channel-8", Receiver: "juno1final"}}
+	require.NotEqual(t, types.GenerateAddress(other), address)
+
+	// ACT: Fund the account, and execute the forward.
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The transfer carries a packet-forward-middleware memo, that
+	// includes the memo of the account in the final hop.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, account.Recipient, packets[0].Receiver)
+	require.JSONEq(t, `{"forward":{"receiver":"juno1final","port":"transfer","channel":"channel-7","next":{"wasm":{"contract":"juno1contract"}}}}`, packets[0].Memo)
+}
@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForwardMemo(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+	signer := chain.SenderAccount.GetAddress().String()
+
+	_, err := app.ForwardingKeeper.SetMaxMemoLength(chain.GetContext(), &types.MsgSetMaxMemoLength{
+		Signer:        signer,
+		MaxMemoLength: 16,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	_, err = app.ForwardingKeeper.SetMaxMemoLength(chain.GetContext(), &types.MsgSetMaxMemoLength{
+		Signer:        authority,
+		MaxMemoLength: 16,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to register an account with a memo that is too long.
+	_, err = app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "cosmos1recipient",
+		Channel:   channel,
+		Memo:      strings.Repeat("m", 17),
+	})
+
+	// ASSERT: The registration was rejected.
+	require.ErrorContains(t, err, "memo must not exceed 16 bytes")
+
+	// ACT: Register an account with a memo.
+	account := types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient", Memo: "tag:12345"}
+	address := types.GenerateAddress(account)
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: account.Recipient,
+		Channel:   account.Channel,
+		Memo:      account.Memo,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The memo is part of the address derivation.
+	require.NotEqual(t, types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient"}), address)
+	res, err := app.ForwardingKeeper.Address(chain.GetContext(), &types.QueryAddress{
+		Channel:   account.Channel,
+		Recipient: account.Recipient,
+		Memo:      account.Memo,
+	})
+	require.NoError(t, err)
+	require.Equal(t, address.String(), res.Address)
+	require.True(t, res.Exists)
+
+	// ACT: Fund the account, and execute the forward.
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The memo was included in the transfer.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, account.Memo, packets[0].Memo)
+	require.Equal(t, account.Recipient, packets[0].Receiver)
+}
+
+func TestMaxMemoLengthDefault(t *testing.T) {
+	path, app := setupTransferPath(t)
+	ctx := path.EndpointA.Chain.GetContext()
+
+	// ACT: Import a genesis state exported before the max memo length was
+	// introduced.
+	genesis := forwarding.ExportGenesis(ctx, app.ForwardingKeeper)
+	genesis.MaxMemoLength = 0
+	require.NoError(t, genesis.Validate())
+	require.NoError(t, app.ForwardingKeeper.ConfiguredMaxMemoLength.Remove(ctx))
+	forwarding.InitGenesis(ctx, app.ForwardingKeeper, *genesis)
+
+	// ASSERT: The default max memo length is used.
+	require.Equal(t, types.DefaultMaxMemoLength, app.ForwardingKeeper.GetMaxMemoLength(ctx))
+
+	// ACT: Configure, and then reset, the max memo length.
+	_, err := app.ForwardingKeeper.SetMaxMemoLength(ctx, &types.MsgSetMaxMemoLength{
+		Signer:        authority,
+		MaxMemoLength: 16,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(16), forwarding.ExportGenesis(ctx, app.ForwardingKeeper).MaxMemoLength)
+
+	_, err = app.ForwardingKeeper.SetMaxMemoLength(ctx, &types.MsgSetMaxMemoLength{
+		Signer:        authority,
+		MaxMemoLength: 0,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The default max memo length is used, and exported.
+	require.Equal(t, types.DefaultMaxMemoLength, app.ForwardingKeeper.GetMaxMemoLength(ctx))
+	require.Equal(t, types.DefaultMaxMemoLength, forwarding.ExportGenesis(ctx, app.ForwardingKeeper).MaxMemoLength)
+}
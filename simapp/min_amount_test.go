@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// deferredForwards returns the forwards that were deferred in the specified
+// events.
+func deferredForwards(t *testing.T, events sdk.Events) (deferred []*types.ForwardDeferred) {
+	for _, event := range events {
+		if event.Type != "noble.forwarding.v1.ForwardDeferred" {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		deferred = append(deferred, msg.(*types.ForwardDeferred))
+	}
+
+	return
+}
+
+func TestMinForwardAmount(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	_, err := app.ForwardingKeeper.SetMinForwardAmount(chain.GetContext(), &types.MsgSetMinForwardAmount{
+		Signer: chain.SenderAccount.GetAddress().String(),
+		Denom:  sdk.DefaultBondDenom,
+		Amount: sdkmath.NewInt(500),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Configure a minimum forward amount.
+	_, err = app.ForwardingKeeper.SetMinForwardAmount(chain.GetContext(), &types.MsgSetMinForwardAmount{
+		Signer: authority,
+		Denom:  sdk.DefaultBondDenom,
+		Amount: sdkmath.NewInt(500),
+	})
+	require.NoError(t, err)
+
+	res, err := app.ForwardingKeeper.MinForwardAmount(chain.GetContext(), &types.QueryMinForwardAmount{Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(500), res.Amount)
+
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: "cosmos1recipient",
+		Channel:   channel,
+	})
+	require.NoError(t, err)
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient"})
+
+	// ACT: Deposit less than the minimum forward amount.
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The forward was deferred, and the deferral was recorded.
+	require.Empty(t, sentPackets(t, ctx.EventManager().Events()))
+	require.Equal(t, sdkmath.NewInt(300), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+
+	deferred := deferredForwards(t, ctx.EventManager().Events())
+	require.Len(t, deferred, 1)
+	require.Equal(t, address.String(), deferred[0].Address)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300), deferred[0].Amount)
+	require.Equal(t, types.DeferReasonBelowMinimum, deferred[0].Reason)
+
+	stats, err := app.ForwardingKeeper.StatsByChannel(ctx, &types.QueryStatsByChannel{Channel: channel})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), stats.NumOfDeferrals[types.DeferReasonBelowMinimum.String()])
+
+	// ACT: Deposit enough to exceed the minimum forward amount.
+	chain.NextBlock()
+	ctx = headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The accumulated balance was forwarded.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, "600", packets[0].Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
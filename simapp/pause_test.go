@@ -0,0 +1,271 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/noble-assets/forwarding/v2/simapp"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// authority is the module authority configured in app.yaml.
+const authority = "noble1h8tqx833l3t2s45mwxjz29r85dcevy93wk63za"
+
+func setupTransferPath(t *testing.T) (*ibctesting.Path, *simapp.SimApp) {
+	coordinator := ibctesting.NewCoordinator(t, 2)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+
+	path := ibctesting.NewTransferPath(chainA, chainB)
+	coordinator.Setup(path)
+
+	return path, chainA.App.(*simapp.SimApp)
+}
+
+func TestPauseChannel(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+
+	_, err := app.ForwardingKeeper.PauseChannel(chain.GetContext(), &types.MsgPauseChannel{
+		Signer:  authority,
+		Channel: path.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+
+	address := forward(t, path, 1_000)
+	chain.NextBlock()
+
+	// ASSERT: The forward is queued, and the funds are held.
+	ctx := chain.GetContext()
+	require.True(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).IsZero())
+
+	// ACT: Resume the channel.
+	_, err = app.ForwardingKeeper.ResumeChannel(ctx, &types.MsgResumeChannel{
+		Signer:  authority,
+		Channel: path.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+	chain.NextBlock()
+
+	// ASSERT: The queued forward was executed.
+	ctx = chain.GetContext()
+	require.False(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+}
+
+func TestPauseChannelRejectRegistrations(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+
+	_, err := app.ForwardingKeeper.PauseChannel(chain.GetContext(), &types.MsgPauseChannel{
+		Signer:              authority,
+		Channel:             path.EndpointA.ChannelID,
+		RejectRegistrations: true,
+	})
+	require.NoError(t, err)
+
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: path.EndpointB.Chain.SenderAccount.GetAddress().String(),
+		Channel:   path.EndpointA.ChannelID,
+	})
+	require.ErrorContains(t, err, types.ErrPausedChannel.Error())
+}
+
+func TestPauseChannelBacklog(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	addresses := registerAccounts(t, path, 3)
+
+	// ARRANGE: Register two local accounts.
+	var locals []sdk.AccAddress
+	for _, sender := range chain.SenderAccounts[1:3] {
+		recipient := sender.SenderAccount.GetAddress().String()
+		_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+			Signer:          chain.SenderAccount.GetAddress().String(),
+			Recipient:       recipient,
+			DestinationType: types.DestinationTypeLocal,
+		})
+		require.NoError(t, err)
+		locals = append(locals, types.GenerateAddress(types.ForwardingAccount{Recipient: recipient, DestinationType: types.DestinationTypeLocal}))
+	}
+
+	ctx := chain.GetContext()
+	_, err := app.ForwardingKeeper.PauseChannel(ctx, &types.MsgPauseChannel{
+		Signer:  authority,
+		Channel: path.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+	_, err = app.ForwardingKeeper.SetExecutionLimits(ctx, &types.MsgSetExecutionLimits{
+		Signer:              authority,
+		MaxForwardsPerBlock: 1,
+	})
+	require.NoError(t, err)
+
+	// ACT: Queue a backlog on the paused channel, followed by both local
+	// accounts, of which only one can be executed in the same block.
+	var msgs []sdk.Msg
+	for _, address := range append(addresses, locals...) {
+		msgs = append(msgs, banktypes.NewMsgSend(chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	}
+	_, err = chain.SendMsgs(msgs...)
+	require.NoError(t, err)
+
+	ctx = chain.GetContext()
+	require.Equal(t, uint64(4), app.ForwardingKeeper.GetQueueDepth(ctx))
+
+	// ACT: Execute the forwards of the next block.
+	chain.NextBlock()
+
+	// ASSERT: The queued local forward was executed, ahead of the backlog of
+	// the paused channel.
+	ctx = chain.GetContext()
+	for _, address := range locals {
+		require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	}
+	for _, address := range addresses {
+		require.True(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	}
+	require.Equal(t, uint64(3), app.ForwardingKeeper.GetQueueDepth(ctx))
+}
+
+func TestPauseSplitChannel(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	other := ibctesting.NewTransferPath(path.EndpointA.Chain, path.EndpointB.Chain)
+	chain.Coordinator.Setup(other)
+
+	// ARRANGE: Register an account that splits its forwards across both
+	// channels, alongside two accounts that only use the first channel.
+	splits := []types.Split{
+		{Channel: channel, Recipient: "cosmos1primary", Bps: 5_000},
+		{Channel: other.EndpointA.ChannelID, Recipient: "cosmos1secondary", Bps: 5_000},
+	}
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: splits[0].Recipient,
+		Channel:   channel,
+		Splits:    splits,
+	})
+	require.NoError(t, err)
+	split := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: splits[0].Recipient, Splits: splits})
+	addresses := registerAccounts(t, path, 2)
+
+	ctx := chain.GetContext()
+	_, err = app.ForwardingKeeper.PauseChannel(ctx, &types.MsgPauseChannel{
+		Signer:  authority,
+		Channel: other.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+	_, err = app.ForwardingKeeper.SetExecutionLimits(ctx, &types.MsgSetExecutionLimits{
+		Signer:              authority,
+		MaxForwardsPerBlock: 1,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund all accounts in a single block, of which only one can be
+	// executed in the same block.
+	var msgs []sdk.Msg
+	for _, address := range append([]sdk.AccAddress{split}, addresses...) {
+		msgs = append(msgs, banktypes.NewMsgSend(chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	}
+	_, err = chain.SendMsgs(msgs...)
+	require.NoError(t, err)
+
+	// ASSERT: The split forward was queued on the paused channel, instead of
+	// the channel of the account.
+	ctx = chain.GetContext()
+	require.True(t, app.ForwardingKeeper.IsQueuedForward(ctx, split.String()))
+	require.Equal(t, uint64(2), app.ForwardingKeeper.GetQueueDepth(ctx))
+	require.Equal(t, []string{channel, other.EndpointA.ChannelID}, app.ForwardingKeeper.GetQueueKeys(ctx))
+
+	// ACT: Execute the forwards of the next block.
+	chain.NextBlock()
+
+	// ASSERT: The forward queued on the first channel was executed, while
+	// the split forward remains queued until the channel is resumed.
+	ctx = chain.GetContext()
+	for _, address := range addresses {
+		require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	}
+	require.Equal(t, []string{split.String()}, app.ForwardingKeeper.GetQueuedForwards(ctx))
+	require.Equal(t, []string{other.EndpointA.ChannelID}, app.ForwardingKeeper.GetQueueKeys(ctx))
+}
+
+func TestPauseQueuedSplitChannel(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	other := ibctesting.NewTransferPath(path.EndpointA.Chain, path.EndpointB.Chain)
+	chain.Coordinator.Setup(other)
+
+	// ARRANGE: Queue a forward of an account that splits its forwards across
+	// both channels, ahead of an account that only uses the first channel.
+	splits := []types.Split{
+		{Channel: channel, Recipient: "cosmos1primary", Bps: 5_000},
+		{Channel: other.EndpointA.ChannelID, Recipient: "cosmos1secondary", Bps: 5_000},
+	}
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: splits[0].Recipient,
+		Channel:   channel,
+		Splits:    splits,
+	})
+	require.NoError(t, err)
+	split := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: splits[0].Recipient, Splits: splits})
+	address := registerAccounts(t, path, 1)[0]
+
+	ctx := headerContext(chain)
+	app.ForwardingKeeper.EnqueueForward(ctx, split.String())
+	app.ForwardingKeeper.EnqueueForward(ctx, address.String())
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), split, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	require.Equal(t, []string{channel}, app.ForwardingKeeper.GetQueueKeys(ctx))
+
+	// ACT: Pause the second channel, and execute the queued forwards.
+	_, err = app.ForwardingKeeper.PauseChannel(ctx, &types.MsgPauseChannel{
+		Signer:  authority,
+		Channel: other.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The split forward was moved onto the paused channel, without
+	// blocking the forward queued after it.
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, []string{split.String()}, app.ForwardingKeeper.GetQueuedForwards(ctx))
+	require.Equal(t, []string{other.EndpointA.ChannelID}, app.ForwardingKeeper.GetQueueKeys(ctx))
+	require.Equal(t, uint64(1), app.ForwardingKeeper.GetQueueDepth(ctx))
+}
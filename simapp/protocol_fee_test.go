@@ -0,0 +1,173 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProtocolFee(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+	feeRecipient := sdk.AccAddress("fee_recipient")
+
+	schedule := types.FeeSchedule{
+		Percentage: sdkmath.LegacyNewDecWithPrec(1, 2),
+		Flat:       sdkmath.NewInt(5),
+	}
+
+	// ACT: Attempt to configure the fees without the authority.
+	_, err := app.ForwardingKeeper.SetFeeSchedule(chain.GetContext(), &types.MsgSetFeeSchedule{
+		Signer:     chain.SenderAccount.GetAddress().String(),
+		Denom:      sdk.DefaultBondDenom,
+		Percentage: schedule.Percentage,
+		Flat:       schedule.Flat,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+	_, err = app.ForwardingKeeper.SetFeeRecipient(chain.GetContext(), &types.MsgSetFeeRecipient{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: feeRecipient.String(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Attempt to configure an invalid fee schedule.
+	_, err = app.ForwardingKeeper.SetFeeSchedule(chain.GetContext(), &types.MsgSetFeeSchedule{
+		Signer:     authority,
+		Denom:      sdk.DefaultBondDenom,
+		Percentage: sdkmath.LegacyOneDec(),
+		Flat:       sdkmath.ZeroInt(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidFeeSchedule)
+
+	// ACT: Configure the fees with the authority.
+	_, err = app.ForwardingKeeper.SetFeeSchedule(chain.GetContext(), &types.MsgSetFeeSchedule{
+		Signer:     authority,
+		Denom:      sdk.DefaultBondDenom,
+		Percentage: schedule.Percentage,
+		Flat:       schedule.Flat,
+	})
+	require.NoError(t, err)
+	_, err = app.ForwardingKeeper.SetFeeRecipient(chain.GetContext(), &types.MsgSetFeeRecipient{
+		Signer:    authority,
+		Recipient: feeRecipient.String(),
+	})
+	require.NoError(t, err)
+
+	scheduleRes, err := app.ForwardingKeeper.FeeSchedule(chain.GetContext(), &types.QueryFeeSchedule{Denom: sdk.DefaultBondDenom})
+	require.NoError(t, err)
+	require.True(t, schedule.Percentage.Equal(scheduleRes.Schedule.Percentage))
+	require.True(t, schedule.Flat.Equal(scheduleRes.Schedule.Flat))
+	recipientRes, err := app.ForwardingKeeper.FeeRecipient(chain.GetContext(), &types.QueryFeeRecipient{})
+	require.NoError(t, err)
+	require.Equal(t, feeRecipient.String(), recipientRes.Recipient)
+
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: "cosmos1recipient",
+		Channel:   channel,
+	})
+	require.NoError(t, err)
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient"})
+
+	// ACT: Forward a deposit.
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The fee was deducted from the forward, and sent to the recipient.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, "985", packets[0].Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(15), app.BankKeeper.GetBalance(ctx, feeRecipient, sdk.DefaultBondDenom).Amount)
+
+	// ASSERT: The fee was recorded in the channel stats.
+	stats, err := app.ForwardingKeeper.StatsByChannel(ctx, &types.QueryStatsByChannel{Channel: channel})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 985)), stats.TotalForwarded)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15)), stats.TotalFees)
+}
+
+func TestProtocolFeeSplits(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+	feeRecipient := sdk.AccAddress("fee_recipient")
+
+	_, err := app.ForwardingKeeper.SetFeeSchedule(chain.GetContext(), &types.MsgSetFeeSchedule{
+		Signer:     authority,
+		Denom:      sdk.DefaultBondDenom,
+		Percentage: sdkmath.LegacyNewDecWithPrec(1, 2),
+		Flat:       sdkmath.NewInt(5),
+	})
+	require.NoError(t, err)
+	_, err = app.ForwardingKeeper.SetFeeRecipient(chain.GetContext(), &types.MsgSetFeeRecipient{
+		Signer:    authority,
+		Recipient: feeRecipient.String(),
+	})
+	require.NoError(t, err)
+
+	splits := []types.Split{
+		{Channel: channel, Recipient: "cosmos1primary", Bps: 5_000},
+		{Channel: channel, Recipient: "cosmos1secondary", Bps: 5_000},
+	}
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: splits[0].Recipient,
+		Channel:   channel,
+		Splits:    splits,
+	})
+	require.NoError(t, err)
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: splits[0].Recipient, Splits: splits})
+
+	// ACT: Forward a deposit that is split across two transfers.
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The percentage was deducted from both shares, and the flat fee
+	// only from the first.
+	amounts := make(map[string]string)
+	for _, data := range sentPackets(t, ctx.EventManager().Events()) {
+		amounts[data.Receiver] = data.Amount
+	}
+	require.Equal(t, map[string]string{"cosmos1primary": "490", "cosmos1secondary": "495"}, amounts)
+	require.Equal(t, sdkmath.NewInt(15), app.BankKeeper.GetBalance(ctx, feeRecipient, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Reset the fee recipient.
+	_, err = app.ForwardingKeeper.SetFeeRecipient(ctx, &types.MsgSetFeeRecipient{
+		Signer:    authority,
+		Recipient: "",
+	})
+	require.NoError(t, err)
+
+	// ASSERT: Fees are sent to the fee collector again.
+	res, err := app.ForwardingKeeper.FeeRecipient(ctx, &types.QueryFeeRecipient{})
+	require.NoError(t, err)
+	require.Equal(t, authtypes.NewModuleAddress(authtypes.FeeCollectorName).String(), res.Recipient)
+}
@@ -0,0 +1,179 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// registerAccounts registers several forwarding accounts on the first chain
+// of a path, each with a different recipient.
+func registerAccounts(t *testing.T, path *ibctesting.Path, count int) (addresses []sdk.AccAddress) {
+	chain := path.EndpointA.Chain
+
+	for i := range count {
+		recipient := fmt.Sprintf("cosmos1recipient%d", i)
+		_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+			Signer:    chain.SenderAccount.GetAddress().String(),
+			Recipient: recipient,
+			Channel:   path.EndpointA.ChannelID,
+		})
+		require.NoError(t, err)
+
+		addresses = append(addresses, types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: recipient}))
+	}
+
+	return addresses
+}
+
+func TestExecutionLimits(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	addresses := registerAccounts(t, path, 3)
+
+	_, err := app.ForwardingKeeper.SetExecutionLimits(chain.GetContext(), &types.MsgSetExecutionLimits{
+		Signer:              authority,
+		MaxForwardsPerBlock: 1,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund all accounts in a single block.
+	var msgs []sdk.Msg
+	for _, address := range addresses {
+		msgs = append(msgs, banktypes.NewMsgSend(chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	}
+	_, err = chain.SendMsgs(msgs...)
+	require.NoError(t, err)
+
+	// ASSERT: Only a single forward was executed, with the others queued.
+	ctx := chain.GetContext()
+	require.Equal(t, uint64(2), app.ForwardingKeeper.GetQueueDepth(ctx))
+	queued := app.ForwardingKeeper.GetQueuedForwards(ctx)
+	require.Len(t, queued, 2)
+	for _, address := range addresses {
+		require.Equal(t, slices.Contains(queued, address.String()), !app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	}
+
+	// ACT: Execute the forwards of the next block.
+	chain.NextBlock()
+
+	// ASSERT: The queued forwards are executed in order.
+	ctx = chain.GetContext()
+	require.Equal(t, []string{queued[1]}, app.ForwardingKeeper.GetQueuedForwards(ctx))
+	require.Equal(t, uint64(1), app.ForwardingKeeper.GetQueueDepth(ctx))
+
+	chain.NextBlock()
+	ctx = chain.GetContext()
+	require.Empty(t, app.ForwardingKeeper.GetQueuedForwards(ctx))
+	require.Zero(t, app.ForwardingKeeper.GetQueueDepth(ctx))
+	require.Empty(t, app.ForwardingKeeper.GetQueueKeys(ctx))
+	for _, address := range addresses {
+		require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	}
+}
+
+func TestSetExecutionLimits(t *testing.T) {
+	path, app := setupTransferPath(t)
+	ctx := path.EndpointA.Chain.GetContext()
+
+	// ASSERT: The default genesis state limits forwards.
+	require.Equal(t, types.DefaultExecutionLimits(), app.ForwardingKeeper.GetExecutionLimits(ctx))
+
+	// ASSERT: Forwards aren't limited if no limits have been configured, as
+	// on chains that upgraded from a version without limits.
+	require.NoError(t, app.ForwardingKeeper.ConfiguredExecutionLimits.Remove(ctx))
+	require.Equal(t, types.ExecutionLimits{}, app.ForwardingKeeper.GetExecutionLimits(ctx))
+
+	// ACT: Attempt to configure execution limits without the authority.
+	_, err := app.ForwardingKeeper.SetExecutionLimits(ctx, &types.MsgSetExecutionLimits{
+		Signer:              path.EndpointA.Chain.SenderAccount.GetAddress().String(),
+		MaxForwardsPerBlock: 1,
+	})
+
+	// ASSERT: The limits were rejected.
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Configure execution limits with the authority.
+	_, err = app.ForwardingKeeper.SetExecutionLimits(ctx, &types.MsgSetExecutionLimits{
+		Signer:              authority,
+		MaxForwardsPerBlock: 1,
+		MaxGasPerBlock:      1_000_000,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The limits were stored.
+	res, err := app.ForwardingKeeper.ExecutionLimits(ctx, &types.QueryExecutionLimits{})
+	require.NoError(t, err)
+	require.Equal(t, types.ExecutionLimits{MaxForwardsPerBlock: 1, MaxGasPerBlock: 1_000_000}, res.Limits)
+}
+
+func TestExecutionGasLimit(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	addresses := registerAccounts(t, path, 3)
+
+	// NOTE: Any forward exhausts the gas limit, so that only one is executed.
+	_, err := app.ForwardingKeeper.SetExecutionLimits(chain.GetContext(), &types.MsgSetExecutionLimits{
+		Signer:         authority,
+		MaxGasPerBlock: 1,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund all accounts in a single block.
+	var msgs []sdk.Msg
+	for _, address := range addresses {
+		msgs = append(msgs, banktypes.NewMsgSend(chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	}
+	_, err = chain.SendMsgs(msgs...)
+	require.NoError(t, err)
+
+	// ASSERT: Only a single forward was executed, with the others queued.
+	ctx := chain.GetContext()
+	res, err := app.ForwardingKeeper.QueueDepth(ctx, &types.QueryQueueDepth{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Depth)
+
+	// ACT: Execute the forwards of the next block.
+	chain.NextBlock()
+
+	// ASSERT: A single queued forward was executed.
+	ctx = chain.GetContext()
+	res, err = app.ForwardingKeeper.QueueDepth(ctx, &types.QueryQueueDepth{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.Depth)
+
+	chain.NextBlock()
+	ctx = chain.GetContext()
+	res, err = app.ForwardingKeeper.QueueDepth(ctx, &types.QueryQueueDepth{})
+	require.NoError(t, err)
+	require.Zero(t, res.Depth)
+	for _, address := range addresses {
+		require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	}
+}
@@ -0,0 +1,220 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/core/header"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimit(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+
+	_, err := app.ForwardingKeeper.SetRateLimit(chain.GetContext(), &types.MsgSetRateLimit{
+		Signer:  authority,
+		Channel: path.EndpointA.ChannelID,
+		Denom:   sdk.DefaultBondDenom,
+		Amount:  sdkmath.NewInt(1_000),
+		Window:  uint64(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	// ACT: Forward an amount within the rate limit.
+	address := forward(t, path, 600)
+	chain.NextBlock()
+
+	// ASSERT: The forward was executed, and recorded against the rate limit.
+	ctx := chain.GetContext()
+	require.Equal(t, sdkmath.NewInt(600), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+	res, err := app.ForwardingKeeper.RateLimitUsage(ctx, &types.QueryRateLimitUsage{
+		Channel: path.EndpointA.ChannelID,
+		Denom:   sdk.DefaultBondDenom,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.NewInt(600), res.Usage.Amount)
+	require.Equal(t, sdkmath.NewInt(400), res.Remaining)
+
+	// ACT: Forward an amount exceeding the remaining quota.
+	_, err = chain.SendMsgs(banktypes.NewMsgSend(
+		chain.SenderAccount.GetAddress(),
+		address,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)),
+	))
+	require.NoError(t, err)
+	chain.NextBlock()
+
+	// ASSERT: The forward is queued, and the funds are held.
+	ctx = chain.GetContext()
+	require.True(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	require.Equal(t, sdkmath.NewInt(600), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(600), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Wait for the window to elapse.
+	chain.Coordinator.IncrementTimeBy(24 * time.Hour)
+	chain.NextBlock()
+
+	// ASSERT: The queued forward was executed in the new window.
+	ctx = chain.GetContext()
+	require.False(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(1_200), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(600), app.ForwardingKeeper.GetRateLimitUsage(ctx, path.EndpointA.ChannelID, sdk.DefaultBondDenom).Amount)
+}
+
+// headerContext returns the context of the current block of a chain, with
+// its header info set like it is during block execution.
+func headerContext(chain *ibctesting.TestChain) sdk.Context {
+	ctx := chain.GetContext()
+	return ctx.WithHeaderInfo(header.Info{Height: ctx.BlockHeight(), Time: ctx.BlockTime()})
+}
+
+func TestRateLimitRollingWindow(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+
+	_, err := app.ForwardingKeeper.SetRateLimit(chain.GetContext(), &types.MsgSetRateLimit{
+		Signer:  authority,
+		Channel: path.EndpointA.ChannelID,
+		Denom:   sdk.DefaultBondDenom,
+		Amount:  sdkmath.NewInt(1_000),
+		Window:  uint64(24 * time.Hour),
+	})
+	require.NoError(t, err)
+
+	send := func(address sdk.AccAddress, amount int64) {
+		_, err := chain.SendMsgs(banktypes.NewMsgSend(
+			chain.SenderAccount.GetAddress(),
+			address,
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+		))
+		require.NoError(t, err)
+	}
+
+	// ARRANGE: Forward 600 at the start of the window, and 400 towards its
+	// end, using up the entire quota.
+	address := forward(t, path, 600)
+	chain.Coordinator.IncrementTimeBy(20 * time.Hour)
+	send(address, 400)
+
+	ctx := headerContext(chain)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(1_000), app.ForwardingKeeper.GetRateLimitUsage(ctx, path.EndpointA.ChannelID, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Cross the boundary of the first window, and forward the full
+	// quota again.
+	chain.Coordinator.IncrementTimeBy(5 * time.Hour)
+	send(address, 1_000)
+
+	// ASSERT: Only the first forward has left the trailing window, so the
+	// forward is queued instead of doubling the quota across the boundary.
+	ctx = headerContext(chain)
+	require.Equal(t, sdkmath.NewInt(400), app.ForwardingKeeper.GetRateLimitUsage(ctx, path.EndpointA.ChannelID, sdk.DefaultBondDenom).Amount)
+	require.True(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Wait for the second forward to leave the trailing window.
+	chain.Coordinator.IncrementTimeBy(20 * time.Hour)
+	chain.NextBlock()
+
+	// ASSERT: The queued forward was executed.
+	ctx = headerContext(chain)
+	require.False(t, app.ForwardingKeeper.IsQueuedForward(ctx, address.String()))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(1_000), app.ForwardingKeeper.GetRateLimitUsage(ctx, path.EndpointA.ChannelID, sdk.DefaultBondDenom).Amount)
+}
+
+func TestRateLimitAboveLimit(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+	addresses := registerAccounts(t, path, 3)
+
+	setRateLimit := func(amount int64) {
+		_, err := app.ForwardingKeeper.SetRateLimit(chain.GetContext(), &types.MsgSetRateLimit{
+			Signer:  authority,
+			Channel: channel,
+			Denom:   sdk.DefaultBondDenom,
+			Amount:  sdkmath.NewInt(amount),
+			Window:  uint64(24 * time.Hour),
+		})
+		require.NoError(t, err)
+	}
+	send := func(address sdk.AccAddress, amount int64) {
+		_, err := chain.SendMsgs(banktypes.NewMsgSend(
+			chain.SenderAccount.GetAddress(),
+			address,
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+		))
+		require.NoError(t, err)
+	}
+	setRateLimit(1_000)
+
+	// ACT: Deposit more than the rate limit allows within a single window.
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), addresses[0], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_500))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The forward was deferred instead of queued.
+	require.Empty(t, sentPackets(t, ctx.EventManager().Events()))
+	require.False(t, app.ForwardingKeeper.IsQueuedForward(ctx, addresses[0].String()))
+	require.Equal(t, sdkmath.NewInt(1_500), app.BankKeeper.GetBalance(ctx, addresses[0], sdk.DefaultBondDenom).Amount)
+
+	deferred := deferredForwards(t, ctx.EventManager().Events())
+	require.Len(t, deferred, 1)
+	require.Equal(t, types.DeferReasonAboveRateLimit, deferred[0].Reason)
+
+	// ARRANGE: Use up most of the quota, and queue two forwards that exceed
+	// the remaining quota.
+	chain.NextBlock()
+	send(addresses[1], 600)
+	send(addresses[1], 600)
+	send(addresses[2], 500)
+
+	ctx = chain.GetContext()
+	require.Equal(t, []string{addresses[1].String(), addresses[2].String()}, app.ForwardingKeeper.GetQueuedForwards(ctx))
+	deferrals := app.ForwardingKeeper.GetNumOfDeferrals(ctx, channel)[types.DeferReasonAboveRateLimit.String()]
+
+	// ACT: Lower the rate limit below the first queued forward, and wait for
+	// the window to elapse.
+	setRateLimit(550)
+	chain.Coordinator.IncrementTimeBy(24 * time.Hour)
+	chain.NextBlock()
+
+	// ASSERT: The first queued forward was deferred, without blocking the
+	// forward queued after it.
+	ctx = chain.GetContext()
+	require.Empty(t, app.ForwardingKeeper.GetQueuedForwards(ctx))
+	require.Equal(t, sdkmath.NewInt(600), app.BankKeeper.GetBalance(ctx, addresses[1], sdk.DefaultBondDenom).Amount)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, addresses[2]).IsZero())
+
+	require.Equal(t, deferrals+1, app.ForwardingKeeper.GetNumOfDeferrals(ctx, channel)[types.DeferReasonAboveRateLimit.String()])
+}
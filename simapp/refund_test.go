@@ -0,0 +1,161 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/simapp"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// inFlightForward registers a forwarding account with a fallback address, and
+// executes its forward, returning the packet that was sent. The recipient is
+// invalid on the counterparty chain, so the packet is acknowledged with an
+// error once relayed.
+func inFlightForward(t *testing.T, path *ibctesting.Path, app *simapp.SimApp, policy types.RefundPolicy) (address sdk.AccAddress, fallback sdk.AccAddress, packet channeltypes.Packet) {
+	chain := path.EndpointA.Chain
+	fallback = chain.SenderAccounts[1].SenderAccount.GetAddress()
+
+	_, err := app.ForwardingKeeper.SetRefundPolicy(chain.GetContext(), &types.MsgSetRefundPolicy{
+		Signer: authority,
+		Policy: policy,
+	})
+	require.NoError(t, err)
+
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: "cosmos1recipient",
+		Channel:   path.EndpointA.ChannelID,
+		Fallback:  fallback.String(),
+	})
+	require.NoError(t, err)
+	address = types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: "cosmos1recipient", Fallback: fallback.String()})
+
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	packet, err = ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+	require.NoError(t, err)
+	chain.NextBlock()
+
+	return address, fallback, packet
+}
+
+func TestRefundAcknowledgementError(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	address, _, packet := inFlightForward(t, path, app, types.RefundPolicyHold)
+
+	// ASSERT: The packet is tracked as in flight.
+	ctx := chain.GetContext()
+	sent, found := app.ForwardingKeeper.GetInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	require.True(t, found)
+	require.Equal(t, address.String(), sent)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+
+	// ACT: Export and import the in-flight packets through genesis.
+	genesis := forwarding.ExportGenesis(ctx, app.ForwardingKeeper)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, []types.InFlightPacket{{Channel: packet.SourceChannel, Sequence: packet.Sequence, Address: address.String()}}, genesis.InFlightPackets)
+	app.ForwardingKeeper.RemoveInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	forwarding.InitGenesis(ctx, app.ForwardingKeeper, *genesis)
+
+	// ACT: Relay the packet, which is acknowledged with an error.
+	require.NoError(t, path.RelayPacket(packet))
+
+	// ASSERT: The refunded funds are held in the account, and the packet is
+	// no longer tracked.
+	ctx = chain.GetContext()
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+	_, found = app.ForwardingKeeper.GetInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	require.False(t, found)
+	require.Empty(t, app.ForwardingKeeper.GetAllRetries(ctx))
+}
+
+func TestRefundTimeout(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	address, _, packet := inFlightForward(t, path, app, types.RefundPolicyRetry)
+
+	// ACT: Time out the packet.
+	chain.Coordinator.IncrementTimeBy(time.Duration(types.DefaultTimeoutPolicy().Timestamp) + time.Minute)
+	path.EndpointB.Chain.NextBlock()
+	require.NoError(t, path.EndpointA.UpdateClient())
+	require.NoError(t, path.EndpointA.TimeoutPacket(packet))
+
+	// ASSERT: The refunded funds are queued for a retry.
+	ctx := chain.GetContext()
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+	retries := app.ForwardingKeeper.GetAllRetries(ctx)
+	require.Len(t, retries, 1)
+	require.Equal(t, address.String(), retries[0].Address)
+	require.Equal(t, "packet timed out", retries[0].LastError)
+	_, found := app.ForwardingKeeper.GetInFlightPacket(ctx, packet.SourceChannel, packet.Sequence)
+	require.False(t, found)
+}
+
+func TestRefundFallback(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	address, fallback, packet := inFlightForward(t, path, app, types.RefundPolicyFallback)
+	before := app.BankKeeper.GetBalance(chain.GetContext(), fallback, sdk.DefaultBondDenom)
+
+	// ACT: Relay the packet, which is acknowledged with an error.
+	require.NoError(t, path.RelayPacket(packet))
+
+	// ASSERT: The refunded funds were sent to the fallback address.
+	ctx := chain.GetContext()
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, before.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)), app.BankKeeper.GetBalance(ctx, fallback, sdk.DefaultBondDenom))
+}
+
+func TestRefundKeepsPendingDeposit(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	address, _, packet := inFlightForward(t, path, app, types.RefundPolicyHold)
+
+	// ACT: Deposit into the account, and acknowledge the packet with an error
+	// in the same block.
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))))
+
+	module, found := app.IBCKeeper.Router.GetRoute(transfertypes.ModuleName)
+	require.True(t, found)
+	ack := channeltypes.NewErrorAcknowledgement(errors.New("transfer failed"))
+	require.NoError(t, module.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), chain.SenderAccount.GetAddress()))
+
+	// ASSERT: The new deposit is still marked for forwarding.
+	pending := app.ForwardingKeeper.GetPendingForwards(ctx)
+	require.Len(t, pending, 1)
+	require.Equal(t, address.String(), pending[0].Address)
+	require.Equal(t, sdkmath.NewInt(1_500), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/simapp"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// failingForward registers a forwarding account with a fallback address, and
+// funds it while transfers are disabled, so that its forward fails.
+func failingForward(t *testing.T, path *ibctesting.Path, app *simapp.SimApp) (address sdk.AccAddress, fallback sdk.AccAddress) {
+	chain := path.EndpointA.Chain
+	fallback = chain.SenderAccounts[1].SenderAccount.GetAddress()
+	app.TransferKeeper.SetParams(chain.GetContext(), transfertypes.Params{SendEnabled: false, ReceiveEnabled: true})
+
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: "cosmos1recipient",
+		Channel:   path.EndpointA.ChannelID,
+		Fallback:  fallback.String(),
+	})
+	require.NoError(t, err)
+	address = types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: "cosmos1recipient", Fallback: fallback.String()})
+
+	_, err = chain.SendMsgs(banktypes.NewMsgSend(chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	require.NoError(t, err)
+
+	return address, fallback
+}
+
+func TestRetryBackoff(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	address, _ := failingForward(t, path, app)
+
+	// ASSERT: The failed forward is queued for a retry after the base delay.
+	ctx := chain.GetContext()
+	height := ctx.BlockHeight() - 1
+	res, err := app.ForwardingKeeper.Retries(ctx, &types.QueryRetries{})
+	require.NoError(t, err)
+	require.Len(t, res.Retries, 1)
+	require.Equal(t, address.String(), res.Retries[0].Address)
+	require.Equal(t, uint64(1), res.Retries[0].Attempts)
+	require.Equal(t, height+types.RetryBaseDelay, res.Retries[0].NextAttempt)
+
+	// ACT: Advance to the next attempt, which fails again.
+	for chain.GetContext().BlockHeight() <= height+types.RetryBaseDelay {
+		chain.NextBlock()
+	}
+
+	// ASSERT: The delay until the next attempt has doubled.
+	retries := app.ForwardingKeeper.GetAllRetries(chain.GetContext())
+	require.Len(t, retries, 1)
+	require.Equal(t, uint64(2), retries[0].Attempts)
+	require.Equal(t, height+types.RetryBaseDelay+2*types.RetryBaseDelay, retries[0].NextAttempt)
+
+	// ACT: Export and import the retries through genesis.
+	ctx = chain.GetContext()
+	genesis := forwarding.ExportGenesis(ctx, app.ForwardingKeeper)
+	require.NoError(t, genesis.Validate())
+	require.Equal(t, retries, genesis.Retries)
+	app.ForwardingKeeper.RemoveRetry(ctx, address.String())
+	forwarding.InitGenesis(ctx, app.ForwardingKeeper, *genesis)
+
+	// ASSERT: The retry survived the round trip.
+	require.Equal(t, retries, app.ForwardingKeeper.GetAllRetries(ctx))
+
+	// ACT: Enable transfers, and advance to the next attempt.
+	app.TransferKeeper.SetParams(ctx, transfertypes.DefaultParams())
+	for chain.GetContext().BlockHeight() <= retries[0].NextAttempt {
+		chain.NextBlock()
+	}
+
+	// ASSERT: The retry succeeded, and was removed from the queue.
+	ctx = chain.GetContext()
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Empty(t, app.ForwardingKeeper.GetAllRetries(ctx))
+}
+
+func TestRetryExhausted(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	address, fallback := failingForward(t, path, app)
+
+	// ACT: Fail the forward until all attempts are exhausted.
+	ctx := headerContext(chain)
+	for attempts := uint64(2); attempts <= types.MaxRetryAttempts; attempts++ {
+		app.ForwardingKeeper.SetRetry(ctx, address.String(), errors.New("transfer failed"))
+
+		retries := app.ForwardingKeeper.GetAllRetries(ctx)
+		require.Len(t, retries, 1)
+		require.Equal(t, attempts, retries[0].Attempts)
+		require.Equal(t, ctx.BlockHeight()+types.RetryDelay(attempts), retries[0].NextAttempt)
+		require.Equal(t, "transfer failed", retries[0].LastError)
+	}
+	app.ForwardingKeeper.SetRetry(ctx, address.String(), errors.New("transfer failed"))
+
+	// ASSERT: The account was dropped from the queue, with its funds left in
+	// the account.
+	require.Empty(t, app.ForwardingKeeper.GetAllRetries(ctx))
+	require.Equal(t, int64(1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount.Int64())
+
+	// ACT: Clear the account to its fallback address.
+	before := app.BankKeeper.GetBalance(ctx, fallback, sdk.DefaultBondDenom)
+	_, err := app.ForwardingKeeper.ClearAccount(ctx, &types.MsgClearAccount{
+		Signer:   chain.SenderAccount.GetAddress().String(),
+		Address:  address.String(),
+		Fallback: true,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The funds were recovered by the fallback address.
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, before.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)), app.BankKeeper.GetBalance(ctx, fallback, sdk.DefaultBondDenom))
+}
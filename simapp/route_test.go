@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDenomRoutes(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	routes := []types.DenomRoute{{Denom: "uusdc", Channel: channel, Recipient: "cosmos1routed"}}
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1default", DenomRoutes: routes})
+
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:      chain.SenderAccount.GetAddress().String(),
+		Recipient:   "cosmos1default",
+		Channel:     channel,
+		DenomRoutes: routes,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account with a routed and an unrouted denom, and execute
+	// the forward.
+	ctx := chain.GetContext()
+	coins := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 300), sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 300))))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, chain.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewInt64Coin("uusdc", 300))))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, coins))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: Each denom was forwarded through its route.
+	receivers := make(map[string]string)
+	for _, data := range sentPackets(t, ctx.EventManager().Events()) {
+		receivers[data.Denom] = data.Receiver
+	}
+	require.Equal(t, map[string]string{"uusdc": "cosmos1routed", sdk.DefaultBondDenom: "cosmos1default"}, receivers)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+
+	// ACT: Disallow the routed denom, and fund the account again.
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Clear(ctx, nil))
+	require.NoError(t, app.ForwardingKeeper.AllowedDenoms.Set(ctx, sdk.DefaultBondDenom))
+	ctx = chain.GetContext()
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 300))))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, address, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 300))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The routed denom is still gated by the allowed denoms.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, sdk.DefaultBondDenom, packets[0].Denom)
+	require.Equal(t, "300", app.BankKeeper.GetBalance(ctx, address, "uusdc").Amount.String())
+}
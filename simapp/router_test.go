@@ -0,0 +1,297 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mockRouter is a bridge that accepts routes with non-empty route data, and
+// escrows forwarded funds inside a bridge account.
+type mockRouter struct {
+	bank   types.BankKeeper
+	escrow sdk.AccAddress
+
+	forwarded []sdk.Coin
+	routes    [][]byte
+}
+
+var _ types.Router = &mockRouter{}
+
+func (r *mockRouter) ValidateRoute(_ context.Context, account types.ForwardingAccount) error {
+	if len(account.RouteData) == 0 {
+		return errors.New("missing bridge route")
+	}
+
+	return nil
+}
+
+func (r *mockRouter) Forward(ctx context.Context, account types.ForwardingAccount, balance sdk.Coin) error {
+	if err := r.bank.SendCoins(ctx, account.GetAddress(), r.escrow, sdk.NewCoins(balance)); err != nil {
+		return err
+	}
+
+	r.forwarded = append(r.forwarded, balance)
+	r.routes = append(r.routes, account.RouteData)
+	return nil
+}
+
+func TestCustomRouter(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+
+	router := &mockRouter{bank: app.BankKeeper, escrow: authtypes.NewModuleAddress("bridge")}
+	require.NoError(t, forwarding.InvokeRegisterRouters(app.ForwardingKeeper, map[string]types.ForwardingRouterWrapper{
+		"bridge": {Router: router},
+	}))
+	require.Panics(t, func() { app.ForwardingKeeper.RegisterRouter("bridge", router) })
+	require.Panics(t, func() { app.ForwardingKeeper.RegisterRouter("Invalid Name", router) })
+
+	// ACT: Attempt to register accounts with an unknown destination type, an
+	// invalid route, and a channel.
+	ctx := chain.GetContext()
+	_, err := app.ForwardingKeeper.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:          chain.SenderAccount.GetAddress().String(),
+		Recipient:       "0xrecipient",
+		DestinationType: "unknown",
+	})
+	require.ErrorContains(t, err, "unknown destination type")
+
+	_, err = app.ForwardingKeeper.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:          chain.SenderAccount.GetAddress().String(),
+		Recipient:       "0xrecipient",
+		DestinationType: "bridge",
+	})
+	require.ErrorContains(t, err, "missing bridge route")
+
+	_, err = app.ForwardingKeeper.RegisterAccount(ctx, &types.MsgRegisterAccount{
+		Signer:          chain.SenderAccount.GetAddress().String(),
+		Recipient:       "0xrecipient",
+		Channel:         path.EndpointA.ChannelID,
+		DestinationType: "bridge",
+		RouteData:       []byte{0x01},
+	})
+	require.Error(t, err)
+
+	// ACT: Register an account forwarding to the bridge.
+	routeData := []byte{0x01, 0x02}
+	address := types.GenerateAddress(types.ForwardingAccount{Recipient: "0xrecipient", DestinationType: "bridge", RouteData: routeData})
+	require.NotEqual(t, types.GenerateAddress(types.ForwardingAccount{Recipient: "0xrecipient", DestinationType: "bridge"}), address)
+
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:          chain.SenderAccount.GetAddress().String(),
+		Recipient:       "0xrecipient",
+		DestinationType: "bridge",
+		RouteData:       routeData,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account, and execute the forward.
+	ctx = chain.GetContext()
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The funds were forwarded by the bridge, without any transfer.
+	require.Empty(t, sentPackets(t, ctx.EventManager().Events()))
+	require.Equal(t, []sdk.Coin{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)}, router.forwarded)
+	require.Equal(t, [][]byte{routeData}, router.routes)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, "1000", app.BankKeeper.GetBalance(ctx, router.escrow, sdk.DefaultBondDenom).Amount.String())
+	require.Equal(t, uint64(1), app.ForwardingKeeper.GetAllNumOfForwards(ctx)["bridge"])
+}
+
+func TestLocalRouter(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	recipient := chain.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// ACT: Attempt to register a local account with an invalid recipient.
+	_, err := app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:          chain.SenderAccount.GetAddress().String(),
+		Recipient:       "osmo1recipient",
+		DestinationType: types.DestinationTypeLocal,
+	})
+	require.ErrorContains(t, err, "invalid local recipient address")
+
+	// ACT: Attempt to register a local account with a memo.
+	_, err = app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:          chain.SenderAccount.GetAddress().String(),
+		Recipient:       recipient.String(),
+		DestinationType: types.DestinationTypeLocal,
+		Memo:            "{}",
+	})
+	require.ErrorContains(t, err, "not supported by local destinations")
+
+	// ACT: Register a local account.
+	address := types.GenerateAddress(types.ForwardingAccount{Recipient: recipient.String(), DestinationType: types.DestinationTypeLocal})
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:          chain.SenderAccount.GetAddress().String(),
+		Recipient:       recipient.String(),
+		DestinationType: types.DestinationTypeLocal,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account, and execute the forward.
+	ctx := chain.GetContext()
+	before := app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The funds were sent to the recipient, without any transfer.
+	require.Empty(t, sentPackets(t, ctx.EventManager().Events()))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, before.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)), app.BankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)), app.ForwardingKeeper.GetTotalForwarded(ctx, types.DestinationTypeLocal))
+}
+
+func TestLocalRouterLoops(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	signer := chain.SenderAccount.GetAddress().String()
+	recipient := chain.SenderAccounts[1].SenderAccount.GetAddress()
+
+	// ARRANGE: Register a local account.
+	local := types.GenerateAddress(types.ForwardingAccount{Recipient: recipient.String(), DestinationType: types.DestinationTypeLocal})
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:          signer,
+		Recipient:       recipient.String(),
+		DestinationType: types.DestinationTypeLocal,
+	})
+	require.NoError(t, err)
+
+	// ACT: Attempt to register a local account that forwards into it.
+	_, err = app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:          signer,
+		Recipient:       local.String(),
+		DestinationType: types.DestinationTypeLocal,
+	})
+	require.ErrorIs(t, err, types.ErrForwardingLoop)
+
+	// ARRANGE: Register a local account that forwards into an address, that
+	// is registered as a forwarding account afterwards.
+	forwarding := types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: "cosmos1recipient"})
+	address := types.GenerateAddress(types.ForwardingAccount{Recipient: forwarding.String(), DestinationType: types.DestinationTypeLocal})
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:          signer,
+		Recipient:       forwarding.String(),
+		DestinationType: types.DestinationTypeLocal,
+	})
+	require.NoError(t, err)
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    signer,
+		Recipient: "cosmos1recipient",
+		Channel:   path.EndpointA.ChannelID,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account, and execute the forward.
+	ctx := chain.GetContext()
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The funds were left in the account.
+	require.Empty(t, sentPackets(t, ctx.EventManager().Events()))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, forwarding).IsZero())
+}
+
+func TestLocalRouterCycle(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	signer := chain.SenderAccount.GetAddress()
+
+	// ARRANGE: Register account A forwarding into the address of account B.
+	b := sdk.AccAddress("forwarding_account_b")
+	a := types.GenerateAddress(types.ForwardingAccount{Recipient: b.String(), DestinationType: types.DestinationTypeLocal})
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:          signer.String(),
+		Recipient:       b.String(),
+		DestinationType: types.DestinationTypeLocal,
+	})
+	require.NoError(t, err)
+
+	// ARRANGE: Create account B forwarding back into account A. Registering
+	// it is rejected, so it is created directly, like it could be through
+	// genesis.
+	ctx := chain.GetContext()
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, &types.ForwardingAccount{
+		BaseAccount:     authtypes.NewBaseAccountWithAddress(b),
+		Recipient:       a.String(),
+		DestinationType: types.DestinationTypeLocal,
+	}))
+
+	// ACT: Fund both accounts, and execute the forwards.
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, signer, a, coins))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, signer, b, coins))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: Both forwards were rejected, leaving the funds in place.
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, a))
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, b))
+	require.Zero(t, app.ForwardingKeeper.GetAllNumOfForwards(ctx)[types.DestinationTypeLocal])
+}
+
+func TestLocalRouterBlockedRecipient(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	signer := chain.SenderAccount.GetAddress()
+	pool := authtypes.NewModuleAddress(stakingtypes.BondedPoolName)
+
+	// ACT: Attempt to register a local account forwarding into a blocked
+	// module account.
+	_, err := app.ForwardingKeeper.RegisterAccount(chain.GetContext(), &types.MsgRegisterAccount{
+		Signer:          signer.String(),
+		Recipient:       pool.String(),
+		DestinationType: types.DestinationTypeLocal,
+	})
+
+	// ASSERT: The registration was rejected.
+	require.ErrorContains(t, err, "not allowed to receive funds")
+
+	// ARRANGE: Create the account directly, like it could be through genesis.
+	ctx := chain.GetContext()
+	address := types.GenerateAddress(types.ForwardingAccount{Recipient: pool.String(), DestinationType: types.DestinationTypeLocal})
+	app.AccountKeeper.SetAccount(ctx, app.AccountKeeper.NewAccount(ctx, &types.ForwardingAccount{
+		BaseAccount:     authtypes.NewBaseAccountWithAddress(address),
+		Recipient:       pool.String(),
+		DestinationType: types.DestinationTypeLocal,
+	}))
+
+	// ACT: Fund the account, and execute the forward.
+	before := app.BankKeeper.GetAllBalances(ctx, pool)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, signer, address, coins))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The forward was rejected, leaving the funds in the account.
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, address))
+	require.Equal(t, before, app.BankKeeper.GetAllBalances(ctx, pool))
+}
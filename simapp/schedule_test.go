@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func deposit(t *testing.T, chain *ibctesting.TestChain, address sdk.AccAddress, amount int64) {
+	_, err := chain.SendMsgs(banktypes.NewMsgSend(
+		chain.SenderAccount.GetAddress(),
+		address,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)),
+	))
+	require.NoError(t, err)
+}
+
+func TestScheduledForward(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, path.EndpointA.ChannelID)
+
+	schedule := types.Schedule{HoldDuration: uint64(time.Hour)}
+	recipient := path.EndpointB.Chain.SenderAccount.GetAddress().String()
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: path.EndpointA.ChannelID, Recipient: recipient, Schedule: schedule})
+
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: recipient,
+		Channel:   path.EndpointA.ChannelID,
+		Schedule:  schedule,
+	})
+	require.NoError(t, err)
+
+	// ACT: Deposit twice, half an hour apart.
+	deposit(t, chain, address, 1_000)
+	chain.Coordinator.IncrementTimeBy(30 * time.Minute)
+	deposit(t, chain, address, 500)
+
+	// ASSERT: Both deposits are held.
+	ctx := chain.GetContext()
+	deposits := app.ForwardingKeeper.GetHeldDeposits(ctx, address.String())
+	require.Len(t, deposits, 2)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000)), deposits[0].Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), deposits[1].Amount)
+	require.True(t, app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).IsZero())
+
+	// ACT: Wait for the first deposit to become eligible.
+	chain.Coordinator.IncrementTimeBy(30 * time.Minute)
+	chain.NextBlock()
+
+	// ASSERT: Only the first deposit was forwarded.
+	ctx = chain.GetContext()
+	require.Len(t, app.ForwardingKeeper.GetHeldDeposits(ctx, address.String()), 1)
+	require.Equal(t, sdkmath.NewInt(1_000), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+	require.Equal(t, sdkmath.NewInt(500), app.BankKeeper.GetBalance(ctx, address, sdk.DefaultBondDenom).Amount)
+
+	// ACT: Wait for the second deposit to become eligible.
+	chain.Coordinator.IncrementTimeBy(30 * time.Minute)
+	chain.NextBlock()
+
+	// ASSERT: The second deposit was forwarded.
+	ctx = chain.GetContext()
+	require.Empty(t, app.ForwardingKeeper.GetHeldDeposits(ctx, address.String()))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, sdkmath.NewInt(1_500), app.BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+}
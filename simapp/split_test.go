@@ -0,0 +1,134 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// sentPackets returns the data of all transfer packets sent in the specified
+// events.
+func sentPackets(t *testing.T, events sdk.Events) (packets []transfertypes.FungibleTokenPacketData) {
+	for _, event := range events {
+		if event.Type != channeltypes.EventTypeSendPacket {
+			continue
+		}
+
+		for _, attribute := range event.Attributes {
+			if attribute.Key != channeltypes.AttributeKeyDataHex {
+				continue
+			}
+
+			bz, err := hex.DecodeString(attribute.Value)
+			require.NoError(t, err)
+
+			var data transfertypes.FungibleTokenPacketData
+			require.NoError(t, transfertypes.ModuleCdc.UnmarshalJSON(bz, &data))
+			packets = append(packets, data)
+		}
+	}
+
+	return
+}
+
+func TestSplitForward(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	splits := []types.Split{
+		{Channel: channel, Recipient: "cosmos1primary", Bps: 7_000},
+		{Channel: channel, Recipient: "cosmos1secondary", Bps: 3_000},
+	}
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: splits[0].Recipient, Splits: splits})
+
+	// ARRANGE: Splits that don't total 100% are rejected.
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: splits[0].Recipient,
+		Channel:   channel,
+		Splits:    splits[:1],
+	})
+	require.ErrorContains(t, err, "splits must total 10000 basis points")
+
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: splits[0].Recipient,
+		Channel:   channel,
+		Splits:    splits,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account, and execute the forward.
+	ctx := chain.GetContext()
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_001))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The balance was split, with the remainder added to the first split.
+	amounts := make(map[string]string)
+	for _, data := range sentPackets(t, ctx.EventManager().Events()) {
+		amounts[data.Receiver] = data.Amount
+	}
+	require.Equal(t, map[string]string{"cosmos1primary": "701", "cosmos1secondary": "300"}, amounts)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, uint64(2), app.ForwardingKeeper.GetAllNumOfForwards(ctx)[channel])
+}
+
+func TestSplitForwardDust(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	splits := []types.Split{
+		{Channel: channel, Recipient: "cosmos1primary", Bps: 7_000},
+		{Channel: channel, Recipient: "cosmos1secondary", Bps: 3_000},
+	}
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: splits[0].Recipient, Splits: splits})
+
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: splits[0].Recipient,
+		Channel:   channel,
+		Splits:    splits,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account with a balance too small for the second share.
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The empty share was skipped, with the balance forwarded to the
+	// first split.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, "cosmos1primary", packets[0].Receiver)
+	require.Equal(t, "2", packets[0].Amount)
+	require.Empty(t, deferredForwards(t, ctx.EventManager().Events()))
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
@@ -0,0 +1,137 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v8/testing"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutPolicy(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	_, err := app.ForwardingKeeper.SetTimeoutPolicy(chain.GetContext(), &types.MsgSetTimeoutPolicy{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Channel:   channel,
+		Timestamp: uint64(time.Minute),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ASSERT: The default timeout policy applies to channels without one.
+	res, err := app.ForwardingKeeper.TimeoutPolicy(chain.GetContext(), &types.QueryTimeoutPolicy{Channel: channel})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultTimeoutPolicy(), res.Policy)
+
+	// ACT: Configure both a timestamp and a height timeout on the channel.
+	_, err = app.ForwardingKeeper.SetTimeoutPolicy(chain.GetContext(), &types.MsgSetTimeoutPolicy{
+		Signer:    authority,
+		Channel:   channel,
+		Timestamp: uint64(time.Minute),
+		Height:    10,
+	})
+	require.NoError(t, err)
+
+	// ACT: Execute a forward through the channel.
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: "cosmos1recipient",
+		Channel:   channel,
+	})
+	require.NoError(t, err)
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient"})
+
+	ctx := headerContext(chain)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The packet times out relative to the current time and the
+	// latest height of the counterparty.
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+	require.NoError(t, err)
+	_, clientState, err := app.IBCKeeper.ChannelKeeper.GetChannelClientState(ctx, transfertypes.PortID, channel)
+	require.NoError(t, err)
+	latest := clientState.GetLatestHeight()
+	require.Equal(t, uint64(ctx.BlockTime().Add(time.Minute).UnixNano()), packet.TimeoutTimestamp)
+	require.Equal(t, clienttypes.NewHeight(latest.GetRevisionNumber(), latest.GetRevisionHeight()+10), packet.TimeoutHeight)
+
+	// ACT: Remove the timeout policy of the channel.
+	_, err = app.ForwardingKeeper.SetTimeoutPolicy(ctx, &types.MsgSetTimeoutPolicy{
+		Signer:  authority,
+		Channel: channel,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The channel falls back to the default timeout policy.
+	res, err = app.ForwardingKeeper.TimeoutPolicy(ctx, &types.QueryTimeoutPolicy{Channel: channel})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultTimeoutPolicy(), res.Policy)
+	require.Empty(t, app.ForwardingKeeper.GetAllTimeoutPolicies(ctx))
+}
+
+func TestTimeoutPolicies(t *testing.T) {
+	path, app := setupTransferPath(t)
+	other := ibctesting.NewTransferPath(path.EndpointA.Chain, path.EndpointB.Chain)
+	path.EndpointA.Chain.Coordinator.Setup(other)
+	ctx := path.EndpointA.Chain.GetContext()
+
+	policy := types.TimeoutPolicy{Height: 10}
+	_, err := app.ForwardingKeeper.SetTimeoutPolicy(ctx, &types.MsgSetTimeoutPolicy{
+		Signer:  authority,
+		Channel: other.EndpointA.ChannelID,
+		Height:  policy.Height,
+	})
+	require.NoError(t, err)
+
+	// ACT: Query the first page of timeout policies.
+	res, err := app.ForwardingKeeper.TimeoutPolicies(ctx, &types.QueryTimeoutPolicies{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The first channel uses the default timeout policy.
+	require.Equal(t, []types.ChannelTimeoutPolicy{
+		{Channel: path.EndpointA.ChannelID, Policy: types.DefaultTimeoutPolicy(), Configured: false},
+	}, res.Policies)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+	require.Equal(t, []byte(other.EndpointA.ChannelID), res.Pagination.NextKey)
+
+	// ACT: Query the next page of timeout policies.
+	res, err = app.ForwardingKeeper.TimeoutPolicies(ctx, &types.QueryTimeoutPolicies{
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The second channel uses its configured timeout policy.
+	require.Equal(t, []types.ChannelTimeoutPolicy{
+		{Channel: other.EndpointA.ChannelID, Policy: policy, Configured: true},
+	}, res.Policies)
+	require.Nil(t, res.Pagination.NextKey)
+}
@@ -0,0 +1,172 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package simapp_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	forwarding "github.com/noble-assets/forwarding/v2"
+	"github.com/noble-assets/forwarding/v2/simapp"
+	"github.com/noble-assets/forwarding/v2/types"
+	"github.com/stretchr/testify/require"
+)
+
+// mintVouchers creates an IBC voucher for both a denom that unwinds through
+// the given channel, and a denom that would create a multi-hop voucher.
+func mintVouchers(t *testing.T, app *simapp.SimApp, ctx sdk.Context, channel string, address sdk.AccAddress) (unwinding string, multihop string) {
+	unwindingTrace := transfertypes.DenomTrace{Path: "transfer/" + channel, BaseDenom: "uatom"}
+	multihopTrace := transfertypes.DenomTrace{Path: "transfer/channel-99", BaseDenom: "uatom"}
+	app.TransferKeeper.SetDenomTrace(ctx, unwindingTrace)
+	app.TransferKeeper.SetDenomTrace(ctx, multihopTrace)
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(unwindingTrace.IBCDenom(), 100), sdk.NewInt64Coin(multihopTrace.IBCDenom(), 200))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, address, coins))
+
+	return unwindingTrace.IBCDenom(), multihopTrace.IBCDenom()
+}
+
+// rejectedVouchers returns the vouchers that were rejected by unwind-only mode.
+func rejectedVouchers(t *testing.T, events sdk.Events) (rejected []*types.VoucherRejected) {
+	for _, event := range events {
+		if event.Type != "noble.forwarding.v1.VoucherRejected" {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		rejected = append(rejected, msg.(*types.VoucherRejected))
+	}
+
+	return
+}
+
+func TestAccountUnwindOnly(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+	fallback := chain.SenderAccounts[1].SenderAccount.GetAddress()
+
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient", Fallback: fallback.String(), UnwindOnly: true})
+	require.NotEqual(t, types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient", Fallback: fallback.String()}), address)
+
+	_, err := chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:     chain.SenderAccount.GetAddress().String(),
+		Recipient:  "cosmos1recipient",
+		Channel:    channel,
+		Fallback:   fallback.String(),
+		UnwindOnly: true,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account with a native denom, an unwinding voucher and a
+	// multi-hop voucher, and execute the forward.
+	ctx := chain.GetContext()
+	unwinding, multihop := mintVouchers(t, app, ctx, channel, address)
+	require.NoError(t, app.BankKeeper.SendCoins(ctx, chain.SenderAccount.GetAddress(), address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500))))
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The native denom and unwinding voucher were forwarded.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 2)
+	denoms := []string{packets[0].Denom, packets[1].Denom}
+	require.Contains(t, denoms, sdk.DefaultBondDenom)
+	require.Contains(t, denoms, "transfer/"+channel+"/uatom")
+
+	// ASSERT: The multi-hop voucher was sent to the fallback.
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+	require.Equal(t, "200", app.BankKeeper.GetBalance(ctx, fallback, multihop).Amount.String())
+	require.True(t, app.BankKeeper.GetBalance(ctx, fallback, unwinding).IsZero())
+
+	rejected := rejectedVouchers(t, ctx.EventManager().Events())
+	require.Len(t, rejected, 1)
+	require.Equal(t, address.String(), rejected[0].Address)
+	require.Equal(t, channel, rejected[0].Channel)
+	require.Equal(t, sdk.NewInt64Coin(multihop, 200), rejected[0].Amount)
+	require.Equal(t, fallback.String(), rejected[0].Fallback)
+}
+
+func TestGlobalUnwindOnly(t *testing.T) {
+	path, app := setupTransferPath(t)
+	chain := path.EndpointA.Chain
+	channel := path.EndpointA.ChannelID
+
+	_, err := app.ForwardingKeeper.SetUnwindOnly(chain.GetContext(), &types.MsgSetUnwindOnly{
+		Signer:  chain.SenderAccount.GetAddress().String(),
+		Enabled: true,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidAuthority)
+
+	// ACT: Enable the module-wide unwind-only mode.
+	ctx := chain.GetContext()
+	_, err = app.ForwardingKeeper.SetUnwindOnly(ctx, &types.MsgSetUnwindOnly{
+		Signer:  authority,
+		Enabled: true,
+	})
+	require.NoError(t, err)
+
+	res, err := app.ForwardingKeeper.UnwindOnly(ctx, &types.QueryUnwindOnly{})
+	require.NoError(t, err)
+	require.True(t, res.Enabled)
+	require.True(t, forwarding.ExportGenesis(ctx, app.ForwardingKeeper).UnwindOnly)
+
+	address := types.GenerateAddress(types.ForwardingAccount{Channel: channel, Recipient: "cosmos1recipient"})
+	_, err = chain.SendMsgs(&types.MsgRegisterAccount{
+		Signer:    chain.SenderAccount.GetAddress().String(),
+		Recipient: "cosmos1recipient",
+		Channel:   channel,
+	})
+	require.NoError(t, err)
+
+	// ACT: Fund the account without a fallback with vouchers, and execute the
+	// forward.
+	ctx = chain.GetContext()
+	unwinding, multihop := mintVouchers(t, app, ctx, channel, address)
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+
+	// ASSERT: The multi-hop voucher was left in the account to be cleared.
+	packets := sentPackets(t, ctx.EventManager().Events())
+	require.Len(t, packets, 1)
+	require.Equal(t, "transfer/"+channel+"/uatom", packets[0].Denom)
+	require.True(t, app.BankKeeper.GetBalance(ctx, address, unwinding).IsZero())
+	require.Equal(t, "200", app.BankKeeper.GetBalance(ctx, address, multihop).Amount.String())
+
+	rejected := rejectedVouchers(t, ctx.EventManager().Events())
+	require.Len(t, rejected, 1)
+	require.Empty(t, rejected[0].Fallback)
+
+	// ACT: Disable the module-wide unwind-only mode.
+	_, err = app.ForwardingKeeper.SetUnwindOnly(ctx, &types.MsgSetUnwindOnly{
+		Signer:  authority,
+		Enabled: false,
+	})
+	require.NoError(t, err)
+
+	// ASSERT: The multi-hop voucher is forwarded again.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.ForwardingKeeper.ExecuteForwards(ctx)
+	require.Len(t, sentPackets(t, ctx.EventManager().Events()), 1)
+	require.True(t, app.BankKeeper.GetAllBalances(ctx, address).IsZero())
+}
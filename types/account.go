@@ -28,7 +28,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
 var (
@@ -36,20 +35,132 @@ var (
 	_ authtypes.GenesisAccount = &ForwardingAccount{}
 )
 
-func GenerateAddress(channel string, recipient string, fallback string) sdk.AccAddress {
-	bz := []byte(channel + recipient + fallback)
-	return address.Derive([]byte(ModuleName), bz)[12:]
+// GenerateAddress derives the address of a forwarding account from its
+// route. Accounts without a memo, hops, schedule, splits, batching, denom
+// routes, allowed denoms, unwind-only mode or non IBC destination keep the
+// original derivation from their channel, recipient and fallback, while all
+// other fields are mixed into additional derivation steps, each tagged with
+// the name of its field.
+func GenerateAddress(account ForwardingAccount) sdk.AccAddress {
+	bz := []byte(account.Channel + account.Recipient + account.Fallback)
+	derived := address.Derive([]byte(ModuleName), bz)
+
+	if account.Memo != "" {
+		derived = address.Derive(address.Derive(derived, []byte("memo")), []byte(account.Memo))
+	}
+
+	if len(account.Hops) > 0 {
+		derived = address.Derive(address.Derive(derived, []byte("hops")), EncodeHops(account.Hops))
+	}
+
+	if !account.Schedule.IsZero() {
+		derived = address.Derive(address.Derive(derived, []byte("schedule")), EncodeSchedule(account.Schedule))
+	}
+
+	if len(account.Splits) > 0 {
+		derived = address.Derive(address.Derive(derived, []byte("splits")), EncodeSplits(account.Splits))
+	}
+
+	if !account.Batching.IsZero() {
+		derived = address.Derive(address.Derive(derived, []byte("batching")), EncodeBatching(account.Batching))
+	}
+
+	if len(account.DenomRoutes) > 0 {
+		derived = address.Derive(address.Derive(derived, []byte("denom_routes")), EncodeDenomRoutes(account.DenomRoutes))
+	}
+
+	if len(account.AllowedDenoms) > 0 {
+		derived = address.Derive(address.Derive(derived, []byte("allowed_denoms")), EncodeAccountDenoms(account.AllowedDenoms))
+	}
+
+	if account.UnwindOnly {
+		derived = address.Derive(address.Derive(derived, []byte("unwind_only")), []byte{1})
+	}
+
+	if !IsIBCDestination(account.DestinationType) {
+		derived = address.Derive(address.Derive(derived, []byte("destination_type")), []byte(account.DestinationType))
+	}
+
+	if len(account.RouteData) > 0 {
+		derived = address.Derive(address.Derive(derived, []byte("route_data")), account.RouteData)
+	}
+
+	return derived[12:]
+}
+
+// ForwardingAccount returns the forwarding account registered by the message,
+// without its base account.
+func (msg *MsgRegisterAccount) ForwardingAccount() ForwardingAccount {
+	return ForwardingAccount{
+		Channel:         msg.Channel,
+		Recipient:       msg.Recipient,
+		Fallback:        msg.Fallback,
+		Memo:            msg.Memo,
+		Hops:            msg.Hops,
+		Schedule:        msg.Schedule,
+		Splits:          msg.Splits,
+		Batching:        msg.Batching,
+		DenomRoutes:     msg.DenomRoutes,
+		AllowedDenoms:   msg.AllowedDenoms,
+		UnwindOnly:      msg.UnwindOnly,
+		DestinationType: msg.DestinationType,
+		RouteData:       msg.RouteData,
+	}
+}
+
+// ForwardingAccount returns the forwarding account whose address is queried,
+// without its base account.
+func (req *QueryAddress) ForwardingAccount() ForwardingAccount {
+	return ForwardingAccount{
+		Channel:         req.Channel,
+		Recipient:       req.Recipient,
+		Fallback:        req.Fallback,
+		Memo:            req.Memo,
+		Hops:            req.Hops,
+		Schedule:        req.Schedule,
+		Splits:          req.Splits,
+		Batching:        req.Batching,
+		DenomRoutes:     req.DenomRoutes,
+		AllowedDenoms:   req.AllowedDenoms,
+		UnwindOnly:      req.UnwindOnly,
+		DestinationType: req.DestinationType,
+		RouteData:       req.RouteData,
+	}
 }
 
 func (fa *ForwardingAccount) Validate() error {
-	if !channeltypes.IsValidChannelID(fa.Channel) {
-		return fmt.Errorf("%s is an invalid channel id", fa.Channel)
+	if err := fa.ValidateDestination(); err != nil {
+		return err
 	}
 
 	if fa.CreatedAt < 0 {
 		return fmt.Errorf("%d is an invalid creation block height", fa.CreatedAt)
 	}
 
+	if err := ValidateHops(fa.Hops, fa.Memo); err != nil {
+		return err
+	}
+
+	if err := ValidateSchedule(fa.Schedule); err != nil {
+		return err
+	}
+
+	if err := ValidateSplits(fa.Channel, fa.Recipient, fa.Hops, fa.Splits); err != nil {
+		return err
+	}
+
+	if err := ValidateBatching(fa.Batching); err != nil {
+		return err
+	}
+
+	if err := ValidateDenomRoutes(fa.DenomRoutes); err != nil {
+		return err
+	}
+
+	if err := ValidateAccountDenoms(fa.AllowedDenoms, fa.DenomRoutes); err != nil {
+		return err
+	}
+
 	return fa.BaseAccount.Validate()
 }
 
@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/x/auth/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,10 +29,20 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type ForwardingAccount struct {
 	*types.BaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account,omitempty"`
-	Channel            string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	Recipient          string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	CreatedAt          int64  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Fallback           string `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Channel            string       `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Recipient          string       `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	CreatedAt          int64        `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Fallback           string       `protobuf:"bytes,5,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Memo               string       `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`
+	Hops               []Hop        `protobuf:"bytes,7,rep,name=hops,proto3" json:"hops"`
+	Schedule           Schedule     `protobuf:"bytes,8,opt,name=schedule,proto3" json:"schedule"`
+	Splits             []Split      `protobuf:"bytes,9,rep,name=splits,proto3" json:"splits"`
+	Batching           Batching     `protobuf:"bytes,10,opt,name=batching,proto3" json:"batching"`
+	DenomRoutes        []DenomRoute `protobuf:"bytes,11,rep,name=denom_routes,json=denomRoutes,proto3" json:"denom_routes"`
+	AllowedDenoms      []string     `protobuf:"bytes,12,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	UnwindOnly         bool         `protobuf:"varint,13,opt,name=unwind_only,json=unwindOnly,proto3" json:"unwind_only,omitempty"`
+	DestinationType    string       `protobuf:"bytes,14,opt,name=destination_type,json=destinationType,proto3" json:"destination_type,omitempty"`
+	RouteData          []byte       `protobuf:"bytes,15,opt,name=route_data,json=routeData,proto3" json:"route_data,omitempty"`
 }
 
 func (m *ForwardingAccount) Reset()         { *m = ForwardingAccount{} }
@@ -94,6 +106,390 @@ func (m *ForwardingAccount) GetFallback() string {
 	return ""
 }
 
+func (m *ForwardingAccount) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *ForwardingAccount) GetHops() []Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return Schedule{}
+}
+
+func (m *ForwardingAccount) GetSplits() []Split {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetBatching() Batching {
+	if m != nil {
+		return m.Batching
+	}
+	return Batching{}
+}
+
+func (m *ForwardingAccount) GetDenomRoutes() []DenomRoute {
+	if m != nil {
+		return m.DenomRoutes
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *ForwardingAccount) GetUnwindOnly() bool {
+	if m != nil {
+		return m.UnwindOnly
+	}
+	return false
+}
+
+func (m *ForwardingAccount) GetDestinationType() string {
+	if m != nil {
+		return m.DestinationType
+	}
+	return ""
+}
+
+func (m *ForwardingAccount) GetRouteData() []byte {
+	if m != nil {
+		return m.RouteData
+	}
+	return nil
+}
+
+// Hop defines an additional transfer that is executed by the intermediate
+// chain after receiving a forward, using packet-forward-middleware.
+type Hop struct {
+	// channel is the channel id, on the intermediate chain, that funds are
+	// forwarded through.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// receiver is the address of the receiver on the next chain.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *Hop) Reset()         { *m = Hop{} }
+func (m *Hop) String() string { return proto.CompactTextString(m) }
+func (*Hop) ProtoMessage()    {}
+func (*Hop) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{1}
+}
+func (m *Hop) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Hop) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Hop.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Hop) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hop.Merge(m, src)
+}
+func (m *Hop) XXX_Size() int {
+	return m.Size()
+}
+func (m *Hop) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hop.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Hop proto.InternalMessageInfo
+
+func (m *Hop) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Hop) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// Schedule defines when deposits into a forwarding account become eligible to
+// be forwarded. Each deposit is held for the hold duration, and then released
+// at the next release time of the schedule.
+type Schedule struct {
+	// hold_duration is how long each deposit is held, in nanoseconds.
+	HoldDuration uint64 `protobuf:"varint,1,opt,name=hold_duration,json=holdDuration,proto3" json:"hold_duration,omitempty"`
+	// release_time is the first time deposits are released, in unix
+	// nanoseconds. If zero, deposits are released once they have been held.
+	ReleaseTime int64 `protobuf:"varint,2,opt,name=release_time,json=releaseTime,proto3" json:"release_time,omitempty"`
+	// release_interval is the interval between releases following the release
+	// time, in nanoseconds. If zero, deposits held past the release time are
+	// released once they have been held.
+	ReleaseInterval uint64 `protobuf:"varint,3,opt,name=release_interval,json=releaseInterval,proto3" json:"release_interval,omitempty"`
+}
+
+func (m *Schedule) Reset()         { *m = Schedule{} }
+func (m *Schedule) String() string { return proto.CompactTextString(m) }
+func (*Schedule) ProtoMessage()    {}
+func (*Schedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{2}
+}
+func (m *Schedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Schedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Schedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Schedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Schedule.Merge(m, src)
+}
+func (m *Schedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *Schedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_Schedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Schedule proto.InternalMessageInfo
+
+func (m *Schedule) GetHoldDuration() uint64 {
+	if m != nil {
+		return m.HoldDuration
+	}
+	return 0
+}
+
+func (m *Schedule) GetReleaseTime() int64 {
+	if m != nil {
+		return m.ReleaseTime
+	}
+	return 0
+}
+
+func (m *Schedule) GetReleaseInterval() uint64 {
+	if m != nil {
+		return m.ReleaseInterval
+	}
+	return 0
+}
+
+// Split defines a share of the funds received by a forwarding account, that is
+// forwarded to a recipient through a channel.
+type Split struct {
+	// channel is the channel id that the share is forwarded through.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// recipient is the address of the recipient of the share.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// bps is the share of the funds, in basis points.
+	Bps uint32 `protobuf:"varint,3,opt,name=bps,proto3" json:"bps,omitempty"`
+}
+
+func (m *Split) Reset()         { *m = Split{} }
+func (m *Split) String() string { return proto.CompactTextString(m) }
+func (*Split) ProtoMessage()    {}
+func (*Split) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{3}
+}
+func (m *Split) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Split) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Split.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Split) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Split.Merge(m, src)
+}
+func (m *Split) XXX_Size() int {
+	return m.Size()
+}
+func (m *Split) XXX_DiscardUnknown() {
+	xxx_messageInfo_Split.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Split proto.InternalMessageInfo
+
+func (m *Split) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *Split) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *Split) GetBps() uint32 {
+	if m != nil {
+		return m.Bps
+	}
+	return 0
+}
+
+// Batching defines how funds received by a forwarding account accumulate
+// before being forwarded. Funds are forwarded once the balance of any denom
+// reaches its target, or the maximum wait since the first deposit of the
+// batch has passed, whichever comes first.
+type Batching struct {
+	// targets are the balances, per denom, that trigger a forward once reached.
+	Targets github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=targets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"targets"`
+	// max_wait is the maximum time funds accumulate for, in nanoseconds.
+	MaxWait uint64 `protobuf:"varint,2,opt,name=max_wait,json=maxWait,proto3" json:"max_wait,omitempty"`
+}
+
+func (m *Batching) Reset()         { *m = Batching{} }
+func (m *Batching) String() string { return proto.CompactTextString(m) }
+func (*Batching) ProtoMessage()    {}
+func (*Batching) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{4}
+}
+func (m *Batching) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Batching) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Batching.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Batching) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Batching.Merge(m, src)
+}
+func (m *Batching) XXX_Size() int {
+	return m.Size()
+}
+func (m *Batching) XXX_DiscardUnknown() {
+	xxx_messageInfo_Batching.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Batching proto.InternalMessageInfo
+
+func (m *Batching) GetTargets() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *Batching) GetMaxWait() uint64 {
+	if m != nil {
+		return m.MaxWait
+	}
+	return 0
+}
+
+// DenomRoute defines where funds of a specific denom received by a forwarding
+// account are forwarded, instead of the default route of the account.
+type DenomRoute struct {
+	// denom is the denom that the route applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// channel is the channel id that the denom is forwarded through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// recipient is the address of the recipient of the denom.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *DenomRoute) Reset()         { *m = DenomRoute{} }
+func (m *DenomRoute) String() string { return proto.CompactTextString(m) }
+func (*DenomRoute) ProtoMessage()    {}
+func (*DenomRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d86db85ab0c667b, []int{5}
+}
+func (m *DenomRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRoute.Merge(m, src)
+}
+func (m *DenomRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRoute proto.InternalMessageInfo
+
+func (m *DenomRoute) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRoute) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *DenomRoute) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
 type ForwardingPubKey struct {
 	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
 }
@@ -101,7 +497,7 @@ type ForwardingPubKey struct {
 func (m *ForwardingPubKey) Reset()      { *m = ForwardingPubKey{} }
 func (*ForwardingPubKey) ProtoMessage() {}
 func (*ForwardingPubKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9d86db85ab0c667b, []int{1}
+	return fileDescriptor_9d86db85ab0c667b, []int{6}
 }
 func (m *ForwardingPubKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,36 +535,68 @@ func (m *ForwardingPubKey) GetKey() []byte {
 
 func init() {
 	proto.RegisterType((*ForwardingAccount)(nil), "noble.forwarding.v1.ForwardingAccount")
+	proto.RegisterType((*Hop)(nil), "noble.forwarding.v1.Hop")
+	proto.RegisterType((*Schedule)(nil), "noble.forwarding.v1.Schedule")
+	proto.RegisterType((*Split)(nil), "noble.forwarding.v1.Split")
+	proto.RegisterType((*Batching)(nil), "noble.forwarding.v1.Batching")
+	proto.RegisterType((*DenomRoute)(nil), "noble.forwarding.v1.DenomRoute")
 	proto.RegisterType((*ForwardingPubKey)(nil), "noble.forwarding.v1.ForwardingPubKey")
 }
 
 func init() { proto.RegisterFile("noble/forwarding/v1/account.proto", fileDescriptor_9d86db85ab0c667b) }
 
 var fileDescriptor_9d86db85ab0c667b = []byte{
-	// 356 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0xcb, 0x4e, 0xc2, 0x40,
-	0x14, 0xed, 0x08, 0x3e, 0x18, 0x58, 0x60, 0x75, 0x51, 0x09, 0x96, 0xca, 0x8a, 0x68, 0xe8, 0xa4,
-	0xb8, 0x63, 0x07, 0x89, 0x26, 0xc4, 0x8d, 0xe9, 0xd2, 0x0d, 0x99, 0x19, 0x86, 0xd2, 0x50, 0x3a,
-	0xa4, 0x33, 0xc5, 0xf0, 0x17, 0x2e, 0x5d, 0xfa, 0x11, 0x7e, 0x84, 0x71, 0xc5, 0xd2, 0x95, 0x31,
-	0xf0, 0x09, 0xfe, 0x80, 0x61, 0x3a, 0xd2, 0x98, 0xb8, 0xbb, 0xe7, 0xdc, 0x7b, 0xe6, 0x9c, 0x3b,
-	0x17, 0x5e, 0xc4, 0x9c, 0x44, 0x0c, 0x8d, 0x79, 0xf2, 0x88, 0x93, 0x51, 0x18, 0x07, 0x68, 0xe1,
-	0x21, 0x4c, 0x29, 0x4f, 0x63, 0xe9, 0xce, 0x13, 0x2e, 0xb9, 0x79, 0xa2, 0x46, 0xdc, 0x7c, 0xc4,
-	0x5d, 0x78, 0x35, 0x9b, 0x72, 0x31, 0xe3, 0x02, 0xe1, 0x54, 0x4e, 0xd0, 0xc2, 0x23, 0x4c, 0x62,
-	0x4f, 0x81, 0x4c, 0x54, 0x3b, 0xcb, 0xfa, 0x43, 0x85, 0x50, 0x06, 0x74, 0xeb, 0x34, 0xe0, 0x01,
-	0xcf, 0xf8, 0x6d, 0x95, 0xb1, 0xcd, 0x6f, 0x00, 0x8f, 0x6f, 0x77, 0x16, 0xbd, 0x2c, 0x81, 0x39,
-	0x80, 0x15, 0x82, 0x05, 0x1b, 0xea, 0x44, 0x16, 0x70, 0x40, 0xab, 0xdc, 0x71, 0x5c, 0xfd, 0xa0,
-	0x32, 0xd4, 0xee, 0x6e, 0x1f, 0x0b, 0xa6, 0x75, 0xfd, 0xe2, 0xea, 0xb3, 0x01, 0xfc, 0x32, 0xc9,
-	0x29, 0xd3, 0x82, 0x87, 0x74, 0x82, 0xe3, 0x98, 0x45, 0xd6, 0x9e, 0x03, 0x5a, 0x25, 0xff, 0x17,
-	0x9a, 0x75, 0x58, 0x4a, 0x18, 0x0d, 0xe7, 0x21, 0x8b, 0xa5, 0x55, 0x50, 0xbd, 0x9c, 0x30, 0xcf,
-	0x21, 0xa4, 0x09, 0xc3, 0x92, 0x8d, 0x86, 0x58, 0x5a, 0x45, 0x07, 0xb4, 0x0a, 0x7e, 0x49, 0x33,
-	0x3d, 0x69, 0xd6, 0xe0, 0xd1, 0x18, 0x47, 0x11, 0xc1, 0x74, 0x6a, 0xed, 0x2b, 0xed, 0x0e, 0x77,
-	0x9d, 0xf7, 0xd7, 0x76, 0xfd, 0xbf, 0xa8, 0x3a, 0xd3, 0xa0, 0x79, 0x09, 0xab, 0xf9, 0xd2, 0xf7,
-	0x29, 0xb9, 0x63, 0x4b, 0xb3, 0x0a, 0x0b, 0x53, 0xb6, 0x54, 0xab, 0x56, 0xfc, 0x6d, 0xd9, 0x2d,
-	0x3e, 0xbf, 0x34, 0x8c, 0xfe, 0xcd, 0xdb, 0xda, 0x06, 0xab, 0xb5, 0x0d, 0xbe, 0xd6, 0x36, 0x78,
-	0xda, 0xd8, 0xc6, 0x6a, 0x63, 0x1b, 0x1f, 0x1b, 0xdb, 0x78, 0xb8, 0x0a, 0x42, 0x39, 0x49, 0x89,
-	0x4b, 0xf9, 0x0c, 0xa9, 0x63, 0xb5, 0xb1, 0x10, 0x4c, 0x8a, 0x3f, 0x67, 0xed, 0x20, 0xb9, 0x9c,
-	0x33, 0x41, 0x0e, 0xd4, 0x7f, 0x5f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xd3, 0x7f, 0x79,
-	0xfa, 0x01, 0x00, 0x00,
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x37, 0x6e, 0x9b, 0xbc, 0xa4, 0xdb, 0x32, 0xec, 0x61, 0x5a, 0xed, 0x26, 0xde, 0x20,
+	0x24, 0x03, 0xaa, 0x43, 0xcb, 0x05, 0x2d, 0x07, 0xb4, 0xa1, 0xa0, 0x56, 0x1c, 0x00, 0xb3, 0x12,
+	0xd2, 0x5e, 0xac, 0xb1, 0x3d, 0x9b, 0x8c, 0x6a, 0xcf, 0x58, 0x9e, 0x71, 0xba, 0x39, 0xf0, 0x0f,
+	0x38, 0x70, 0xe4, 0xc8, 0x99, 0x33, 0x3f, 0x62, 0xc5, 0xa9, 0x12, 0x17, 0x4e, 0x0b, 0x6a, 0xff,
+	0x08, 0x9a, 0xf1, 0xd8, 0x69, 0x51, 0xd4, 0x03, 0x27, 0xfb, 0x7d, 0xef, 0x7d, 0xef, 0xbd, 0x99,
+	0xf9, 0xde, 0x83, 0xa7, 0x5c, 0xc4, 0x19, 0x9d, 0xbe, 0x12, 0xe5, 0x25, 0x29, 0x53, 0xc6, 0xe7,
+	0xd3, 0xe5, 0xf1, 0x94, 0x24, 0x89, 0xa8, 0xb8, 0x0a, 0x8a, 0x52, 0x28, 0x81, 0xde, 0x35, 0x21,
+	0xc1, 0x3a, 0x24, 0x58, 0x1e, 0x1f, 0x8e, 0x12, 0x21, 0x73, 0x21, 0xa7, 0xa4, 0x52, 0x8b, 0xe9,
+	0xf2, 0x38, 0xa6, 0x8a, 0x1c, 0x1b, 0xa3, 0x26, 0xb5, 0xfe, 0x98, 0x48, 0xda, 0xfa, 0x13, 0xc1,
+	0xb8, 0xf5, 0x1f, 0xd4, 0xfe, 0xc8, 0x58, 0xd3, 0xda, 0xb0, 0xae, 0x47, 0x73, 0x31, 0x17, 0x35,
+	0xae, 0xff, 0x6a, 0x74, 0xf2, 0xe7, 0x16, 0xbc, 0xf3, 0x55, 0xdb, 0xc2, 0xf3, 0xba, 0x43, 0x74,
+	0x0e, 0x43, 0x5d, 0x21, 0xb2, 0x1d, 0x63, 0xc7, 0x73, 0xfc, 0xc1, 0x89, 0x17, 0xd8, 0x84, 0xa6,
+	0x21, 0x5b, 0x3d, 0x98, 0x11, 0x49, 0x2d, 0x6f, 0xe6, 0x5e, 0xbd, 0x1d, 0x3b, 0xe1, 0x20, 0x5e,
+	0x43, 0x08, 0xc3, 0x4e, 0xb2, 0x20, 0x9c, 0xd3, 0x0c, 0x3f, 0xf0, 0x1c, 0xbf, 0x1f, 0x36, 0x26,
+	0x7a, 0x0c, 0xfd, 0x92, 0x26, 0xac, 0x60, 0x94, 0x2b, 0xdc, 0x35, 0xbe, 0x35, 0x80, 0x9e, 0x00,
+	0x24, 0x25, 0x25, 0x8a, 0xa6, 0x11, 0x51, 0xd8, 0xf5, 0x1c, 0xbf, 0x1b, 0xf6, 0x2d, 0xf2, 0x5c,
+	0xa1, 0x43, 0xe8, 0xbd, 0x22, 0x59, 0x16, 0x93, 0xe4, 0x02, 0x6f, 0x19, 0x6e, 0x6b, 0x23, 0x04,
+	0x6e, 0x4e, 0x73, 0x81, 0xb7, 0x0d, 0x6e, 0xfe, 0xd1, 0x09, 0xb8, 0x0b, 0x51, 0x48, 0xbc, 0xe3,
+	0x75, 0xfd, 0xc1, 0x09, 0x0e, 0x36, 0x5c, 0x7e, 0x70, 0x26, 0x8a, 0x99, 0xfb, 0xe6, 0xed, 0xb8,
+	0x13, 0x9a, 0x58, 0xf4, 0x39, 0xf4, 0x64, 0xb2, 0xa0, 0x69, 0x95, 0x51, 0xdc, 0x33, 0x37, 0xf0,
+	0x64, 0x23, 0xef, 0x7b, 0x1b, 0x64, 0xc9, 0x2d, 0x09, 0x7d, 0x0a, 0xdb, 0xb2, 0xc8, 0x98, 0x92,
+	0xb8, 0x6f, 0xca, 0x1e, 0x6e, 0xa6, 0xeb, 0x10, 0xcb, 0xb5, 0xf1, 0xba, 0x74, 0x4c, 0x54, 0xb2,
+	0x60, 0x7c, 0x8e, 0xe1, 0x9e, 0xd2, 0x33, 0x1b, 0xd4, 0x94, 0x6e, 0x48, 0xe8, 0x0c, 0x86, 0x29,
+	0xe5, 0x22, 0x8f, 0x4a, 0x51, 0x29, 0x2a, 0xf1, 0xc0, 0x34, 0x30, 0xde, 0x98, 0xe4, 0x54, 0x07,
+	0x86, 0x3a, 0xce, 0xa6, 0x19, 0xa4, 0x2d, 0x22, 0xd1, 0xfb, 0xf0, 0x90, 0x64, 0x99, 0xb8, 0xa4,
+	0x69, 0x64, 0x60, 0x89, 0x87, 0x5e, 0xd7, 0xef, 0x87, 0xbb, 0x16, 0x35, 0x6c, 0x89, 0xc6, 0x30,
+	0xa8, 0xf8, 0x25, 0xe3, 0x69, 0x24, 0x78, 0xb6, 0xc2, 0xbb, 0x9e, 0xe3, 0xf7, 0x42, 0xa8, 0xa1,
+	0x6f, 0x78, 0xb6, 0x42, 0x1f, 0xc0, 0x7e, 0x4a, 0xa5, 0x62, 0x9c, 0x28, 0x26, 0x78, 0xa4, 0x56,
+	0x05, 0xc5, 0x0f, 0xcd, 0x0b, 0xed, 0xdd, 0xc2, 0x5f, 0xac, 0x0a, 0xaa, 0xdf, 0xde, 0xb4, 0x1d,
+	0xa5, 0x44, 0x11, 0xbc, 0xe7, 0x39, 0xfe, 0x30, 0xec, 0x1b, 0xe4, 0x94, 0x28, 0xf2, 0xcc, 0xfb,
+	0xe3, 0xf7, 0xa3, 0xc7, 0x9b, 0xa4, 0x68, 0x35, 0x77, 0x3e, 0xf9, 0x0c, 0xba, 0x67, 0xa2, 0xb8,
+	0xad, 0x3d, 0xe7, 0xae, 0xf6, 0x0e, 0xa1, 0x57, 0xd2, 0x84, 0xb2, 0x25, 0x2d, 0xad, 0x2c, 0x5b,
+	0x7b, 0xf2, 0x23, 0xf4, 0x9a, 0x17, 0x45, 0xef, 0xc1, 0xee, 0x42, 0x64, 0x69, 0x94, 0x56, 0xa5,
+	0x69, 0xcf, 0xe4, 0x71, 0xc3, 0xa1, 0x06, 0x4f, 0x2d, 0x86, 0x9e, 0xc2, 0xb0, 0xa4, 0x19, 0xd5,
+	0x03, 0xa3, 0x58, 0x4e, 0x4d, 0xc2, 0x6e, 0x38, 0xb0, 0xd8, 0x0b, 0x96, 0x53, 0x7d, 0xf8, 0x26,
+	0x84, 0x71, 0x45, 0xcb, 0x25, 0xc9, 0x8c, 0xe4, 0xdd, 0x70, 0xcf, 0xe2, 0xe7, 0x16, 0x9e, 0x7c,
+	0x07, 0x5b, 0x46, 0x11, 0xf7, 0x74, 0x7f, 0x67, 0x72, 0x1e, 0xfc, 0x77, 0x72, 0xf6, 0xa1, 0x1b,
+	0x17, 0xd2, 0xa4, 0xdf, 0x0d, 0xf5, 0xef, 0xe4, 0x27, 0x07, 0x7a, 0x8d, 0x52, 0x10, 0x85, 0x1d,
+	0x45, 0xca, 0x39, 0x55, 0x12, 0x3b, 0x46, 0x14, 0x07, 0xcd, 0x58, 0xeb, 0xb1, 0x6d, 0xef, 0xf2,
+	0x0b, 0xc1, 0xf8, 0xec, 0x63, 0x2d, 0x87, 0xdf, 0xfe, 0x1e, 0xfb, 0x73, 0xa6, 0x16, 0x55, 0x1c,
+	0x24, 0x22, 0xb7, 0x4b, 0xc5, 0x7e, 0x8e, 0x64, 0x7a, 0x31, 0xd5, 0x4f, 0x29, 0x0d, 0x41, 0x86,
+	0x4d, 0x6e, 0x74, 0x00, 0xbd, 0x9c, 0xbc, 0x8e, 0x2e, 0x09, 0xab, 0x5b, 0x74, 0xc3, 0x9d, 0x9c,
+	0xbc, 0xfe, 0x81, 0x30, 0x35, 0x79, 0x09, 0xb0, 0x96, 0x1c, 0x7a, 0x04, 0x5b, 0x46, 0x57, 0xf6,
+	0x90, 0xb5, 0xf1, 0x7f, 0xd7, 0xc6, 0xe4, 0x43, 0xd8, 0x5f, 0xaf, 0xb3, 0x6f, 0xab, 0xf8, 0x6b,
+	0xba, 0xd2, 0x17, 0x72, 0x41, 0x57, 0x26, 0xff, 0x30, 0xd4, 0xbf, 0xcf, 0xdc, 0x5f, 0x7e, 0x1d,
+	0x77, 0x66, 0x5f, 0xbe, 0xb9, 0x1e, 0x39, 0x57, 0xd7, 0x23, 0xe7, 0x9f, 0xeb, 0x91, 0xf3, 0xf3,
+	0xcd, 0xa8, 0x73, 0x75, 0x33, 0xea, 0xfc, 0x75, 0x33, 0xea, 0xbc, 0xfc, 0xe8, 0xd6, 0x79, 0xcd,
+	0xc4, 0x1c, 0x11, 0x29, 0xa9, 0x92, 0x77, 0x16, 0xfa, 0x49, 0x7d, 0xf0, 0x78, 0xdb, 0x6c, 0xd2,
+	0x4f, 0xfe, 0x1d, 0x00, 0x32, 0x2c, 0xad, 0x9f, 0xf4, 0x05, 0x00, 0x00,
 }
 
 func (m *ForwardingAccount) Marshal() (dAtA []byte, err error) {
@@ -191,6 +619,108 @@ func (m *ForwardingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RouteData) > 0 {
+		i -= len(m.RouteData)
+		copy(dAtA[i:], m.RouteData)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.RouteData)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.DestinationType) > 0 {
+		i -= len(m.DestinationType)
+		copy(dAtA[i:], m.DestinationType)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.DestinationType)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.UnwindOnly {
+		i--
+		if m.UnwindOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintAccount(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.DenomRoutes) > 0 {
+		for iNdEx := len(m.DenomRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.Batching.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAccount(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x32
+	}
 	if len(m.Fallback) > 0 {
 		i -= len(m.Fallback)
 		copy(dAtA[i:], m.Fallback)
@@ -232,7 +762,7 @@ func (m *ForwardingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ForwardingPubKey) Marshal() (dAtA []byte, err error) {
+func (m *Hop) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -242,7 +772,210 @@ func (m *ForwardingPubKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ForwardingPubKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *Hop) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Hop) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Schedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseInterval != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.ReleaseInterval))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ReleaseTime != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.ReleaseTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HoldDuration != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.HoldDuration))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Split) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Split) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Split) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bps != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.Bps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Batching) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Batching) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Batching) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxWait != 0 {
+		i = encodeVarintAccount(dAtA, i, uint64(m.MaxWait))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccount(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAccount(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardingPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardingPubKey) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -298,29 +1031,906 @@ func (m *ForwardingAccount) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAccount(uint64(l))
 	}
-	return n
-}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovAccount(uint64(l))
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	l = m.Batching.Size()
+	n += 1 + l + sovAccount(uint64(l))
+	if len(m.DenomRoutes) > 0 {
+		for _, e := range m.DenomRoutes {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.UnwindOnly {
+		n += 2
+	}
+	l = len(m.DestinationType)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.RouteData)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *Hop) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *Schedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HoldDuration != 0 {
+		n += 1 + sovAccount(uint64(m.HoldDuration))
+	}
+	if m.ReleaseTime != 0 {
+		n += 1 + sovAccount(uint64(m.ReleaseTime))
+	}
+	if m.ReleaseInterval != 0 {
+		n += 1 + sovAccount(uint64(m.ReleaseInterval))
+	}
+	return n
+}
+
+func (m *Split) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	if m.Bps != 0 {
+		n += 1 + sovAccount(uint64(m.Bps))
+	}
+	return n
+}
+
+func (m *Batching) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovAccount(uint64(l))
+		}
+	}
+	if m.MaxWait != 0 {
+		n += 1 + sovAccount(uint64(m.MaxWait))
+	}
+	return n
+}
+
+func (m *DenomRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func (m *ForwardingPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAccount(uint64(l))
+	}
+	return n
+}
+
+func sovAccount(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccount(x uint64) (n int) {
+	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BaseAccount == nil {
+				m.BaseAccount = &types.BaseAccount{}
+			}
+			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, Hop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, Split{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batching", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRoutes = append(m.DenomRoutes, DenomRoute{})
+			if err := m.DenomRoutes[len(m.DenomRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnwindOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnwindOnly = bool(v != 0)
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteData = append(m.RouteData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RouteData == nil {
+				m.RouteData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Hop) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Hop: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Hop: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Schedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Schedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Schedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HoldDuration", wireType)
+			}
+			m.HoldDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HoldDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseTime", wireType)
+			}
+			m.ReleaseTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseInterval", wireType)
+			}
+			m.ReleaseInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *ForwardingPubKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovAccount(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovAccount(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAccount(x uint64) (n int) {
-	return sovAccount(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
+func (m *Split) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -343,17 +1953,17 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ForwardingAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: Split: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ForwardingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Split: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAccount
@@ -363,31 +1973,27 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAccount
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAccount
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseAccount == nil {
-				m.BaseAccount = &types.BaseAccount{}
-			}
-			if err := m.BaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -415,11 +2021,183 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bps", wireType)
+			}
+			m.Bps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Batching) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Batching: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Batching: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, types1.Coin{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWait", wireType)
+			}
+			m.MaxWait = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccount
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWait |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccount(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccount
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -447,13 +2225,13 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
-			m.CreatedAt = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAccount
@@ -463,14 +2241,27 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccount
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccount
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -498,7 +2289,7 @@ func (m *ForwardingAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fallback = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
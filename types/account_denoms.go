@@ -0,0 +1,89 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxAccountDenoms is the maximum number of denoms a forwarding account can
+// restrict its forwards to.
+const MaxAccountDenoms = 16
+
+// ValidateAccountDenoms checks if a specified list of denoms that a
+// forwarding account restricts its forwards to is valid. When defined, all
+// denoms with a route must be part of the list.
+func ValidateAccountDenoms(denoms []string, routes []DenomRoute) error {
+	if len(denoms) > MaxAccountDenoms {
+		return fmt.Errorf("allowed denoms must not exceed %d entries", MaxAccountDenoms)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom: %w", err)
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	if len(denoms) == 0 {
+		return nil
+	}
+
+	for _, route := range routes {
+		if !seen[route.Denom] {
+			return fmt.Errorf("denom route for %s must be part of allowed denoms", route.Denom)
+		}
+	}
+
+	return nil
+}
+
+// EncodeAccountDenoms deterministically encodes a list of denoms that a
+// forwarding account restricts its forwards to, for use in the derivation of
+// forwarding account addresses. Denoms are encoded in order, as the order
+// they are defined in is irrelevant.
+func EncodeAccountDenoms(denoms []string) []byte {
+	sorted := slices.Clone(denoms)
+	slices.Sort(sorted)
+
+	var bz []byte
+	for _, denom := range sorted {
+		bz = binary.AppendUvarint(bz, uint64(len(denom)))
+		bz = append(bz, denom...)
+	}
+
+	return bz
+}
+
+// AcceptsDenom checks if a forwarding account forwards a specific denom.
+// Accounts without allowed denoms forward all denoms.
+func (fa *ForwardingAccount) AcceptsDenom(denom string) bool {
+	return len(fa.AllowedDenoms) == 0 || slices.Contains(fa.AllowedDenoms, denom)
+}
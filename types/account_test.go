@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestUnwindOnlyAddressCollision(t *testing.T) {
+	base := types.ForwardingAccount{Channel: "channel-0", Recipient: "cosmos1recipient"}
+
+	// ASSERT: A memo matching the name of a field doesn't collide with it.
+	memo := base
+	memo.Memo = "unwind_only"
+	unwindOnly := base
+	unwindOnly.UnwindOnly = true
+	require.NotEqual(t, types.GenerateAddress(memo), types.GenerateAddress(unwindOnly))
+
+	memo.Memo = "hops"
+	memo.UnwindOnly = true
+	require.NotEqual(t, types.GenerateAddress(memo), types.GenerateAddress(unwindOnly))
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsZero returns true if funds are forwarded without batching.
+func (b Batching) IsZero() bool {
+	return b.Targets.IsZero() && b.MaxWait == 0
+}
+
+// ReachedTarget returns true if any of the specified balances has reached its
+// target.
+func (b Batching) ReachedTarget(balances sdk.Coins) bool {
+	for _, target := range b.Targets {
+		if balances.AmountOf(target.Denom).GTE(target.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateBatching checks if a specified batching configuration is valid. A
+// maximum wait is required, so that funds never accumulate indefinitely.
+func ValidateBatching(batching Batching) error {
+	if batching.IsZero() {
+		return nil
+	}
+
+	if batching.MaxWait == 0 {
+		return errors.New("batching max wait must be positive")
+	}
+
+	if batching.MaxWait > uint64(MaxHoldDuration) {
+		return fmt.Errorf("batching max wait must not exceed %s", MaxHoldDuration)
+	}
+
+	if err := batching.Targets.Validate(); err != nil {
+		return fmt.Errorf("invalid batching targets: %w", err)
+	}
+
+	return nil
+}
+
+// EncodeBatching deterministically encodes a batching configuration, for use
+// in the derivation of forwarding account addresses.
+func EncodeBatching(batching Batching) []byte {
+	targets := batching.Targets.String()
+
+	bz := binary.AppendUvarint(nil, uint64(len(targets)))
+	bz = append(bz, targets...)
+	return binary.BigEndian.AppendUint64(bz, batching.MaxWait)
+}
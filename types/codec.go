@@ -43,6 +43,23 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterAccount{}, "noble/forwarding/RegisterAccount", nil)
 	cdc.RegisterConcrete(&MsgClearAccount{}, "noble/forwarding/ClearAccount", nil)
 	cdc.RegisterConcrete(&MsgSetAllowedDenoms{}, "noble/forwarding/SetAllowedDenoms", nil)
+	cdc.RegisterConcrete(&MsgSetBlockedDenoms{}, "noble/forwarding/SetBlockedDenoms", nil)
+	cdc.RegisterConcrete(&MsgSetRefundPolicy{}, "noble/forwarding/SetRefundPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetTimeoutPolicy{}, "noble/forwarding/SetTimeoutPolicy", nil)
+	cdc.RegisterConcrete(&MsgSetMaxMemoLength{}, "noble/forwarding/SetMaxMemoLength", nil)
+	cdc.RegisterConcrete(&MsgSetMinForwardAmount{}, "noble/forwarding/SetMinForwardAmount", nil)
+	cdc.RegisterConcrete(&MsgSetExecutionLimits{}, "noble/forwarding/SetExecutionLimits", nil)
+	cdc.RegisterConcrete(&MsgSetFeeSchedule{}, "noble/forwarding/SetFeeSchedule", nil)
+	cdc.RegisterConcrete(&MsgSetFeeRecipient{}, "noble/forwarding/SetFeeRecipient", nil)
+	cdc.RegisterConcrete(&MsgSetRelayerFee{}, "noble/forwarding/SetRelayerFee", nil)
+	cdc.RegisterConcrete(&MsgSetFallbackGracePeriod{}, "noble/forwarding/SetFallbackGracePeriod", nil)
+	cdc.RegisterConcrete(&MsgPauseChannel{}, "noble/forwarding/PauseChannel", nil)
+	cdc.RegisterConcrete(&MsgResumeChannel{}, "noble/forwarding/ResumeChannel", nil)
+	cdc.RegisterConcrete(&MsgSetCircuitBreaker{}, "noble/forwarding/SetCircuitBreaker", nil)
+	cdc.RegisterConcrete(&MsgSetGuardian{}, "noble/forwarding/SetGuardian", nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, "noble/forwarding/SetRateLimit", nil)
+	cdc.RegisterConcrete(&MsgSetChannelAllowedDenoms{}, "noble/forwarding/SetChannelAllowedDenoms", nil)
+	cdc.RegisterConcrete(&MsgSetUnwindOnly{}, "noble/forwarding/SetUnwindOnly", nil)
 }
 
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
@@ -55,6 +72,23 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgRegisterAccount{},
 		&MsgClearAccount{},
 		&MsgSetAllowedDenoms{},
+		&MsgSetBlockedDenoms{},
+		&MsgSetRefundPolicy{},
+		&MsgSetTimeoutPolicy{},
+		&MsgSetMaxMemoLength{},
+		&MsgSetMinForwardAmount{},
+		&MsgSetExecutionLimits{},
+		&MsgSetFeeSchedule{},
+		&MsgSetFeeRecipient{},
+		&MsgSetRelayerFee{},
+		&MsgSetFallbackGracePeriod{},
+		&MsgPauseChannel{},
+		&MsgResumeChannel{},
+		&MsgSetCircuitBreaker{},
+		&MsgSetGuardian{},
+		&MsgSetRateLimit{},
+		&MsgSetChannelAllowedDenoms{},
+		&MsgSetUnwindOnly{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+)
+
+const (
+	// DenomPrefixWildcard is the suffix of allowed denom entries that match
+	// all denoms starting with a specific prefix, e.g. "factory/noble1.../*".
+	DenomPrefixWildcard = "/*"
+	// BaseDenomPattern is the prefix of allowed denom entries that match all
+	// IBC vouchers of a specific base denom, e.g. "base:uatom".
+	BaseDenomPattern = "base:"
+	// TracePathPattern is the prefix of allowed denom entries that match all
+	// IBC vouchers received through a specific path, e.g.
+	// "path:transfer/channel-4".
+	TracePathPattern = "path:"
+)
+
+// IsDenomPattern checks if an allowed denom entry matches denoms by prefix or
+// denom trace, instead of by exact denom.
+func IsDenomPattern(entry string) bool {
+	return strings.HasPrefix(entry, BaseDenomPattern) ||
+		strings.HasPrefix(entry, TracePathPattern) ||
+		(entry != "*" && strings.HasSuffix(entry, DenomPrefixWildcard))
+}
+
+// ValidateDenomPattern checks if an allowed denom entry that matches denoms by
+// prefix or denom trace is valid.
+func ValidateDenomPattern(pattern string) error {
+	switch {
+	case strings.HasPrefix(pattern, BaseDenomPattern):
+		base := strings.TrimPrefix(pattern, BaseDenomPattern)
+		if strings.TrimSpace(base) == "" {
+			return errors.New("base denom pattern cannot be empty")
+		}
+		if strings.Contains(base, "*") {
+			return fmt.Errorf("base denom pattern cannot contain a wildcard: %s", pattern)
+		}
+	case strings.HasPrefix(pattern, TracePathPattern):
+		path := strings.TrimPrefix(pattern, TracePathPattern)
+		if path == "" {
+			return errors.New("trace path pattern cannot be empty")
+		}
+		trace := transfertypes.DenomTrace{Path: path, BaseDenom: "denom"}
+		if err := trace.Validate(); err != nil {
+			return fmt.Errorf("invalid trace path pattern %s: %w", pattern, err)
+		}
+	case strings.HasSuffix(pattern, DenomPrefixWildcard):
+		prefix := strings.TrimSuffix(pattern, DenomPrefixWildcard)
+		if strings.TrimSpace(prefix) == "" {
+			return errors.New("denom prefix pattern cannot be empty")
+		}
+		if strings.Contains(prefix, "*") {
+			return fmt.Errorf("denom prefix pattern can only end with a wildcard: %s", pattern)
+		}
+	default:
+		return fmt.Errorf("invalid denom pattern: %s", pattern)
+	}
+
+	return nil
+}
+
+// MatchesDenomPattern checks if a denom matches an allowed denom entry that
+// matches denoms by prefix or denom trace. The trace is the denom trace of
+// the denom, and is empty for denoms that aren't IBC vouchers.
+func MatchesDenomPattern(pattern string, denom string, trace transfertypes.DenomTrace) bool {
+	switch {
+	case strings.HasPrefix(pattern, BaseDenomPattern):
+		return trace.Path != "" && trace.BaseDenom == strings.TrimPrefix(pattern, BaseDenomPattern)
+	case strings.HasPrefix(pattern, TracePathPattern):
+		return trace.Path != "" && trace.Path == strings.TrimPrefix(pattern, TracePathPattern)
+	case strings.HasSuffix(pattern, DenomPrefixWildcard):
+		return strings.HasPrefix(denom, strings.TrimSuffix(pattern, "*"))
+	default:
+		return false
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestValidateAllowedDenomPatterns(t *testing.T) {
+	require.NoError(t, types.ValidateAllowedDenoms([]string{"factory/noble1creator/*", "base:uatom", "path:transfer/channel-4", "uusdc"}))
+	require.Error(t, types.ValidateAllowedDenoms([]string{"/*"}))
+	require.Error(t, types.ValidateAllowedDenoms([]string{"factory/*/*"}))
+	require.Error(t, types.ValidateAllowedDenoms([]string{"u*"}))
+	require.Error(t, types.ValidateAllowedDenoms([]string{"base:"}))
+	require.Error(t, types.ValidateAllowedDenoms([]string{"path:transfer"}))
+	require.Error(t, types.ValidateAllowedDenoms([]string{"path:"}))
+
+	genesis := types.DefaultGenesisState()
+	genesis.AllowedDenoms = []string{"path:transfer/channel-4", "base:uatom"}
+	require.NoError(t, genesis.Validate())
+	genesis.AllowedDenoms = []string{"path:transfer/channel"}
+	require.Error(t, genesis.Validate())
+}
@@ -23,6 +23,17 @@ package types
 import "cosmossdk.io/errors"
 
 var (
-	ErrInvalidAuthority = errors.Register(ModuleName, 1, "signer is not authority")
-	ErrInvalidDenoms    = errors.Register(ModuleName, 2, "invalid allowed denoms")
+	ErrInvalidAuthority        = errors.Register(ModuleName, 1, "signer is not authority")
+	ErrInvalidDenoms           = errors.Register(ModuleName, 2, "invalid allowed denoms")
+	ErrInvalidPolicy           = errors.Register(ModuleName, 3, "invalid refund policy")
+	ErrInvalidMemoLength       = errors.Register(ModuleName, 4, "invalid max memo length")
+	ErrInvalidMinForwardAmount = errors.Register(ModuleName, 5, "invalid min forward amount")
+	ErrInvalidFeeSchedule      = errors.Register(ModuleName, 6, "invalid fee schedule")
+	ErrInvalidRelayerFee       = errors.Register(ModuleName, 7, "invalid relayer fee")
+	ErrPausedChannel           = errors.Register(ModuleName, 8, "channel is paused")
+	ErrCircuitBreakerEnabled   = errors.Register(ModuleName, 9, "circuit breaker is enabled")
+	ErrInvalidRateLimit        = errors.Register(ModuleName, 10, "invalid rate limit")
+	ErrInvalidBlockedDenoms    = errors.Register(ModuleName, 11, "invalid blocked denoms")
+	ErrForwardingLoop          = errors.Register(ModuleName, 12, "forwarding loop")
+	ErrFeeNotEnabled           = errors.Register(ModuleName, 13, "relayer fees are not enabled")
 )
@@ -4,7 +4,12 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -32,6 +37,28 @@ type AccountRegistered struct {
 	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 	// fallback is the address of the fallback account.
 	Fallback string `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// memo is the memo included in forwards.
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	// hops are the additional transfers executed after each forward.
+	Hops []Hop `protobuf:"bytes,6,rep,name=hops,proto3" json:"hops"`
+	// schedule defines when deposits become eligible to be forwarded.
+	Schedule Schedule `protobuf:"bytes,7,opt,name=schedule,proto3" json:"schedule"`
+	// splits are the shares that received funds are split into.
+	Splits []Split `protobuf:"bytes,8,rep,name=splits,proto3" json:"splits"`
+	// batching defines how funds accumulate before being forwarded.
+	Batching Batching `protobuf:"bytes,9,opt,name=batching,proto3" json:"batching"`
+	// denom_routes are the routes of specific denoms.
+	DenomRoutes []DenomRoute `protobuf:"bytes,10,rep,name=denom_routes,json=denomRoutes,proto3" json:"denom_routes"`
+	// allowed_denoms are the denoms that the account forwards.
+	AllowedDenoms []string `protobuf:"bytes,11,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// unwind_only defines if the account only forwards IBC vouchers that unwind
+	// along their own trace.
+	UnwindOnly bool `protobuf:"varint,12,opt,name=unwind_only,json=unwindOnly,proto3" json:"unwind_only,omitempty"`
+	// destination_type is the type of destination that the account forwards
+	// to, or empty for IBC.
+	DestinationType string `protobuf:"bytes,13,opt,name=destination_type,json=destinationType,proto3" json:"destination_type,omitempty"`
+	// route_data is the opaque route data interpreted by the destination type.
+	RouteData []byte `protobuf:"bytes,14,opt,name=route_data,json=routeData,proto3" json:"route_data,omitempty"`
 }
 
 func (m *AccountRegistered) Reset()         { *m = AccountRegistered{} }
@@ -95,6 +122,76 @@ func (m *AccountRegistered) GetFallback() string {
 	return ""
 }
 
+func (m *AccountRegistered) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *AccountRegistered) GetHops() []Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *AccountRegistered) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return Schedule{}
+}
+
+func (m *AccountRegistered) GetSplits() []Split {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *AccountRegistered) GetBatching() Batching {
+	if m != nil {
+		return m.Batching
+	}
+	return Batching{}
+}
+
+func (m *AccountRegistered) GetDenomRoutes() []DenomRoute {
+	if m != nil {
+		return m.DenomRoutes
+	}
+	return nil
+}
+
+func (m *AccountRegistered) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *AccountRegistered) GetUnwindOnly() bool {
+	if m != nil {
+		return m.UnwindOnly
+	}
+	return false
+}
+
+func (m *AccountRegistered) GetDestinationType() string {
+	if m != nil {
+		return m.DestinationType
+	}
+	return ""
+}
+
+func (m *AccountRegistered) GetRouteData() []byte {
+	if m != nil {
+		return m.RouteData
+	}
+	return nil
+}
+
 // AccountCleared is emitted whenever a forwarding account is cleared.
 type AccountCleared struct {
 	// address is the address of the forwarding account.
@@ -205,248 +302,7637 @@ func (m *AllowedDenomsConfigured) GetCurrentDenoms() []string {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*AccountRegistered)(nil), "noble.forwarding.v1.AccountRegistered")
-	proto.RegisterType((*AccountCleared)(nil), "noble.forwarding.v1.AccountCleared")
-	proto.RegisterType((*AllowedDenomsConfigured)(nil), "noble.forwarding.v1.AllowedDenomsConfigured")
+// BlockedDenomsConfigured is emitted whenever the blocked denoms are updated.
+type BlockedDenomsConfigured struct {
+	// previous_denoms is the list of previously blocked denoms.
+	PreviousDenoms []string `protobuf:"bytes,1,rep,name=previous_denoms,json=previousDenoms,proto3" json:"previous_denoms,omitempty"`
+	// current_denoms is the list of currently blocked denoms.
+	CurrentDenoms []string `protobuf:"bytes,2,rep,name=current_denoms,json=currentDenoms,proto3" json:"current_denoms,omitempty"`
 }
 
-func init() { proto.RegisterFile("noble/forwarding/v1/events.proto", fileDescriptor_f58759da7cd78060) }
+func (m *BlockedDenomsConfigured) Reset()         { *m = BlockedDenomsConfigured{} }
+func (m *BlockedDenomsConfigured) String() string { return proto.CompactTextString(m) }
+func (*BlockedDenomsConfigured) ProtoMessage()    {}
+func (*BlockedDenomsConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{3}
+}
+func (m *BlockedDenomsConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedDenomsConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedDenomsConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedDenomsConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedDenomsConfigured.Merge(m, src)
+}
+func (m *BlockedDenomsConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedDenomsConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedDenomsConfigured.DiscardUnknown(m)
+}
 
-var fileDescriptor_f58759da7cd78060 = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x41, 0x4a, 0x03, 0x31,
-	0x14, 0x86, 0x9b, 0x56, 0xd4, 0x06, 0xac, 0x38, 0x2e, 0x0c, 0x22, 0xa1, 0x14, 0xc4, 0x82, 0x38,
-	0x43, 0xf5, 0x04, 0xb5, 0x0a, 0xae, 0xbb, 0x74, 0x23, 0x99, 0xe4, 0x75, 0x1a, 0x4c, 0x93, 0x21,
-	0xc9, 0x4c, 0x71, 0xe9, 0x0d, 0x3c, 0x96, 0xcb, 0x2e, 0x5d, 0x4a, 0x7b, 0x11, 0x69, 0x3a, 0x63,
-	0xa9, 0x0b, 0x97, 0xef, 0xff, 0xbe, 0xe4, 0x0f, 0x79, 0xb8, 0xab, 0x4d, 0xaa, 0x20, 0x99, 0x18,
-	0x3b, 0x67, 0x56, 0x48, 0x9d, 0x25, 0xe5, 0x20, 0x81, 0x12, 0xb4, 0x77, 0x71, 0x6e, 0x8d, 0x37,
-	0xd1, 0x69, 0x30, 0xe2, 0xad, 0x11, 0x97, 0x83, 0xde, 0x3b, 0xc2, 0x27, 0x43, 0xce, 0x4d, 0xa1,
-	0xfd, 0x18, 0x32, 0xe9, 0x3c, 0x58, 0x10, 0x11, 0xc1, 0x07, 0x4c, 0x08, 0x0b, 0xce, 0x11, 0xd4,
-	0x45, 0xfd, 0xf6, 0xb8, 0x1e, 0xd7, 0x84, 0x4f, 0x99, 0xd6, 0xa0, 0x48, 0x73, 0x43, 0xaa, 0x31,
-	0xba, 0xc0, 0x6d, 0x0b, 0x5c, 0xe6, 0x12, 0xb4, 0x27, 0xad, 0xc0, 0xb6, 0x41, 0x74, 0x8e, 0x0f,
-	0x27, 0x4c, 0xa9, 0x94, 0xf1, 0x57, 0xb2, 0x17, 0xe0, 0xef, 0xdc, 0x7b, 0xc2, 0x9d, 0xea, 0x09,
-	0x23, 0x05, 0xec, 0xff, 0xfe, 0x9d, 0x96, 0xe6, 0x9f, 0x96, 0x9e, 0xc4, 0x67, 0x43, 0xa5, 0xcc,
-	0x1c, 0xc4, 0x03, 0x68, 0x33, 0x73, 0x23, 0xa3, 0x27, 0x32, 0x2b, 0xd6, 0x57, 0x5e, 0xe1, 0xe3,
-	0xdc, 0x42, 0x29, 0x4d, 0xe1, 0x5e, 0x44, 0x80, 0x04, 0x75, 0x5b, 0xfd, 0xf6, 0xb8, 0x53, 0xc7,
-	0x9b, 0x23, 0xd1, 0x25, 0xee, 0xf0, 0xc2, 0x5a, 0xd0, 0xbe, 0xf6, 0x9a, 0xc1, 0x3b, 0xaa, 0xd2,
-	0x8d, 0x76, 0xff, 0xf8, 0xb9, 0xa4, 0x68, 0xb1, 0xa4, 0xe8, 0x7b, 0x49, 0xd1, 0xc7, 0x8a, 0x36,
-	0x16, 0x2b, 0xda, 0xf8, 0x5a, 0xd1, 0xc6, 0xf3, 0x75, 0x26, 0xfd, 0xb4, 0x48, 0x63, 0x6e, 0x66,
-	0x49, 0xf8, 0xf2, 0x1b, 0xe6, 0x1c, 0x78, 0xb7, 0xb3, 0x9b, 0xdb, 0xc4, 0xbf, 0xe5, 0xe0, 0xd2,
-	0xfd, 0xb0, 0x9b, 0xbb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x05, 0x3b, 0x20, 0xbe, 0xbf, 0x01,
-	0x00, 0x00,
+var xxx_messageInfo_BlockedDenomsConfigured proto.InternalMessageInfo
+
+func (m *BlockedDenomsConfigured) GetPreviousDenoms() []string {
+	if m != nil {
+		return m.PreviousDenoms
+	}
+	return nil
 }
 
-func (m *AccountRegistered) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BlockedDenomsConfigured) GetCurrentDenoms() []string {
+	if m != nil {
+		return m.CurrentDenoms
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AccountRegistered) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ForwardRefunded is emitted whenever an automatic forward fails on the
+// counterparty chain and its funds are refunded to the forwarding account.
+type ForwardRefunded struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that the forward was sent through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// sequence is the sequence of the packet that was sent.
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// amount is the amount that was refunded.
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// error is the reason why the forward failed.
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// policy is the refund policy that was applied to the refunded funds.
+	Policy RefundPolicy `protobuf:"varint,6,opt,name=policy,proto3,enum=noble.forwarding.v1.RefundPolicy" json:"policy,omitempty"`
 }
 
-func (m *AccountRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fallback) > 0 {
-		i -= len(m.Fallback)
-		copy(dAtA[i:], m.Fallback)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fallback)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0x12
+func (m *ForwardRefunded) Reset()         { *m = ForwardRefunded{} }
+func (m *ForwardRefunded) String() string { return proto.CompactTextString(m) }
+func (*ForwardRefunded) ProtoMessage()    {}
+func (*ForwardRefunded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{4}
+}
+func (m *ForwardRefunded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardRefunded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardRefunded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ForwardRefunded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardRefunded.Merge(m, src)
+}
+func (m *ForwardRefunded) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardRefunded) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardRefunded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardRefunded proto.InternalMessageInfo
+
+func (m *ForwardRefunded) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AccountCleared) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ForwardRefunded) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AccountCleared) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ForwardRefunded) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func (m *AccountCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
+func (m *ForwardRefunded) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return types.Coin{}
+}
+
+func (m *ForwardRefunded) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AllowedDenomsConfigured) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ForwardRefunded) GetPolicy() RefundPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return dAtA[:n], nil
+	return RefundPolicyUnspecified
 }
 
-func (m *AllowedDenomsConfigured) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RefundPolicyConfigured is emitted whenever the refund policy is updated.
+type RefundPolicyConfigured struct {
+	// previous_policy is the previous refund policy.
+	PreviousPolicy RefundPolicy `protobuf:"varint,1,opt,name=previous_policy,json=previousPolicy,proto3,enum=noble.forwarding.v1.RefundPolicy" json:"previous_policy,omitempty"`
+	// current_policy is the current refund policy.
+	CurrentPolicy RefundPolicy `protobuf:"varint,2,opt,name=current_policy,json=currentPolicy,proto3,enum=noble.forwarding.v1.RefundPolicy" json:"current_policy,omitempty"`
 }
 
-func (m *AllowedDenomsConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CurrentDenoms) > 0 {
-		for iNdEx := len(m.CurrentDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CurrentDenoms[iNdEx])
-			copy(dAtA[i:], m.CurrentDenoms[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.PreviousDenoms) > 0 {
-		for iNdEx := len(m.PreviousDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PreviousDenoms[iNdEx])
-			copy(dAtA[i:], m.PreviousDenoms[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *RefundPolicyConfigured) Reset()         { *m = RefundPolicyConfigured{} }
+func (m *RefundPolicyConfigured) String() string { return proto.CompactTextString(m) }
+func (*RefundPolicyConfigured) ProtoMessage()    {}
+func (*RefundPolicyConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{5}
+}
+func (m *RefundPolicyConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RefundPolicyConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RefundPolicyConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RefundPolicyConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundPolicyConfigured.Merge(m, src)
+}
+func (m *RefundPolicyConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *RefundPolicyConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundPolicyConfigured.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_RefundPolicyConfigured proto.InternalMessageInfo
+
+func (m *RefundPolicyConfigured) GetPreviousPolicy() RefundPolicy {
+	if m != nil {
+		return m.PreviousPolicy
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return RefundPolicyUnspecified
 }
-func (m *AccountRegistered) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *RefundPolicyConfigured) GetCurrentPolicy() RefundPolicy {
+	if m != nil {
+		return m.CurrentPolicy
 	}
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return RefundPolicyUnspecified
+}
+
+// TimeoutPolicyConfigured is emitted whenever the timeout policy of a channel
+// is updated.
+type TimeoutPolicyConfigured struct {
+	// channel is the channel id that the timeout policy applies to.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// previous_policy is the previous timeout policy.
+	PreviousPolicy TimeoutPolicy `protobuf:"bytes,2,opt,name=previous_policy,json=previousPolicy,proto3" json:"previous_policy"`
+	// current_policy is the current timeout policy.
+	CurrentPolicy TimeoutPolicy `protobuf:"bytes,3,opt,name=current_policy,json=currentPolicy,proto3" json:"current_policy"`
+}
+
+func (m *TimeoutPolicyConfigured) Reset()         { *m = TimeoutPolicyConfigured{} }
+func (m *TimeoutPolicyConfigured) String() string { return proto.CompactTextString(m) }
+func (*TimeoutPolicyConfigured) ProtoMessage()    {}
+func (*TimeoutPolicyConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{6}
+}
+func (m *TimeoutPolicyConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimeoutPolicyConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimeoutPolicyConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *TimeoutPolicyConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimeoutPolicyConfigured.Merge(m, src)
+}
+func (m *TimeoutPolicyConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimeoutPolicyConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimeoutPolicyConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimeoutPolicyConfigured proto.InternalMessageInfo
+
+func (m *TimeoutPolicyConfigured) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	l = len(m.Fallback)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return ""
+}
+
+func (m *TimeoutPolicyConfigured) GetPreviousPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.PreviousPolicy
 	}
-	return n
+	return TimeoutPolicy{}
 }
 
-func (m *AccountCleared) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *TimeoutPolicyConfigured) GetCurrentPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.CurrentPolicy
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return TimeoutPolicy{}
+}
+
+// MaxMemoLengthConfigured is emitted whenever the maximum memo length is
+// updated.
+type MaxMemoLengthConfigured struct {
+	// previous_max_memo_length is the previous maximum memo length.
+	PreviousMaxMemoLength uint64 `protobuf:"varint,1,opt,name=previous_max_memo_length,json=previousMaxMemoLength,proto3" json:"previous_max_memo_length,omitempty"`
+	// current_max_memo_length is the current maximum memo length.
+	CurrentMaxMemoLength uint64 `protobuf:"varint,2,opt,name=current_max_memo_length,json=currentMaxMemoLength,proto3" json:"current_max_memo_length,omitempty"`
+}
+
+func (m *MaxMemoLengthConfigured) Reset()         { *m = MaxMemoLengthConfigured{} }
+func (m *MaxMemoLengthConfigured) String() string { return proto.CompactTextString(m) }
+func (*MaxMemoLengthConfigured) ProtoMessage()    {}
+func (*MaxMemoLengthConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{7}
+}
+func (m *MaxMemoLengthConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxMemoLengthConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxMemoLengthConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *MaxMemoLengthConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxMemoLengthConfigured.Merge(m, src)
+}
+func (m *MaxMemoLengthConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxMemoLengthConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxMemoLengthConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxMemoLengthConfigured proto.InternalMessageInfo
+
+func (m *MaxMemoLengthConfigured) GetPreviousMaxMemoLength() uint64 {
+	if m != nil {
+		return m.PreviousMaxMemoLength
 	}
-	return n
+	return 0
 }
 
-func (m *AllowedDenomsConfigured) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MaxMemoLengthConfigured) GetCurrentMaxMemoLength() uint64 {
+	if m != nil {
+		return m.CurrentMaxMemoLength
 	}
-	var l int
-	_ = l
-	if len(m.PreviousDenoms) > 0 {
-		for _, s := range m.PreviousDenoms {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+// ForwardDeferred is emitted whenever an automatic forward is deferred.
+type ForwardDeferred struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that the forward would be sent through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// amount is the amount that was deferred.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// reason is why the forward was deferred.
+	Reason DeferReason `protobuf:"varint,4,opt,name=reason,proto3,enum=noble.forwarding.v1.DeferReason" json:"reason,omitempty"`
+}
+
+func (m *ForwardDeferred) Reset()         { *m = ForwardDeferred{} }
+func (m *ForwardDeferred) String() string { return proto.CompactTextString(m) }
+func (*ForwardDeferred) ProtoMessage()    {}
+func (*ForwardDeferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{8}
+}
+func (m *ForwardDeferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardDeferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardDeferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CurrentDenoms) > 0 {
-		for _, s := range m.CurrentDenoms {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+}
+func (m *ForwardDeferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardDeferred.Merge(m, src)
+}
+func (m *ForwardDeferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardDeferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardDeferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardDeferred proto.InternalMessageInfo
+
+func (m *ForwardDeferred) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardDeferred) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardDeferred) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ForwardDeferred) GetReason() DeferReason {
+	if m != nil {
+		return m.Reason
+	}
+	return DeferReasonUnspecified
+}
+
+// MinForwardAmountConfigured is emitted whenever the minimum forward amount of
+// a denom is updated.
+type MinForwardAmountConfigured struct {
+	// denom is the denom that the minimum forward amount applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// previous_amount is the previous minimum forward amount.
+	PreviousAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=previous_amount,json=previousAmount,proto3,customtype=cosmossdk.io/math.Int" json:"previous_amount"`
+	// current_amount is the current minimum forward amount.
+	CurrentAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=current_amount,json=currentAmount,proto3,customtype=cosmossdk.io/math.Int" json:"current_amount"`
+}
+
+func (m *MinForwardAmountConfigured) Reset()         { *m = MinForwardAmountConfigured{} }
+func (m *MinForwardAmountConfigured) String() string { return proto.CompactTextString(m) }
+func (*MinForwardAmountConfigured) ProtoMessage()    {}
+func (*MinForwardAmountConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{9}
+}
+func (m *MinForwardAmountConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinForwardAmountConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinForwardAmountConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinForwardAmountConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinForwardAmountConfigured.Merge(m, src)
+}
+func (m *MinForwardAmountConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinForwardAmountConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinForwardAmountConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinForwardAmountConfigured proto.InternalMessageInfo
+
+func (m *MinForwardAmountConfigured) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// ExecutionLimitsConfigured is emitted whenever the execution limits are
+// updated.
+type ExecutionLimitsConfigured struct {
+	// previous_limits are the previous execution limits.
+	PreviousLimits ExecutionLimits `protobuf:"bytes,1,opt,name=previous_limits,json=previousLimits,proto3" json:"previous_limits"`
+	// current_limits are the current execution limits.
+	CurrentLimits ExecutionLimits `protobuf:"bytes,2,opt,name=current_limits,json=currentLimits,proto3" json:"current_limits"`
+}
+
+func (m *ExecutionLimitsConfigured) Reset()         { *m = ExecutionLimitsConfigured{} }
+func (m *ExecutionLimitsConfigured) String() string { return proto.CompactTextString(m) }
+func (*ExecutionLimitsConfigured) ProtoMessage()    {}
+func (*ExecutionLimitsConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{10}
+}
+func (m *ExecutionLimitsConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutionLimitsConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutionLimitsConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExecutionLimitsConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutionLimitsConfigured.Merge(m, src)
+}
+func (m *ExecutionLimitsConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutionLimitsConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutionLimitsConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExecutionLimitsConfigured proto.InternalMessageInfo
+
+func (m *ExecutionLimitsConfigured) GetPreviousLimits() ExecutionLimits {
+	if m != nil {
+		return m.PreviousLimits
+	}
+	return ExecutionLimits{}
+}
+
+func (m *ExecutionLimitsConfigured) GetCurrentLimits() ExecutionLimits {
+	if m != nil {
+		return m.CurrentLimits
+	}
+	return ExecutionLimits{}
+}
+
+// FeeScheduleConfigured is emitted whenever the fee schedule of a denom is
+// updated.
+type FeeScheduleConfigured struct {
+	// denom is the denom that the fee schedule applies to.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// previous_schedule is the previous fee schedule.
+	PreviousSchedule FeeSchedule `protobuf:"bytes,2,opt,name=previous_schedule,json=previousSchedule,proto3" json:"previous_schedule"`
+	// current_schedule is the current fee schedule.
+	CurrentSchedule FeeSchedule `protobuf:"bytes,3,opt,name=current_schedule,json=currentSchedule,proto3" json:"current_schedule"`
+}
+
+func (m *FeeScheduleConfigured) Reset()         { *m = FeeScheduleConfigured{} }
+func (m *FeeScheduleConfigured) String() string { return proto.CompactTextString(m) }
+func (*FeeScheduleConfigured) ProtoMessage()    {}
+func (*FeeScheduleConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{11}
+}
+func (m *FeeScheduleConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeScheduleConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeScheduleConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeScheduleConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeScheduleConfigured.Merge(m, src)
+}
+func (m *FeeScheduleConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeScheduleConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeScheduleConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeScheduleConfigured proto.InternalMessageInfo
+
+func (m *FeeScheduleConfigured) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeScheduleConfigured) GetPreviousSchedule() FeeSchedule {
+	if m != nil {
+		return m.PreviousSchedule
+	}
+	return FeeSchedule{}
+}
+
+func (m *FeeScheduleConfigured) GetCurrentSchedule() FeeSchedule {
+	if m != nil {
+		return m.CurrentSchedule
+	}
+	return FeeSchedule{}
+}
+
+// FeeRecipientConfigured is emitted whenever the fee recipient is updated.
+type FeeRecipientConfigured struct {
+	// previous_recipient is the previous fee recipient.
+	PreviousRecipient string `protobuf:"bytes,1,opt,name=previous_recipient,json=previousRecipient,proto3" json:"previous_recipient,omitempty"`
+	// current_recipient is the current fee recipient.
+	CurrentRecipient string `protobuf:"bytes,2,opt,name=current_recipient,json=currentRecipient,proto3" json:"current_recipient,omitempty"`
+}
+
+func (m *FeeRecipientConfigured) Reset()         { *m = FeeRecipientConfigured{} }
+func (m *FeeRecipientConfigured) String() string { return proto.CompactTextString(m) }
+func (*FeeRecipientConfigured) ProtoMessage()    {}
+func (*FeeRecipientConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{12}
+}
+func (m *FeeRecipientConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRecipientConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRecipientConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRecipientConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRecipientConfigured.Merge(m, src)
+}
+func (m *FeeRecipientConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRecipientConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRecipientConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRecipientConfigured proto.InternalMessageInfo
+
+func (m *FeeRecipientConfigured) GetPreviousRecipient() string {
+	if m != nil {
+		return m.PreviousRecipient
+	}
+	return ""
+}
+
+func (m *FeeRecipientConfigured) GetCurrentRecipient() string {
+	if m != nil {
+		return m.CurrentRecipient
+	}
+	return ""
+}
+
+// RelayerFeeConfigured is emitted whenever the relayer fee of a channel is
+// updated.
+type RelayerFeeConfigured struct {
+	// channel is the channel id that the relayer fee applies to.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// previous_fee is the previous relayer fee.
+	PreviousFee RelayerFee `protobuf:"bytes,2,opt,name=previous_fee,json=previousFee,proto3" json:"previous_fee"`
+	// current_fee is the current relayer fee.
+	CurrentFee RelayerFee `protobuf:"bytes,3,opt,name=current_fee,json=currentFee,proto3" json:"current_fee"`
+}
+
+func (m *RelayerFeeConfigured) Reset()         { *m = RelayerFeeConfigured{} }
+func (m *RelayerFeeConfigured) String() string { return proto.CompactTextString(m) }
+func (*RelayerFeeConfigured) ProtoMessage()    {}
+func (*RelayerFeeConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{13}
+}
+func (m *RelayerFeeConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RelayerFeeConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelayerFeeConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RelayerFeeConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelayerFeeConfigured.Merge(m, src)
+}
+func (m *RelayerFeeConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelayerFeeConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelayerFeeConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RelayerFeeConfigured proto.InternalMessageInfo
+
+func (m *RelayerFeeConfigured) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *RelayerFeeConfigured) GetPreviousFee() RelayerFee {
+	if m != nil {
+		return m.PreviousFee
+	}
+	return RelayerFee{}
+}
+
+func (m *RelayerFeeConfigured) GetCurrentFee() RelayerFee {
+	if m != nil {
+		return m.CurrentFee
+	}
+	return RelayerFee{}
+}
+
+// FallbackGracePeriodConfigured is emitted whenever the fallback grace period
+// is updated.
+type FallbackGracePeriodConfigured struct {
+	// previous_grace_period is the previous grace period, in nanoseconds.
+	PreviousGracePeriod uint64 `protobuf:"varint,1,opt,name=previous_grace_period,json=previousGracePeriod,proto3" json:"previous_grace_period,omitempty"`
+	// current_grace_period is the current grace period, in nanoseconds.
+	CurrentGracePeriod uint64 `protobuf:"varint,2,opt,name=current_grace_period,json=currentGracePeriod,proto3" json:"current_grace_period,omitempty"`
+}
+
+func (m *FallbackGracePeriodConfigured) Reset()         { *m = FallbackGracePeriodConfigured{} }
+func (m *FallbackGracePeriodConfigured) String() string { return proto.CompactTextString(m) }
+func (*FallbackGracePeriodConfigured) ProtoMessage()    {}
+func (*FallbackGracePeriodConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{14}
+}
+func (m *FallbackGracePeriodConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FallbackGracePeriodConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FallbackGracePeriodConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FallbackGracePeriodConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FallbackGracePeriodConfigured.Merge(m, src)
+}
+func (m *FallbackGracePeriodConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *FallbackGracePeriodConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_FallbackGracePeriodConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FallbackGracePeriodConfigured proto.InternalMessageInfo
+
+func (m *FallbackGracePeriodConfigured) GetPreviousGracePeriod() uint64 {
+	if m != nil {
+		return m.PreviousGracePeriod
+	}
+	return 0
+}
+
+func (m *FallbackGracePeriodConfigured) GetCurrentGracePeriod() uint64 {
+	if m != nil {
+		return m.CurrentGracePeriod
+	}
+	return 0
+}
+
+// ForwardSweptToFallback is emitted whenever the funds of a forwarding account
+// are swept to its fallback address, because its channel isn't open.
+type ForwardSweptToFallback struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that the account forwards through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// fallback is the address that the funds were swept to.
+	Fallback string `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	// amount is the amount that was swept.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// reason is why the funds were swept.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *ForwardSweptToFallback) Reset()         { *m = ForwardSweptToFallback{} }
+func (m *ForwardSweptToFallback) String() string { return proto.CompactTextString(m) }
+func (*ForwardSweptToFallback) ProtoMessage()    {}
+func (*ForwardSweptToFallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{15}
+}
+func (m *ForwardSweptToFallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardSweptToFallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardSweptToFallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardSweptToFallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardSweptToFallback.Merge(m, src)
+}
+func (m *ForwardSweptToFallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardSweptToFallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardSweptToFallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardSweptToFallback proto.InternalMessageInfo
+
+func (m *ForwardSweptToFallback) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ForwardSweptToFallback) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ForwardSweptToFallback) GetFallback() string {
+	if m != nil {
+		return m.Fallback
+	}
+	return ""
+}
+
+func (m *ForwardSweptToFallback) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *ForwardSweptToFallback) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// ChannelPaused is emitted whenever automatic forwards are paused on a channel.
+type ChannelPaused struct {
+	// channel is the channel id that was paused.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// reject_registrations defines if new accounts can't be registered on the
+	// channel while it is paused.
+	RejectRegistrations bool `protobuf:"varint,2,opt,name=reject_registrations,json=rejectRegistrations,proto3" json:"reject_registrations,omitempty"`
+}
+
+func (m *ChannelPaused) Reset()         { *m = ChannelPaused{} }
+func (m *ChannelPaused) String() string { return proto.CompactTextString(m) }
+func (*ChannelPaused) ProtoMessage()    {}
+func (*ChannelPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{16}
+}
+func (m *ChannelPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelPaused.Merge(m, src)
+}
+func (m *ChannelPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelPaused proto.InternalMessageInfo
+
+func (m *ChannelPaused) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelPaused) GetRejectRegistrations() bool {
+	if m != nil {
+		return m.RejectRegistrations
+	}
+	return false
+}
+
+// ChannelResumed is emitted whenever automatic forwards are resumed on a
+// channel.
+type ChannelResumed struct {
+	// channel is the channel id that was resumed.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (m *ChannelResumed) Reset()         { *m = ChannelResumed{} }
+func (m *ChannelResumed) String() string { return proto.CompactTextString(m) }
+func (*ChannelResumed) ProtoMessage()    {}
+func (*ChannelResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{17}
+}
+func (m *ChannelResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelResumed.Merge(m, src)
+}
+func (m *ChannelResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelResumed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelResumed proto.InternalMessageInfo
+
+func (m *ChannelResumed) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+// CircuitBreakerEnabled is emitted whenever the circuit breaker is enabled,
+// suspending all automatic forwards and signerless registrations.
+type CircuitBreakerEnabled struct {
+	// signer is the address that enabled the circuit breaker.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *CircuitBreakerEnabled) Reset()         { *m = CircuitBreakerEnabled{} }
+func (m *CircuitBreakerEnabled) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerEnabled) ProtoMessage()    {}
+func (*CircuitBreakerEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{18}
+}
+func (m *CircuitBreakerEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerEnabled.Merge(m, src)
+}
+func (m *CircuitBreakerEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerEnabled proto.InternalMessageInfo
+
+func (m *CircuitBreakerEnabled) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// CircuitBreakerDisabled is emitted whenever the circuit breaker is disabled.
+type CircuitBreakerDisabled struct {
+	// signer is the address that disabled the circuit breaker.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *CircuitBreakerDisabled) Reset()         { *m = CircuitBreakerDisabled{} }
+func (m *CircuitBreakerDisabled) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreakerDisabled) ProtoMessage()    {}
+func (*CircuitBreakerDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{19}
+}
+func (m *CircuitBreakerDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreakerDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreakerDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreakerDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreakerDisabled.Merge(m, src)
+}
+func (m *CircuitBreakerDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreakerDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreakerDisabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreakerDisabled proto.InternalMessageInfo
+
+func (m *CircuitBreakerDisabled) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// GuardianConfigured is emitted whenever the guardian is updated.
+type GuardianConfigured struct {
+	// previous_guardian is the previous guardian.
+	PreviousGuardian string `protobuf:"bytes,1,opt,name=previous_guardian,json=previousGuardian,proto3" json:"previous_guardian,omitempty"`
+	// current_guardian is the current guardian.
+	CurrentGuardian string `protobuf:"bytes,2,opt,name=current_guardian,json=currentGuardian,proto3" json:"current_guardian,omitempty"`
+}
+
+func (m *GuardianConfigured) Reset()         { *m = GuardianConfigured{} }
+func (m *GuardianConfigured) String() string { return proto.CompactTextString(m) }
+func (*GuardianConfigured) ProtoMessage()    {}
+func (*GuardianConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{20}
+}
+func (m *GuardianConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GuardianConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GuardianConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GuardianConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GuardianConfigured.Merge(m, src)
+}
+func (m *GuardianConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *GuardianConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_GuardianConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GuardianConfigured proto.InternalMessageInfo
+
+func (m *GuardianConfigured) GetPreviousGuardian() string {
+	if m != nil {
+		return m.PreviousGuardian
+	}
+	return ""
+}
+
+func (m *GuardianConfigured) GetCurrentGuardian() string {
+	if m != nil {
+		return m.CurrentGuardian
+	}
+	return ""
+}
+
+// RateLimitConfigured is emitted whenever the rate limit of a channel and
+// denom is updated.
+type RateLimitConfigured struct {
+	// channel is the channel id that the rate limit applies to.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// denom is the denom that the rate limit applies to.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// previous_limit is the previous rate limit.
+	PreviousLimit RateLimit `protobuf:"bytes,3,opt,name=previous_limit,json=previousLimit,proto3" json:"previous_limit"`
+	// current_limit is the current rate limit.
+	CurrentLimit RateLimit `protobuf:"bytes,4,opt,name=current_limit,json=currentLimit,proto3" json:"current_limit"`
+}
+
+func (m *RateLimitConfigured) Reset()         { *m = RateLimitConfigured{} }
+func (m *RateLimitConfigured) String() string { return proto.CompactTextString(m) }
+func (*RateLimitConfigured) ProtoMessage()    {}
+func (*RateLimitConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{21}
+}
+func (m *RateLimitConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitConfigured.Merge(m, src)
+}
+func (m *RateLimitConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitConfigured proto.InternalMessageInfo
+
+func (m *RateLimitConfigured) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *RateLimitConfigured) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *RateLimitConfigured) GetPreviousLimit() RateLimit {
+	if m != nil {
+		return m.PreviousLimit
+	}
+	return RateLimit{}
+}
+
+func (m *RateLimitConfigured) GetCurrentLimit() RateLimit {
+	if m != nil {
+		return m.CurrentLimit
+	}
+	return RateLimit{}
+}
+
+// RateLimitExceeded is emitted whenever an automatic forward is queued because
+// it would exceed the rate limit of its channel and denom.
+type RateLimitExceeded struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that the account forwards through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// denom is the denom that exceeded the rate limit.
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// amount is the amount that was to be forwarded.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// usage is the amount already forwarded within the current window.
+	Usage cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=usage,proto3,customtype=cosmossdk.io/math.Int" json:"usage"`
+	// limit is the maximum amount forwarded within a single window.
+	Limit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=limit,proto3,customtype=cosmossdk.io/math.Int" json:"limit"`
+}
+
+func (m *RateLimitExceeded) Reset()         { *m = RateLimitExceeded{} }
+func (m *RateLimitExceeded) String() string { return proto.CompactTextString(m) }
+func (*RateLimitExceeded) ProtoMessage()    {}
+func (*RateLimitExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{22}
+}
+func (m *RateLimitExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitExceeded.Merge(m, src)
+}
+func (m *RateLimitExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitExceeded proto.InternalMessageInfo
+
+func (m *RateLimitExceeded) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RateLimitExceeded) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *RateLimitExceeded) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DepositHeld is emitted whenever a deposit into a scheduled forwarding
+// account is held until it becomes eligible to be forwarded.
+type DepositHeld struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount that is held.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// eligible_at is the block time at which the deposit becomes eligible to
+	// be forwarded, in unix nanoseconds.
+	EligibleAt int64 `protobuf:"varint,3,opt,name=eligible_at,json=eligibleAt,proto3" json:"eligible_at,omitempty"`
+}
+
+func (m *DepositHeld) Reset()         { *m = DepositHeld{} }
+func (m *DepositHeld) String() string { return proto.CompactTextString(m) }
+func (*DepositHeld) ProtoMessage()    {}
+func (*DepositHeld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{23}
+}
+func (m *DepositHeld) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositHeld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositHeld.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositHeld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositHeld.Merge(m, src)
+}
+func (m *DepositHeld) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositHeld) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositHeld.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositHeld proto.InternalMessageInfo
+
+func (m *DepositHeld) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DepositHeld) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *DepositHeld) GetEligibleAt() int64 {
+	if m != nil {
+		return m.EligibleAt
+	}
+	return 0
+}
+
+// DepositReleased is emitted whenever held deposits of a scheduled forwarding
+// account become eligible to be forwarded.
+type DepositReleased struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the amount that was released.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *DepositReleased) Reset()         { *m = DepositReleased{} }
+func (m *DepositReleased) String() string { return proto.CompactTextString(m) }
+func (*DepositReleased) ProtoMessage()    {}
+func (*DepositReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{24}
+}
+func (m *DepositReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositReleased.Merge(m, src)
+}
+func (m *DepositReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositReleased proto.InternalMessageInfo
+
+func (m *DepositReleased) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DepositReleased) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// BatchStarted is emitted whenever funds start accumulating inside a
+// forwarding account with batching.
+type BatchStarted struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// forward_at is the block time at which the funds are forwarded at the
+	// latest, in unix nanoseconds.
+	ForwardAt int64 `protobuf:"varint,2,opt,name=forward_at,json=forwardAt,proto3" json:"forward_at,omitempty"`
+}
+
+func (m *BatchStarted) Reset()         { *m = BatchStarted{} }
+func (m *BatchStarted) String() string { return proto.CompactTextString(m) }
+func (*BatchStarted) ProtoMessage()    {}
+func (*BatchStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{25}
+}
+func (m *BatchStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchStarted.Merge(m, src)
+}
+func (m *BatchStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchStarted proto.InternalMessageInfo
+
+func (m *BatchStarted) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BatchStarted) GetForwardAt() int64 {
+	if m != nil {
+		return m.ForwardAt
+	}
+	return 0
+}
+
+// BatchReleased is emitted whenever the funds accumulated inside a forwarding
+// account with batching are released to be forwarded.
+type BatchReleased struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// target_reached is true if the balance of a denom reached its target, and
+	// false if the maximum wait has passed.
+	TargetReached bool `protobuf:"varint,2,opt,name=target_reached,json=targetReached,proto3" json:"target_reached,omitempty"`
+}
+
+func (m *BatchReleased) Reset()         { *m = BatchReleased{} }
+func (m *BatchReleased) String() string { return proto.CompactTextString(m) }
+func (*BatchReleased) ProtoMessage()    {}
+func (*BatchReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{26}
+}
+func (m *BatchReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchReleased.Merge(m, src)
+}
+func (m *BatchReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchReleased proto.InternalMessageInfo
+
+func (m *BatchReleased) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BatchReleased) GetTargetReached() bool {
+	if m != nil {
+		return m.TargetReached
+	}
+	return false
+}
+
+// ChannelAllowedDenomsConfigured is emitted whenever the allowed denoms of a
+// channel are updated.
+type ChannelAllowedDenomsConfigured struct {
+	// channel is the channel id that the allowed denoms apply to.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// previous_denoms is the list of previously allowed denoms.
+	PreviousDenoms []string `protobuf:"bytes,2,rep,name=previous_denoms,json=previousDenoms,proto3" json:"previous_denoms,omitempty"`
+	// current_denoms is the list of currently allowed denoms.
+	CurrentDenoms []string `protobuf:"bytes,3,rep,name=current_denoms,json=currentDenoms,proto3" json:"current_denoms,omitempty"`
+}
+
+func (m *ChannelAllowedDenomsConfigured) Reset()         { *m = ChannelAllowedDenomsConfigured{} }
+func (m *ChannelAllowedDenomsConfigured) String() string { return proto.CompactTextString(m) }
+func (*ChannelAllowedDenomsConfigured) ProtoMessage()    {}
+func (*ChannelAllowedDenomsConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{27}
+}
+func (m *ChannelAllowedDenomsConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelAllowedDenomsConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelAllowedDenomsConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelAllowedDenomsConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelAllowedDenomsConfigured.Merge(m, src)
+}
+func (m *ChannelAllowedDenomsConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelAllowedDenomsConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelAllowedDenomsConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelAllowedDenomsConfigured proto.InternalMessageInfo
+
+func (m *ChannelAllowedDenomsConfigured) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *ChannelAllowedDenomsConfigured) GetPreviousDenoms() []string {
+	if m != nil {
+		return m.PreviousDenoms
+	}
+	return nil
+}
+
+func (m *ChannelAllowedDenomsConfigured) GetCurrentDenoms() []string {
+	if m != nil {
+		return m.CurrentDenoms
+	}
+	return nil
+}
+
+// UnwindOnlyConfigured is emitted whenever the module-wide unwind-only mode is
+// updated.
+type UnwindOnlyConfigured struct {
+	// previous_enabled defines if unwind-only mode was previously enabled.
+	PreviousEnabled bool `protobuf:"varint,1,opt,name=previous_enabled,json=previousEnabled,proto3" json:"previous_enabled,omitempty"`
+	// current_enabled defines if unwind-only mode is currently enabled.
+	CurrentEnabled bool `protobuf:"varint,2,opt,name=current_enabled,json=currentEnabled,proto3" json:"current_enabled,omitempty"`
+}
+
+func (m *UnwindOnlyConfigured) Reset()         { *m = UnwindOnlyConfigured{} }
+func (m *UnwindOnlyConfigured) String() string { return proto.CompactTextString(m) }
+func (*UnwindOnlyConfigured) ProtoMessage()    {}
+func (*UnwindOnlyConfigured) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{28}
+}
+func (m *UnwindOnlyConfigured) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnwindOnlyConfigured) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnwindOnlyConfigured.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnwindOnlyConfigured) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnwindOnlyConfigured.Merge(m, src)
+}
+func (m *UnwindOnlyConfigured) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnwindOnlyConfigured) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnwindOnlyConfigured.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnwindOnlyConfigured proto.InternalMessageInfo
+
+func (m *UnwindOnlyConfigured) GetPreviousEnabled() bool {
+	if m != nil {
+		return m.PreviousEnabled
+	}
+	return false
+}
+
+func (m *UnwindOnlyConfigured) GetCurrentEnabled() bool {
+	if m != nil {
+		return m.CurrentEnabled
+	}
+	return false
+}
+
+// VoucherRejected is emitted whenever an IBC voucher received by a forwarding
+// account in unwind-only mode isn't forwarded, as forwarding it wouldn't
+// unwind it along its own trace.
+type VoucherRejected struct {
+	// address is the address of the forwarding account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// channel is the channel id that the voucher would be forwarded through.
+	Channel string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// amount is the amount that was rejected.
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// fallback is the address that the voucher was sent to, or empty if it was
+	// left in the account to be cleared.
+	Fallback string `protobuf:"bytes,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
+}
+
+func (m *VoucherRejected) Reset()         { *m = VoucherRejected{} }
+func (m *VoucherRejected) String() string { return proto.CompactTextString(m) }
+func (*VoucherRejected) ProtoMessage()    {}
+func (*VoucherRejected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f58759da7cd78060, []int{29}
+}
+func (m *VoucherRejected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoucherRejected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoucherRejected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoucherRejected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoucherRejected.Merge(m, src)
+}
+func (m *VoucherRejected) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoucherRejected) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoucherRejected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoucherRejected proto.InternalMessageInfo
+
+func (m *VoucherRejected) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VoucherRejected) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *VoucherRejected) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *VoucherRejected) GetFallback() string {
+	if m != nil {
+		return m.Fallback
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AccountRegistered)(nil), "noble.forwarding.v1.AccountRegistered")
+	proto.RegisterType((*AccountCleared)(nil), "noble.forwarding.v1.AccountCleared")
+	proto.RegisterType((*AllowedDenomsConfigured)(nil), "noble.forwarding.v1.AllowedDenomsConfigured")
+	proto.RegisterType((*BlockedDenomsConfigured)(nil), "noble.forwarding.v1.BlockedDenomsConfigured")
+	proto.RegisterType((*ForwardRefunded)(nil), "noble.forwarding.v1.ForwardRefunded")
+	proto.RegisterType((*RefundPolicyConfigured)(nil), "noble.forwarding.v1.RefundPolicyConfigured")
+	proto.RegisterType((*TimeoutPolicyConfigured)(nil), "noble.forwarding.v1.TimeoutPolicyConfigured")
+	proto.RegisterType((*MaxMemoLengthConfigured)(nil), "noble.forwarding.v1.MaxMemoLengthConfigured")
+	proto.RegisterType((*ForwardDeferred)(nil), "noble.forwarding.v1.ForwardDeferred")
+	proto.RegisterType((*MinForwardAmountConfigured)(nil), "noble.forwarding.v1.MinForwardAmountConfigured")
+	proto.RegisterType((*ExecutionLimitsConfigured)(nil), "noble.forwarding.v1.ExecutionLimitsConfigured")
+	proto.RegisterType((*FeeScheduleConfigured)(nil), "noble.forwarding.v1.FeeScheduleConfigured")
+	proto.RegisterType((*FeeRecipientConfigured)(nil), "noble.forwarding.v1.FeeRecipientConfigured")
+	proto.RegisterType((*RelayerFeeConfigured)(nil), "noble.forwarding.v1.RelayerFeeConfigured")
+	proto.RegisterType((*FallbackGracePeriodConfigured)(nil), "noble.forwarding.v1.FallbackGracePeriodConfigured")
+	proto.RegisterType((*ForwardSweptToFallback)(nil), "noble.forwarding.v1.ForwardSweptToFallback")
+	proto.RegisterType((*ChannelPaused)(nil), "noble.forwarding.v1.ChannelPaused")
+	proto.RegisterType((*ChannelResumed)(nil), "noble.forwarding.v1.ChannelResumed")
+	proto.RegisterType((*CircuitBreakerEnabled)(nil), "noble.forwarding.v1.CircuitBreakerEnabled")
+	proto.RegisterType((*CircuitBreakerDisabled)(nil), "noble.forwarding.v1.CircuitBreakerDisabled")
+	proto.RegisterType((*GuardianConfigured)(nil), "noble.forwarding.v1.GuardianConfigured")
+	proto.RegisterType((*RateLimitConfigured)(nil), "noble.forwarding.v1.RateLimitConfigured")
+	proto.RegisterType((*RateLimitExceeded)(nil), "noble.forwarding.v1.RateLimitExceeded")
+	proto.RegisterType((*DepositHeld)(nil), "noble.forwarding.v1.DepositHeld")
+	proto.RegisterType((*DepositReleased)(nil), "noble.forwarding.v1.DepositReleased")
+	proto.RegisterType((*BatchStarted)(nil), "noble.forwarding.v1.BatchStarted")
+	proto.RegisterType((*BatchReleased)(nil), "noble.forwarding.v1.BatchReleased")
+	proto.RegisterType((*ChannelAllowedDenomsConfigured)(nil), "noble.forwarding.v1.ChannelAllowedDenomsConfigured")
+	proto.RegisterType((*UnwindOnlyConfigured)(nil), "noble.forwarding.v1.UnwindOnlyConfigured")
+	proto.RegisterType((*VoucherRejected)(nil), "noble.forwarding.v1.VoucherRejected")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/events.proto", fileDescriptor_f58759da7cd78060) }
+
+var fileDescriptor_f58759da7cd78060 = []byte{
+	// 1650 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x9f, 0xb6, 0x1d, 0x6f, 0xf2, 0x9c, 0x38, 0x33, 0x3d, 0x99, 0x4c, 0x4f, 0xc4, 0xd8, 0xde,
+	0x16, 0xa3, 0xf5, 0x32, 0x8a, 0x3d, 0x31, 0x42, 0xbb, 0x9c, 0x50, 0xbe, 0x3c, 0x19, 0xd8, 0xd1,
+	0x66, 0x3a, 0x81, 0x03, 0x42, 0xb2, 0xca, 0xdd, 0xcf, 0x76, 0x6f, 0xda, 0x5d, 0xa6, 0xab, 0x3a,
+	0x1f, 0x7f, 0x00, 0x07, 0x38, 0xed, 0x99, 0xbf, 0x00, 0x21, 0xc1, 0x89, 0x03, 0x67, 0xb8, 0xec,
+	0x71, 0x85, 0x84, 0x40, 0x08, 0x16, 0x98, 0x11, 0x27, 0xfe, 0x09, 0xd4, 0xf5, 0xd1, 0x6e, 0x7b,
+	0x1c, 0x13, 0x47, 0x62, 0x38, 0x25, 0xf5, 0xbe, 0xea, 0xfd, 0x5e, 0xbd, 0xfa, 0xf5, 0x2b, 0x43,
+	0x2d, 0xa4, 0xdd, 0x00, 0x9b, 0x3d, 0x1a, 0x5d, 0x90, 0xc8, 0xf3, 0xc3, 0x7e, 0xf3, 0x7c, 0xa7,
+	0x89, 0xe7, 0x18, 0x72, 0xd6, 0x18, 0x45, 0x94, 0x53, 0xf3, 0xbe, 0xb0, 0x68, 0x8c, 0x2d, 0x1a,
+	0xe7, 0x3b, 0x5b, 0x15, 0x97, 0xb2, 0x21, 0x65, 0xcd, 0x2e, 0x61, 0xd8, 0x3c, 0xdf, 0xe9, 0x22,
+	0x27, 0x3b, 0x4d, 0x97, 0xfa, 0xa1, 0x74, 0xda, 0x7a, 0x24, 0xf5, 0x1d, 0xb1, 0x6a, 0xca, 0x85,
+	0x52, 0x6d, 0xf4, 0x69, 0x9f, 0x4a, 0x79, 0xf2, 0x9f, 0x92, 0xbe, 0x3f, 0x2b, 0x0f, 0xe2, 0xba,
+	0x34, 0x0e, 0xb9, 0x32, 0xa9, 0xce, 0x32, 0x61, 0x9c, 0x70, 0x94, 0x06, 0xf6, 0x3f, 0x0b, 0x70,
+	0x6f, 0x57, 0xba, 0x38, 0xd8, 0xf7, 0x19, 0xc7, 0x08, 0x3d, 0xd3, 0x82, 0xf7, 0x88, 0xe7, 0x45,
+	0xc8, 0x98, 0x65, 0xd4, 0x8c, 0xfa, 0x8a, 0xa3, 0x97, 0x89, 0xc6, 0x1d, 0x90, 0x30, 0xc4, 0xc0,
+	0xca, 0x49, 0x8d, 0x5a, 0x9a, 0x5f, 0x83, 0x95, 0x08, 0x5d, 0x7f, 0xe4, 0x63, 0xc8, 0xad, 0xbc,
+	0xd0, 0x8d, 0x05, 0xe6, 0x16, 0x2c, 0xf7, 0x48, 0x10, 0x74, 0x89, 0x7b, 0x66, 0x15, 0x84, 0x32,
+	0x5d, 0x9b, 0x26, 0x14, 0x86, 0x38, 0xa4, 0xd6, 0x92, 0x90, 0x8b, 0xff, 0xcd, 0x16, 0x14, 0x06,
+	0x74, 0xc4, 0xac, 0x62, 0x2d, 0x5f, 0x2f, 0xb5, 0xac, 0xc6, 0x8c, 0x82, 0x36, 0x8e, 0xe8, 0x68,
+	0xaf, 0xf0, 0xc5, 0x57, 0xd5, 0x3b, 0x8e, 0xb0, 0x35, 0xbf, 0x03, 0xcb, 0xcc, 0x1d, 0xa0, 0x17,
+	0x07, 0x68, 0xbd, 0x57, 0x33, 0xea, 0xa5, 0xd6, 0xe3, 0x99, 0x7e, 0x27, 0xca, 0x48, 0x39, 0xa7,
+	0x4e, 0xe6, 0xc7, 0x50, 0x64, 0xa3, 0xc0, 0xe7, 0xcc, 0x5a, 0x16, 0xdb, 0x6e, 0xcd, 0x76, 0x4f,
+	0x4c, 0x94, 0xaf, 0xb2, 0x4f, 0xb6, 0xee, 0x12, 0xee, 0x0e, 0xfc, 0xb0, 0x6f, 0xad, 0xcc, 0xd9,
+	0x7a, 0x4f, 0x19, 0xe9, 0xad, 0xb5, 0x93, 0x79, 0x04, 0xab, 0x1e, 0x86, 0x74, 0xd8, 0x89, 0x68,
+	0xcc, 0x91, 0x59, 0x20, 0x12, 0xa8, 0xce, 0x0c, 0x72, 0x90, 0x18, 0x3a, 0x89, 0x9d, 0x0a, 0x53,
+	0xf2, 0x52, 0x09, 0x33, 0x9f, 0x40, 0x99, 0x04, 0x01, 0xbd, 0x40, 0xaf, 0x23, 0xc4, 0xcc, 0x2a,
+	0xd5, 0xf2, 0xf5, 0x15, 0x67, 0x4d, 0x49, 0x85, 0x37, 0x33, 0xab, 0x50, 0x8a, 0xc3, 0x0b, 0x3f,
+	0xf4, 0x3a, 0x34, 0x0c, 0xae, 0xac, 0xd5, 0x9a, 0x51, 0x5f, 0x76, 0x40, 0x8a, 0x3e, 0x0d, 0x83,
+	0x2b, 0xf3, 0x43, 0xb8, 0xeb, 0x21, 0xe3, 0x7e, 0x48, 0xb8, 0x4f, 0xc3, 0x0e, 0xbf, 0x1a, 0xa1,
+	0xb5, 0x26, 0x4e, 0x68, 0x3d, 0x23, 0x3f, 0xbd, 0x1a, 0xa1, 0xf9, 0x18, 0x40, 0xa4, 0xdd, 0xf1,
+	0x08, 0x27, 0x56, 0xb9, 0x66, 0xd4, 0x57, 0x9d, 0x15, 0x21, 0x39, 0x20, 0x9c, 0xd8, 0x47, 0x50,
+	0x56, 0x2d, 0xb6, 0x1f, 0x20, 0x99, 0xdf, 0x5f, 0x13, 0x5d, 0x94, 0x9b, 0xea, 0x22, 0xdb, 0x87,
+	0x87, 0xbb, 0x59, 0x14, 0xfb, 0x34, 0xec, 0xf9, 0xfd, 0x38, 0x09, 0xf9, 0x01, 0xac, 0x8f, 0x22,
+	0x3c, 0xf7, 0x69, 0xcc, 0x34, 0x6e, 0x43, 0xe0, 0x2e, 0x6b, 0xb1, 0x02, 0xfe, 0x04, 0xca, 0x6e,
+	0x1c, 0x45, 0x18, 0x72, 0x6d, 0x97, 0x93, 0xf5, 0x51, 0x52, 0x69, 0x96, 0x6c, 0xb5, 0x17, 0x50,
+	0xf7, 0xec, 0x1d, 0x6c, 0xf5, 0x6f, 0x03, 0xd6, 0xdb, 0xf2, 0x84, 0x1d, 0xec, 0xc5, 0xa1, 0x77,
+	0xcb, 0x1b, 0xb8, 0x05, 0xcb, 0x0c, 0x7f, 0x1c, 0x63, 0xe8, 0xa2, 0xb8, 0x80, 0x05, 0x27, 0x5d,
+	0x9b, 0x1f, 0x41, 0x91, 0x0c, 0x93, 0x23, 0x10, 0xb7, 0xaf, 0xd4, 0x7a, 0xd4, 0x50, 0x04, 0x93,
+	0xb0, 0x51, 0x43, 0xb1, 0x51, 0x63, 0x9f, 0xfa, 0xa1, 0xee, 0x6c, 0x69, 0x6e, 0x6e, 0xc0, 0x12,
+	0x46, 0x11, 0x8d, 0xd4, 0xed, 0x94, 0x0b, 0xf3, 0xdb, 0x50, 0x1c, 0xd1, 0xc0, 0x77, 0xaf, 0xac,
+	0x62, 0xcd, 0xa8, 0x97, 0x5b, 0xef, 0xcf, 0x6c, 0x54, 0x89, 0xe6, 0x58, 0x18, 0x3a, 0xca, 0xc1,
+	0xfe, 0xb5, 0x01, 0x9b, 0x59, 0x45, 0xa6, 0xb0, 0xdf, 0xcd, 0x14, 0x56, 0x85, 0x37, 0x6e, 0x1a,
+	0x3e, 0xad, 0xbd, 0x5c, 0x9b, 0x47, 0xe3, 0xda, 0xab, 0x50, 0xb9, 0x9b, 0x86, 0xd2, 0xc7, 0x23,
+	0x97, 0xf6, 0x9f, 0x0c, 0x78, 0x78, 0xea, 0x0f, 0x91, 0xc6, 0xfc, 0xad, 0x8c, 0x33, 0x87, 0x61,
+	0x4c, 0x1e, 0xc6, 0xab, 0xb7, 0xb1, 0xe4, 0x44, 0xe5, 0xed, 0x99, 0x09, 0x4c, 0x6c, 0xa0, 0x8e,
+	0x60, 0x1a, 0xd2, 0xa7, 0x6f, 0x41, 0xca, 0x2f, 0x18, 0x71, 0x0a, 0xd9, 0x4f, 0x0d, 0x78, 0xf8,
+	0x92, 0x5c, 0xbe, 0xc4, 0x21, 0xfd, 0x04, 0xc3, 0x3e, 0x1f, 0x64, 0x90, 0x7d, 0x04, 0x56, 0x9a,
+	0xff, 0x90, 0x5c, 0x76, 0x12, 0x56, 0xee, 0x04, 0xc2, 0x4a, 0x40, 0x2d, 0x38, 0x0f, 0xb4, 0x7e,
+	0x22, 0x84, 0xf9, 0x2d, 0x78, 0xa8, 0xb3, 0x9c, 0xf6, 0xcb, 0x09, 0xbf, 0x0d, 0xa5, 0x9e, 0x70,
+	0xb3, 0x7f, 0x3b, 0xbe, 0x04, 0x07, 0xd8, 0xc3, 0xe8, 0xb6, 0x9f, 0xa1, 0x71, 0xa3, 0xe7, 0x17,
+	0x6b, 0xf4, 0x8f, 0xa1, 0x18, 0x21, 0x61, 0x34, 0x14, 0x37, 0xa4, 0xdc, 0xaa, 0x5d, 0xc3, 0xbd,
+	0x3d, 0x8c, 0x1c, 0x61, 0xe7, 0x28, 0x7b, 0xfb, 0xaf, 0x06, 0x6c, 0xbd, 0xf4, 0x43, 0x95, 0xfd,
+	0xae, 0x08, 0x97, 0xa9, 0xe4, 0x06, 0x2c, 0x89, 0xdb, 0xaf, 0x30, 0xc8, 0x85, 0x79, 0x9a, 0xe9,
+	0x0f, 0x95, 0xb0, 0x40, 0xb2, 0xf7, 0x34, 0xc9, 0xea, 0x2f, 0x5f, 0x55, 0x1f, 0xc8, 0xbc, 0x99,
+	0x77, 0xd6, 0xf0, 0x69, 0x73, 0x48, 0xf8, 0xa0, 0xf1, 0x22, 0xe4, 0x7f, 0xf8, 0xcd, 0x36, 0x28,
+	0x40, 0x2f, 0x42, 0x3e, 0x6e, 0x11, 0xb9, 0xab, 0xe9, 0x8c, 0x5b, 0x24, 0x53, 0x85, 0x05, 0x83,
+	0xea, 0x2e, 0x91, 0x31, 0xed, 0xdf, 0x1b, 0xf0, 0xe8, 0xf0, 0x12, 0xdd, 0x38, 0xe1, 0xfb, 0x4f,
+	0xfc, 0xa1, 0xcf, 0xb3, 0x64, 0x78, 0x92, 0xc1, 0x11, 0x08, 0xa5, 0xc0, 0x59, 0x6a, 0x7d, 0x7d,
+	0x66, 0xfd, 0xa6, 0x02, 0x4d, 0x77, 0xba, 0x94, 0x9a, 0xaf, 0xc6, 0x30, 0x54, 0xcc, 0xdc, 0xc2,
+	0x31, 0x35, 0x0a, 0x29, 0xb4, 0xff, 0x68, 0xc0, 0x83, 0x36, 0xa2, 0xfe, 0xf6, 0xff, 0xd7, 0xf3,
+	0x39, 0x81, 0x7b, 0x29, 0xae, 0x74, 0xaa, 0x90, 0x59, 0xcc, 0xee, 0x8c, 0x4c, 0x70, 0x95, 0xc1,
+	0x5d, 0x1d, 0x40, 0xcb, 0xcd, 0x57, 0x70, 0x57, 0xe3, 0x4a, 0x63, 0xe6, 0x17, 0x8a, 0xb9, 0xae,
+	0xfc, 0xb5, 0xd8, 0xe6, 0xb0, 0xd9, 0x46, 0x74, 0xf4, 0x27, 0x32, 0x83, 0x6b, 0x1b, 0xcc, 0x14,
+	0xc1, 0xf8, 0x9b, 0x2a, 0x41, 0xa6, 0xd8, 0x52, 0x47, 0xf3, 0x29, 0xdc, 0xd3, 0xb9, 0x4d, 0x7f,
+	0x81, 0x75, 0xd2, 0xa9, 0xb1, 0xfd, 0x3b, 0x03, 0x36, 0x1c, 0x0c, 0xc8, 0x15, 0x46, 0x6d, 0xc4,
+	0x1b, 0x11, 0xe2, 0x11, 0xac, 0xa6, 0xe9, 0xf4, 0x50, 0xd7, 0xb2, 0x7a, 0x0d, 0x1d, 0xeb, 0xd0,
+	0x7a, 0xc2, 0xd1, 0xae, 0x6d, 0x44, 0xb3, 0x0d, 0x25, 0x9d, 0x69, 0x0f, 0x75, 0x01, 0x6f, 0x18,
+	0x08, 0x94, 0x67, 0x1b, 0xd1, 0xfe, 0x89, 0x01, 0x8f, 0xdb, 0x6a, 0x08, 0x7d, 0x1e, 0x11, 0x17,
+	0x8f, 0x31, 0xf2, 0xa9, 0x97, 0x41, 0xd3, 0x82, 0x94, 0xe4, 0x3a, 0xfd, 0xc4, 0xa2, 0x33, 0x12,
+	0x26, 0x8a, 0x01, 0xef, 0x6b, 0x65, 0xc6, 0xdb, 0x7c, 0x06, 0x9a, 0xe0, 0x26, 0x5d, 0x24, 0xf9,
+	0x99, 0x4a, 0x97, 0xf1, 0xb0, 0xff, 0x65, 0xc0, 0xa6, 0x22, 0x8f, 0x93, 0x0b, 0x1c, 0xf1, 0x53,
+	0xaa, 0xb3, 0xba, 0xed, 0x18, 0x90, 0x8e, 0xda, 0xf9, 0xa9, 0x51, 0xdb, 0xcd, 0x8c, 0x01, 0xf9,
+	0xf9, 0xec, 0xf8, 0x2c, 0xa9, 0xd7, 0x2f, 0xff, 0x5e, 0xad, 0xf7, 0x7d, 0x3e, 0x88, 0xbb, 0x0d,
+	0x97, 0x0e, 0xd5, 0xa3, 0x44, 0xfd, 0xd9, 0x66, 0xde, 0x59, 0x33, 0x19, 0x0b, 0x99, 0x70, 0x60,
+	0x29, 0x93, 0x6e, 0xa6, 0x4c, 0x2a, 0x67, 0x06, 0xb5, 0xb2, 0x7f, 0x04, 0x6b, 0xfb, 0x32, 0xc7,
+	0x63, 0x12, 0xb3, 0xb9, 0xcd, 0xb2, 0x03, 0x1b, 0x11, 0x7e, 0x86, 0x6e, 0xd2, 0x8b, 0xc9, 0xab,
+	0x24, 0x12, 0xc3, 0xa6, 0xa4, 0x81, 0x65, 0xe7, 0xbe, 0xd4, 0x39, 0x59, 0x95, 0xfd, 0x0d, 0x28,
+	0xab, 0xe8, 0x0e, 0xb2, 0x78, 0x38, 0x2f, 0xbc, 0xdd, 0x84, 0x07, 0xfb, 0x7e, 0xe4, 0xc6, 0x3e,
+	0xdf, 0x8b, 0x90, 0x9c, 0x61, 0x74, 0x18, 0x92, 0x6e, 0x80, 0x5e, 0x92, 0x3a, 0xf3, 0xfb, 0x21,
+	0x46, 0xca, 0x43, 0xad, 0xec, 0x67, 0xb0, 0x39, 0xe9, 0x70, 0xe0, 0xb3, 0xf9, 0x1e, 0x01, 0x98,
+	0xcf, 0xe3, 0xa4, 0x11, 0x49, 0x98, 0x69, 0xa8, 0xa7, 0x19, 0x56, 0xe9, 0x2b, 0xb5, 0x72, 0x4c,
+	0xd9, 0x42, 0xbb, 0x25, 0x13, 0x78, 0xda, 0x49, 0xda, 0x56, 0x9e, 0xb5, 0x66, 0x01, 0x6d, 0x6a,
+	0xff, 0xcd, 0x80, 0xfb, 0x0e, 0xe1, 0x28, 0xc8, 0xee, 0x46, 0xd7, 0x31, 0x65, 0xbd, 0x5c, 0x96,
+	0xf5, 0xbe, 0x07, 0xe5, 0x49, 0x36, 0x57, 0xb7, 0xab, 0x32, 0xfb, 0x76, 0xe9, 0x1d, 0x35, 0xe5,
+	0x4e, 0xd0, 0xb8, 0xf9, 0x02, 0xd6, 0x26, 0x58, 0xdc, 0x2a, 0x2c, 0x10, 0x6b, 0x35, 0x4b, 0xdf,
+	0xf6, 0x2f, 0x72, 0x70, 0x2f, 0xb5, 0x38, 0xbc, 0x74, 0x11, 0x6f, 0x3b, 0x24, 0xa7, 0xb8, 0xf3,
+	0x59, 0xdc, 0xfb, 0x13, 0xe3, 0xf1, 0x82, 0xdf, 0x4b, 0xdd, 0xf7, 0xbb, 0xb0, 0x14, 0x33, 0xd2,
+	0x47, 0x6b, 0x69, 0xf1, 0x18, 0xd2, 0x33, 0x09, 0x21, 0x4b, 0x55, 0xbc, 0x45, 0x08, 0xe1, 0x69,
+	0xff, 0xca, 0x80, 0xd2, 0x01, 0x8e, 0x28, 0xf3, 0xf9, 0x11, 0x06, 0xf3, 0x8a, 0x34, 0x26, 0x83,
+	0xdc, 0xff, 0x8e, 0x0c, 0xaa, 0x50, 0xc2, 0xc0, 0xef, 0xfb, 0xdd, 0x00, 0x3b, 0x44, 0xb6, 0x53,
+	0xde, 0x01, 0x2d, 0xda, 0xe5, 0xf6, 0xe7, 0x06, 0xac, 0xab, 0x7c, 0x1d, 0x0c, 0x90, 0x30, 0xfc,
+	0x7f, 0xe7, 0x6c, 0x3f, 0x87, 0x55, 0xf1, 0x50, 0x3f, 0xe1, 0x24, 0xe2, 0x73, 0xd3, 0x79, 0x0c,
+	0xa0, 0xda, 0x38, 0x01, 0x97, 0x13, 0xe0, 0x56, 0x94, 0x64, 0x97, 0xdb, 0xc7, 0xb0, 0x26, 0x02,
+	0xdd, 0x00, 0xd8, 0x13, 0x28, 0x73, 0x12, 0xf5, 0x31, 0x61, 0x3c, 0x92, 0x7c, 0xdc, 0x15, 0xd7,
+	0xad, 0x49, 0xa9, 0x23, 0x85, 0xf6, 0xcf, 0x0c, 0xa8, 0x28, 0x9a, 0xbb, 0xee, 0x25, 0x7c, 0xfd,
+	0x9d, 0x9f, 0xf1, 0x70, 0xcd, 0xdd, 0xf0, 0xe1, 0x9a, 0x9f, 0xf5, 0x70, 0xfd, 0x0c, 0x36, 0xbe,
+	0x9f, 0xfe, 0x60, 0x90, 0xc9, 0xe0, 0x43, 0x48, 0xc9, 0xac, 0x83, 0x92, 0x59, 0x45, 0x2a, 0xcb,
+	0x4e, 0xba, 0xbf, 0x26, 0xdc, 0x0f, 0x40, 0x73, 0x59, 0x6a, 0x29, 0x71, 0xeb, 0x04, 0x94, 0xa1,
+	0xfd, 0x73, 0x03, 0xd6, 0x7f, 0x40, 0x63, 0x77, 0x90, 0x8c, 0xdf, 0x09, 0xfb, 0xbf, 0xeb, 0xf7,
+	0xc1, 0x9c, 0x5f, 0xb0, 0xf6, 0x0e, 0xbf, 0x78, 0x5d, 0x31, 0xbe, 0x7c, 0x5d, 0x31, 0xfe, 0xf1,
+	0xba, 0x62, 0x7c, 0xfe, 0xa6, 0x72, 0xe7, 0xcb, 0x37, 0x95, 0x3b, 0x7f, 0x7e, 0x53, 0xb9, 0xf3,
+	0xc3, 0xa7, 0x99, 0xe6, 0x13, 0xb4, 0xb7, 0x4d, 0x18, 0x43, 0xce, 0x26, 0x7e, 0x92, 0x6b, 0xc9,
+	0x2e, 0xec, 0x16, 0xc5, 0x6f, 0x72, 0xdf, 0xfc, 0xcf, 0x00, 0xa6, 0x11, 0xf3, 0xf7, 0x61, 0x14,
+	0x00, 0x00,
+}
+
+func (m *AccountRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RouteData) > 0 {
+		i -= len(m.RouteData)
+		copy(dAtA[i:], m.RouteData)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RouteData)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.DestinationType) > 0 {
+		i -= len(m.DestinationType)
+		copy(dAtA[i:], m.DestinationType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestinationType)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.UnwindOnly {
+		i--
+		if m.UnwindOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DenomRoutes) > 0 {
+		for iNdEx := len(m.DenomRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Batching.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountCleared) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountCleared) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountCleared) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowedDenomsConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedDenomsConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedDenomsConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentDenoms) > 0 {
+		for iNdEx := len(m.CurrentDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentDenoms[iNdEx])
+			copy(dAtA[i:], m.CurrentDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PreviousDenoms) > 0 {
+		for iNdEx := len(m.PreviousDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousDenoms[iNdEx])
+			copy(dAtA[i:], m.PreviousDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockedDenomsConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedDenomsConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedDenomsConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentDenoms) > 0 {
+		for iNdEx := len(m.CurrentDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentDenoms[iNdEx])
+			copy(dAtA[i:], m.CurrentDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.PreviousDenoms) > 0 {
+		for iNdEx := len(m.PreviousDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousDenoms[iNdEx])
+			copy(dAtA[i:], m.PreviousDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardRefunded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardRefunded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardRefunded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RefundPolicyConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RefundPolicyConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RefundPolicyConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentPolicy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentPolicy))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousPolicy != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousPolicy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TimeoutPolicyConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimeoutPolicyConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimeoutPolicyConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PreviousPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaxMemoLengthConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxMemoLengthConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxMemoLengthConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentMaxMemoLength != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentMaxMemoLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousMaxMemoLength != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousMaxMemoLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardDeferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardDeferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardDeferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Reason))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinForwardAmountConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinForwardAmountConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinForwardAmountConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentAmount.Size()
+		i -= size
+		if _, err := m.CurrentAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.PreviousAmount.Size()
+		i -= size
+		if _, err := m.PreviousAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecutionLimitsConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutionLimitsConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutionLimitsConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.PreviousLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeScheduleConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeScheduleConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeScheduleConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PreviousSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeRecipientConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRecipientConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRecipientConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentRecipient) > 0 {
+		i -= len(m.CurrentRecipient)
+		copy(dAtA[i:], m.CurrentRecipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentRecipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousRecipient) > 0 {
+		i -= len(m.PreviousRecipient)
+		copy(dAtA[i:], m.PreviousRecipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousRecipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelayerFeeConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelayerFeeConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelayerFeeConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.PreviousFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FallbackGracePeriodConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FallbackGracePeriodConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FallbackGracePeriodConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentGracePeriod != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CurrentGracePeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousGracePeriod != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PreviousGracePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ForwardSweptToFallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardSweptToFallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardSweptToFallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RejectRegistrations {
+		i--
+		if m.RejectRegistrations {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelResumed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelResumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CircuitBreakerDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreakerDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreakerDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GuardianConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GuardianConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GuardianConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentGuardian) > 0 {
+		i -= len(m.CurrentGuardian)
+		copy(dAtA[i:], m.CurrentGuardian)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentGuardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousGuardian) > 0 {
+		i -= len(m.PreviousGuardian)
+		copy(dAtA[i:], m.PreviousGuardian)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousGuardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CurrentLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PreviousLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Limit.Size()
+		i -= size
+		if _, err := m.Limit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Usage.Size()
+		i -= size
+		if _, err := m.Usage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositHeld) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositHeld) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositHeld) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EligibleAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EligibleAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ForwardAt != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ForwardAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetReached {
+		i--
+		if m.TargetReached {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelAllowedDenomsConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelAllowedDenomsConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelAllowedDenomsConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CurrentDenoms) > 0 {
+		for iNdEx := len(m.CurrentDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CurrentDenoms[iNdEx])
+			copy(dAtA[i:], m.CurrentDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.CurrentDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PreviousDenoms) > 0 {
+		for iNdEx := len(m.PreviousDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PreviousDenoms[iNdEx])
+			copy(dAtA[i:], m.PreviousDenoms[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UnwindOnlyConfigured) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnwindOnlyConfigured) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnwindOnlyConfigured) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentEnabled {
+		i--
+		if m.CurrentEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PreviousEnabled {
+		i--
+		if m.PreviousEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoucherRejected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoucherRejected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoucherRejected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AccountRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = m.Batching.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.DenomRoutes) > 0 {
+		for _, e := range m.DenomRoutes {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.UnwindOnly {
+		n += 2
+	}
+	l = len(m.DestinationType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RouteData)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountCleared) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *AllowedDenomsConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PreviousDenoms) > 0 {
+		for _, s := range m.PreviousDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CurrentDenoms) > 0 {
+		for _, s := range m.CurrentDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockedDenomsConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PreviousDenoms) > 0 {
+		for _, s := range m.PreviousDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CurrentDenoms) > 0 {
+		for _, s := range m.CurrentDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForwardRefunded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovEvents(uint64(m.Sequence))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Policy != 0 {
+		n += 1 + sovEvents(uint64(m.Policy))
+	}
+	return n
+}
+
+func (m *RefundPolicyConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousPolicy != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousPolicy))
+	}
+	if m.CurrentPolicy != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentPolicy))
+	}
+	return n
+}
+
+func (m *TimeoutPolicyConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.PreviousPolicy.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CurrentPolicy.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *MaxMemoLengthConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousMaxMemoLength != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousMaxMemoLength))
+	}
+	if m.CurrentMaxMemoLength != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentMaxMemoLength))
+	}
+	return n
+}
+
+func (m *ForwardDeferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Reason != 0 {
+		n += 1 + sovEvents(uint64(m.Reason))
+	}
+	return n
+}
+
+func (m *MinForwardAmountConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.PreviousAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CurrentAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ExecutionLimitsConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PreviousLimits.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CurrentLimits.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *FeeScheduleConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.PreviousSchedule.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CurrentSchedule.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *FeeRecipientConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CurrentRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *RelayerFeeConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.PreviousFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CurrentFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *FallbackGracePeriodConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousGracePeriod != 0 {
+		n += 1 + sovEvents(uint64(m.PreviousGracePeriod))
+	}
+	if m.CurrentGracePeriod != 0 {
+		n += 1 + sovEvents(uint64(m.CurrentGracePeriod))
+	}
+	return n
+}
+
+func (m *ForwardSweptToFallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RejectRegistrations {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChannelResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *CircuitBreakerEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *CircuitBreakerDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *GuardianConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousGuardian)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CurrentGuardian)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.PreviousLimit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.CurrentLimit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *RateLimitExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Limit.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *DepositHeld) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.EligibleAt != 0 {
+		n += 1 + sovEvents(uint64(m.EligibleAt))
+	}
+	return n
+}
+
+func (m *DepositReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ForwardAt != 0 {
+		n += 1 + sovEvents(uint64(m.ForwardAt))
+	}
+	return n
+}
+
+func (m *BatchReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TargetReached {
+		n += 2
+	}
+	return n
+}
+
+func (m *ChannelAllowedDenomsConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.PreviousDenoms) > 0 {
+		for _, s := range m.PreviousDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.CurrentDenoms) > 0 {
+		for _, s := range m.CurrentDenoms {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnwindOnlyConfigured) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousEnabled {
+		n += 2
+	}
+	if m.CurrentEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *VoucherRejected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, Hop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, Split{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batching", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRoutes = append(m.DenomRoutes, DenomRoute{})
+			if err := m.DenomRoutes[len(m.DenomRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnwindOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnwindOnly = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteData = append(m.RouteData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RouteData == nil {
+				m.RouteData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountCleared) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountCleared: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedDenomsConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedDenomsConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousDenoms = append(m.PreviousDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentDenoms = append(m.CurrentDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockedDenomsConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedDenomsConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedDenomsConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousDenoms = append(m.PreviousDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentDenoms = append(m.CurrentDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardRefunded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardRefunded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardRefunded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= RefundPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RefundPolicyConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RefundPolicyConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RefundPolicyConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPolicy", wireType)
+			}
+			m.PreviousPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousPolicy |= RefundPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPolicy", wireType)
+			}
+			m.CurrentPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPolicy |= RefundPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TimeoutPolicyConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimeoutPolicyConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimeoutPolicyConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxMemoLengthConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxMemoLengthConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxMemoLengthConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousMaxMemoLength", wireType)
+			}
+			m.PreviousMaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousMaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentMaxMemoLength", wireType)
+			}
+			m.CurrentMaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentMaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardDeferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardDeferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardDeferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			m.Reason = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reason |= DeferReason(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinForwardAmountConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinForwardAmountConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinForwardAmountConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecutionLimitsConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecutionLimitsConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecutionLimitsConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeScheduleConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeScheduleConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeScheduleConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRecipientConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRecipientConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRecipientConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RelayerFeeConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RelayerFeeConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RelayerFeeConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FallbackGracePeriodConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FallbackGracePeriodConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FallbackGracePeriodConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGracePeriod", wireType)
+			}
+			m.PreviousGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentGracePeriod", wireType)
+			}
+			m.CurrentGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardSweptToFallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardSweptToFallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardSweptToFallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectRegistrations", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectRegistrations = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelResumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelResumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelResumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CircuitBreakerDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreakerDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreakerDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GuardianConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GuardianConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GuardianConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitExceeded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitExceeded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositHeld) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositHeld: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositHeld: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EligibleAt", wireType)
+			}
+			m.EligibleAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EligibleAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositReleased) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositReleased: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositReleased: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardAt", wireType)
+			}
+			m.ForwardAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForwardAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
+func (m *BatchReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -469,10 +7955,10 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountRegistered: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -508,6 +7994,76 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetReached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetReached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelAllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelAllowedDenomsConfigured: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelAllowedDenomsConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
@@ -539,9 +8095,9 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 			}
 			m.Channel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -569,11 +8125,11 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.PreviousDenoms = append(m.PreviousDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDenoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -601,7 +8157,7 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fallback = string(dAtA[iNdEx:postIndex])
+			m.CurrentDenoms = append(m.CurrentDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -624,7 +8180,7 @@ func (m *AccountRegistered) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountCleared) Unmarshal(dAtA []byte) error {
+func (m *UnwindOnlyConfigured) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -647,17 +8203,17 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountCleared: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnwindOnlyConfigured: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountCleared: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnwindOnlyConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -667,29 +8223,17 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.PreviousEnabled = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEnabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -699,24 +8243,12 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.CurrentEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -738,7 +8270,7 @@ func (m *AccountCleared) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
+func (m *VoucherRejected) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -761,15 +8293,15 @@ func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedDenomsConfigured: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoucherRejected: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedDenomsConfigured: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoucherRejected: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -797,11 +8329,11 @@ func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousDenoms = append(m.PreviousDenoms, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -829,7 +8361,72 @@ func (m *AllowedDenomsConfigured) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CurrentDenoms = append(m.CurrentDenoms, string(dAtA[iNdEx:postIndex]))
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
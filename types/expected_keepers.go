@@ -24,8 +24,10 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
@@ -41,15 +43,29 @@ type AccountKeeper interface {
 
 type BankKeeper interface {
 	AppendSendRestriction(restriction banktypes.SendRestrictionFn)
+	BlockedAddr(addr sdk.AccAddress) bool
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 type ChannelKeeper interface {
+	GetAllChannelsWithPortPrefix(ctx sdk.Context, portPrefix string) []channeltypes.IdentifiedChannel
 	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
 	GetChannelClientState(ctx sdk.Context, portID string, channelID string) (string, exported.ClientState, error)
 }
 
 type TransferKeeper interface {
+	GetDenomTrace(ctx sdk.Context, denomTraceHash cmtbytes.HexBytes) (transfertypes.DenomTrace, bool)
 	Transfer(ctx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
 }
+
+type FeeKeeper interface {
+	IsFeeEnabled(ctx sdk.Context, portID, channelID string) bool
+	PayPacketFeeAsync(ctx context.Context, msg *feetypes.MsgPayPacketFeeAsync) (*feetypes.MsgPayPacketFeeAsyncResponse, error)
+}
+
+// CircuitBreaker defines the module-wide circuit breaker checked by the
+// signature verification decorator before signerless registrations.
+type CircuitBreaker interface {
+	IsCircuitBreakerEnabled(ctx context.Context) bool
+}
@@ -0,0 +1,74 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EmptyFeeSchedule returns a fee schedule that doesn't deduct any fees.
+func EmptyFeeSchedule() FeeSchedule {
+	return FeeSchedule{
+		Percentage: sdkmath.LegacyZeroDec(),
+		Flat:       sdkmath.ZeroInt(),
+	}
+}
+
+// Fee returns the fee deducted from a specific amount.
+func (s FeeSchedule) Fee(amount sdkmath.Int) sdkmath.Int {
+	return s.Percentage.MulInt(amount).TruncateInt().Add(s.Flat)
+}
+
+// ShareFee returns the fee deducted from a share of a forward that is split
+// into several transfers. The flat amount is only deducted from the first
+// share, so that it is charged once per forward.
+func (s FeeSchedule) ShareFee(share sdkmath.Int, first bool) sdkmath.Int {
+	if first {
+		return s.Fee(share)
+	}
+
+	return s.Percentage.MulInt(share).TruncateInt()
+}
+
+// IsZero returns true if the fee schedule doesn't deduct any fees.
+func (s FeeSchedule) IsZero() bool {
+	return s.Percentage.IsZero() && s.Flat.IsZero()
+}
+
+// ValidateFeeSchedule checks if a fee schedule is valid for a specified denom.
+func ValidateFeeSchedule(denom string, schedule FeeSchedule) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+
+	if schedule.Percentage.IsNil() || schedule.Percentage.IsNegative() || schedule.Percentage.GTE(sdkmath.LegacyOneDec()) {
+		return errors.New("fee percentage must be in the range [0, 1)")
+	}
+
+	if schedule.Flat.IsNil() || schedule.Flat.IsNegative() {
+		return errors.New("flat fee cannot be negative")
+	}
+
+	return nil
+}
@@ -22,16 +22,21 @@ package types
 
 import (
 	"errors"
+	"fmt"
 	"slices"
 	"strings"
 
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
 )
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		AllowedDenoms: []string{"*"},
+		AllowedDenoms:   []string{"*"},
+		RefundPolicy:    RefundPolicyHold,
+		MaxMemoLength:   DefaultMaxMemoLength,
+		ExecutionLimits: DefaultExecutionLimits(),
 	}
 }
 
@@ -40,20 +45,262 @@ func (gen *GenesisState) Validate() error {
 		return err
 	}
 
-	for channel := range gen.NumOfAccounts {
+	if err := ValidateBlockedDenoms(gen.BlockedDenoms); err != nil {
+		return err
+	}
+
+	channelDenoms := make(map[string]bool)
+	for _, entry := range gen.ChannelAllowedDenoms {
+		if !channeltypes.IsValidChannelID(entry.Channel) {
+			return fmt.Errorf("invalid channel of allowed denoms: %s", entry.Channel)
+		}
+
+		if len(entry.Denoms) == 0 {
+			return fmt.Errorf("allowed denoms of %s cannot be empty", entry.Channel)
+		}
+
+		if err := ValidateAllowedDenoms(entry.Denoms); err != nil {
+			return fmt.Errorf("invalid allowed denoms of %s: %w", entry.Channel, err)
+		}
+
+		if channelDenoms[entry.Channel] {
+			return fmt.Errorf("duplicate allowed denoms of %s", entry.Channel)
+		}
+		channelDenoms[entry.Channel] = true
+	}
+
+	if gen.RefundPolicy != RefundPolicyUnspecified {
+		if err := ValidateRefundPolicy(gen.RefundPolicy); err != nil {
+			return err
+		}
+	}
+
+	if err := ValidateMaxMemoLength(gen.MaxMemoLength); err != nil {
+		return err
+	}
+
+	for denom, rawAmount := range gen.MinForwardAmounts {
+		amount, ok := sdkmath.NewIntFromString(rawAmount)
+		if !ok {
+			return errors.New("invalid min forward amount")
+		}
+
+		if err := ValidateMinForwardAmount(denom, amount); err != nil {
+			return err
+		}
+	}
+
+	for _, count := range gen.NumOfDeferrals {
+		if !isValidStatsKey(count.Channel) {
+			return errors.New("invalid channel")
+		}
+
+		if _, ok := DeferReason_name[int32(count.Reason)]; !ok || count.Reason == DeferReasonUnspecified {
+			return errors.New("invalid defer reason")
+		}
+	}
+
+	retries := make(map[string]bool)
+	for _, retry := range gen.Retries {
+		if _, err := sdk.AccAddressFromBech32(retry.Address); err != nil {
+			return errors.New("invalid retry address")
+		}
+
+		if retry.Attempts == 0 || retry.Attempts > MaxRetryAttempts {
+			return fmt.Errorf("invalid number of retry attempts: %d", retry.Attempts)
+		}
+
+		if retries[retry.Address] {
+			return errors.New("duplicate retry address")
+		}
+		retries[retry.Address] = true
+	}
+
+	inFlightPackets := make(map[string]bool)
+	for _, packet := range gen.InFlightPackets {
+		if !channeltypes.IsValidChannelID(packet.Channel) {
+			return errors.New("invalid in-flight packet channel")
+		}
+
+		if packet.Sequence == 0 {
+			return errors.New("invalid in-flight packet sequence")
+		}
+
+		if _, err := sdk.AccAddressFromBech32(packet.Address); err != nil {
+			return errors.New("invalid in-flight packet address")
+		}
+
+		key := fmt.Sprintf("%s/%d", packet.Channel, packet.Sequence)
+		if inFlightPackets[key] {
+			return errors.New("duplicate in-flight packet")
+		}
+		inFlightPackets[key] = true
+	}
+
+	queued := make(map[string]bool)
+	for _, address := range gen.ForwardQueue {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errors.New("invalid queued forward address")
+		}
+
+		if queued[address] {
+			return errors.New("duplicate queued forward address")
+		}
+		queued[address] = true
+	}
+
+	for denom, schedule := range gen.FeeSchedules {
+		if err := ValidateFeeSchedule(denom, schedule); err != nil {
+			return err
+		}
+	}
+
+	if gen.FeeRecipient != "" {
+		if _, err := sdk.AccAddressFromBech32(gen.FeeRecipient); err != nil {
+			return errors.New("invalid fee recipient address")
+		}
+	}
+
+	for channel, fee := range gen.RelayerFees {
+		if err := ValidateRelayerFee(channel, fee); err != nil {
+			return err
+		}
+	}
+
+	blocked := make(map[string]bool)
+	for _, forward := range gen.BlockedForwards {
+		if _, err := sdk.AccAddressFromBech32(forward.Address); err != nil {
+			return errors.New("invalid blocked forward address")
+		}
+
+		if !channeltypes.IsValidChannelID(forward.Channel) {
+			return errors.New("invalid channel")
+		}
+
+		if blocked[forward.Address] {
+			return errors.New("duplicate blocked forward address")
+		}
+		blocked[forward.Address] = true
+	}
+
+	paused := make(map[string]bool)
+	for _, channel := range gen.PausedChannels {
+		if !channeltypes.IsValidChannelID(channel.Channel) {
+			return errors.New("invalid channel")
+		}
+
+		if paused[channel.Channel] {
+			return errors.New("duplicate paused channel")
+		}
+		paused[channel.Channel] = true
+	}
+
+	if gen.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(gen.Guardian); err != nil {
+			return errors.New("invalid guardian address")
+		}
+	}
+
+	limits := make(map[string]bool)
+	for _, limit := range gen.RateLimits {
+		if err := ValidateRateLimit(limit); err != nil {
+			return err
+		}
+
+		key := limit.Channel + "/" + limit.Denom
+		if limits[key] {
+			return errors.New("duplicate rate limit")
+		}
+		limits[key] = true
+	}
+
+	for _, usage := range gen.RateLimitUsages {
+		if !limits[usage.Channel+"/"+usage.Denom] {
+			return errors.New("rate limit usage without rate limit")
+		}
+
+		if usage.Amount.IsNil() || usage.Amount.IsNegative() {
+			return errors.New("invalid rate limit usage amount")
+		}
+
+		for i, bucket := range usage.Buckets {
+			if bucket.Amount.IsNil() || bucket.Amount.IsNegative() || bucket.End < bucket.Start {
+				return errors.New("invalid rate limit usage bucket")
+			}
+
+			if i > 0 && bucket.Start <= usage.Buckets[i-1].End {
+				return errors.New("rate limit usage buckets must be ordered")
+			}
+		}
+	}
+
+	held := make(map[string]bool)
+	for _, deposit := range gen.HeldDeposits {
+		if _, err := sdk.AccAddressFromBech32(deposit.Address); err != nil {
+			return errors.New("invalid held deposit address")
+		}
+
+		if err := deposit.Amount.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%d", deposit.Address, deposit.EligibleAt)
+		if held[key] {
+			return errors.New("duplicate held deposit")
+		}
+		held[key] = true
+	}
+
+	batches := make(map[string]bool)
+	for _, batch := range gen.Batches {
+		if _, err := sdk.AccAddressFromBech32(batch.Address); err != nil {
+			return errors.New("invalid batch address")
+		}
+
+		if batch.ForwardAt < batch.StartedAt {
+			return errors.New("batch must be forwarded after it started")
+		}
+
+		if batches[batch.Address] {
+			return errors.New("duplicate batch")
+		}
+		batches[batch.Address] = true
+	}
+
+	for channel, total := range gen.TotalFees {
 		if !channeltypes.IsValidChannelID(channel) {
 			return errors.New("invalid channel")
 		}
+
+		if _, err := sdk.ParseCoinsNormalized(total); err != nil {
+			return errors.New("invalid coins")
+		}
 	}
 
-	for channel := range gen.NumOfForwards {
+	for channel, policy := range gen.TimeoutPolicies {
 		if !channeltypes.IsValidChannelID(channel) {
 			return errors.New("invalid channel")
 		}
+
+		if policy.IsZero() {
+			return errors.New("timeout policy must set a timeout timestamp or height")
+		}
+	}
+
+	for channel := range gen.NumOfAccounts {
+		if !isValidStatsKey(channel) {
+			return errors.New("invalid channel")
+		}
+	}
+
+	for channel := range gen.NumOfForwards {
+		if !isValidStatsKey(channel) {
+			return errors.New("invalid channel")
+		}
 	}
 
 	for channel, total := range gen.TotalForwarded {
-		if !channeltypes.IsValidChannelID(channel) {
+		if !isValidStatsKey(channel) {
 			return errors.New("invalid channel")
 		}
 
@@ -67,7 +314,7 @@ func (gen *GenesisState) Validate() error {
 
 // ValidateAllowedDenoms checks if a specified denom list is valid.
 // It ensures that if a wildcard "*" is present, it must be the only item.
-// It also ensures non-empty entries.
+// It also ensures non-empty entries, and valid prefix and trace patterns.
 func ValidateAllowedDenoms(denoms []string) error {
 	if slices.Contains(denoms, "*") && len(denoms) > 1 {
 		return errors.New("wildcard can only be present by itself")
@@ -77,7 +324,44 @@ func ValidateAllowedDenoms(denoms []string) error {
 		if strings.TrimSpace(denom) == "" {
 			return errors.New("cannot allow empty denom")
 		}
+
+		if IsDenomPattern(denom) {
+			if err := ValidateDenomPattern(denom); err != nil {
+				return err
+			}
+		} else if denom != "*" && strings.Contains(denom, "*") {
+			return fmt.Errorf("wildcard can only be present by itself or after a prefix: %s", denom)
+		}
 	}
 
 	return nil
 }
+
+// ValidateBlockedDenoms checks if a specified list of blocked denoms is
+// valid. It ensures that the wildcard isn't blocked, and that there are no
+// empty or duplicate entries.
+func ValidateBlockedDenoms(denoms []string) error {
+	seen := make(map[string]bool)
+	for _, denom := range denoms {
+		if denom == "*" {
+			return errors.New("cannot block wildcard")
+		}
+
+		if strings.TrimSpace(denom) == "" {
+			return errors.New("cannot block empty denom")
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate blocked denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// isValidStatsKey checks if a key of the statistics is valid. Statistics are
+// tracked per channel, or per destination type for non IBC destinations.
+func isValidStatsKey(key string) bool {
+	return channeltypes.IsValidChannelID(key) || ValidateDestinationType(key) == nil
+}
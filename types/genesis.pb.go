@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -23,10 +24,35 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	AllowedDenoms  []string          `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
-	NumOfAccounts  map[string]uint64 `protobuf:"bytes,2,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	NumOfForwards  map[string]uint64 `protobuf:"bytes,3,rep,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	TotalForwarded map[string]string `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3" json:"total_forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	AllowedDenoms        []string                 `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	NumOfAccounts        map[string]uint64        `protobuf:"bytes,2,rep,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	NumOfForwards        map[string]uint64        `protobuf:"bytes,3,rep,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TotalForwarded       map[string]string        `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3" json:"total_forwarded,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RefundPolicy         RefundPolicy             `protobuf:"varint,5,opt,name=refund_policy,json=refundPolicy,proto3,enum=noble.forwarding.v1.RefundPolicy" json:"refund_policy,omitempty"`
+	TimeoutPolicies      map[string]TimeoutPolicy `protobuf:"bytes,6,rep,name=timeout_policies,json=timeoutPolicies,proto3" json:"timeout_policies" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MaxMemoLength        uint64                   `protobuf:"varint,7,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty"`
+	MinForwardAmounts    map[string]string        `protobuf:"bytes,8,rep,name=min_forward_amounts,json=minForwardAmounts,proto3" json:"min_forward_amounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumOfDeferrals       []DeferralCount          `protobuf:"bytes,9,rep,name=num_of_deferrals,json=numOfDeferrals,proto3" json:"num_of_deferrals"`
+	ExecutionLimits      ExecutionLimits          `protobuf:"bytes,10,opt,name=execution_limits,json=executionLimits,proto3" json:"execution_limits"`
+	ForwardQueue         []string                 `protobuf:"bytes,11,rep,name=forward_queue,json=forwardQueue,proto3" json:"forward_queue,omitempty"`
+	FeeSchedules         map[string]FeeSchedule   `protobuf:"bytes,12,rep,name=fee_schedules,json=feeSchedules,proto3" json:"fee_schedules" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FeeRecipient         string                   `protobuf:"bytes,13,opt,name=fee_recipient,json=feeRecipient,proto3" json:"fee_recipient,omitempty"`
+	TotalFees            map[string]string        `protobuf:"bytes,14,rep,name=total_fees,json=totalFees,proto3" json:"total_fees,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RelayerFees          map[string]RelayerFee    `protobuf:"bytes,15,rep,name=relayer_fees,json=relayerFees,proto3" json:"relayer_fees" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FallbackGracePeriod  uint64                   `protobuf:"varint,16,opt,name=fallback_grace_period,json=fallbackGracePeriod,proto3" json:"fallback_grace_period,omitempty"`
+	BlockedForwards      []BlockedForward         `protobuf:"bytes,17,rep,name=blocked_forwards,json=blockedForwards,proto3" json:"blocked_forwards"`
+	PausedChannels       []PausedChannel          `protobuf:"bytes,18,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels"`
+	CircuitBreaker       bool                     `protobuf:"varint,19,opt,name=circuit_breaker,json=circuitBreaker,proto3" json:"circuit_breaker,omitempty"`
+	Guardian             string                   `protobuf:"bytes,20,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	RateLimits           []RateLimit              `protobuf:"bytes,21,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	RateLimitUsages      []RateLimitUsage         `protobuf:"bytes,22,rep,name=rate_limit_usages,json=rateLimitUsages,proto3" json:"rate_limit_usages"`
+	HeldDeposits         []HeldDeposit            `protobuf:"bytes,23,rep,name=held_deposits,json=heldDeposits,proto3" json:"held_deposits"`
+	Batches              []Batch                  `protobuf:"bytes,24,rep,name=batches,proto3" json:"batches"`
+	BlockedDenoms        []string                 `protobuf:"bytes,25,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty"`
+	ChannelAllowedDenoms []ChannelAllowedDenoms   `protobuf:"bytes,26,rep,name=channel_allowed_denoms,json=channelAllowedDenoms,proto3" json:"channel_allowed_denoms"`
+	UnwindOnly           bool                     `protobuf:"varint,27,opt,name=unwind_only,json=unwindOnly,proto3" json:"unwind_only,omitempty"`
+	Retries              []ForwardRetry           `protobuf:"bytes,28,rep,name=retries,proto3" json:"retries"`
+	InFlightPackets      []InFlightPacket         `protobuf:"bytes,29,rep,name=in_flight_packets,json=inFlightPackets,proto3" json:"in_flight_packets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -90,39 +116,265 @@ func (m *GenesisState) GetTotalForwarded() map[string]string {
 	return nil
 }
 
+func (m *GenesisState) GetRefundPolicy() RefundPolicy {
+	if m != nil {
+		return m.RefundPolicy
+	}
+	return RefundPolicyUnspecified
+}
+
+func (m *GenesisState) GetTimeoutPolicies() map[string]TimeoutPolicy {
+	if m != nil {
+		return m.TimeoutPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMaxMemoLength() uint64 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMinForwardAmounts() map[string]string {
+	if m != nil {
+		return m.MinForwardAmounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNumOfDeferrals() []DeferralCount {
+	if m != nil {
+		return m.NumOfDeferrals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExecutionLimits() ExecutionLimits {
+	if m != nil {
+		return m.ExecutionLimits
+	}
+	return ExecutionLimits{}
+}
+
+func (m *GenesisState) GetForwardQueue() []string {
+	if m != nil {
+		return m.ForwardQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeSchedules() map[string]FeeSchedule {
+	if m != nil {
+		return m.FeeSchedules
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeRecipient() string {
+	if m != nil {
+		return m.FeeRecipient
+	}
+	return ""
+}
+
+func (m *GenesisState) GetTotalFees() map[string]string {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRelayerFees() map[string]RelayerFee {
+	if m != nil {
+		return m.RelayerFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFallbackGracePeriod() uint64 {
+	if m != nil {
+		return m.FallbackGracePeriod
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBlockedForwards() []BlockedForward {
+	if m != nil {
+		return m.BlockedForwards
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedChannels() []PausedChannel {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreaker() bool {
+	if m != nil {
+		return m.CircuitBreaker
+	}
+	return false
+}
+
+func (m *GenesisState) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRateLimitUsages() []RateLimitUsage {
+	if m != nil {
+		return m.RateLimitUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHeldDeposits() []HeldDeposit {
+	if m != nil {
+		return m.HeldDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatches() []Batch {
+	if m != nil {
+		return m.Batches
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedDenoms() []string {
+	if m != nil {
+		return m.BlockedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelAllowedDenoms() []ChannelAllowedDenoms {
+	if m != nil {
+		return m.ChannelAllowedDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnwindOnly() bool {
+	if m != nil {
+		return m.UnwindOnly
+	}
+	return false
+}
+
+func (m *GenesisState) GetRetries() []ForwardRetry {
+	if m != nil {
+		return m.Retries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetInFlightPackets() []InFlightPacket {
+	if m != nil {
+		return m.InFlightPackets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "noble.forwarding.v1.GenesisState")
+	proto.RegisterMapType((map[string]FeeSchedule)(nil), "noble.forwarding.v1.GenesisState.FeeSchedulesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "noble.forwarding.v1.GenesisState.MinForwardAmountsEntry")
 	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.GenesisState.NumOfAccountsEntry")
 	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.GenesisState.NumOfForwardsEntry")
+	proto.RegisterMapType((map[string]RelayerFee)(nil), "noble.forwarding.v1.GenesisState.RelayerFeesEntry")
+	proto.RegisterMapType((map[string]TimeoutPolicy)(nil), "noble.forwarding.v1.GenesisState.TimeoutPoliciesEntry")
+	proto.RegisterMapType((map[string]string)(nil), "noble.forwarding.v1.GenesisState.TotalFeesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "noble.forwarding.v1.GenesisState.TotalForwardedEntry")
 }
 
 func init() { proto.RegisterFile("noble/forwarding/v1/genesis.proto", fileDescriptor_672c6f172b8b6a10) }
 
 var fileDescriptor_672c6f172b8b6a10 = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0xcb, 0x4f, 0xca,
-	0x49, 0xd5, 0x4f, 0xcb, 0x2f, 0x2a, 0x4f, 0x2c, 0x4a, 0xc9, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x06,
-	0x2b, 0xd1, 0x43, 0x28, 0xd1, 0x2b, 0x33, 0x54, 0x9a, 0xc1, 0xc2, 0xc5, 0xe3, 0x0e, 0x51, 0x16,
-	0x5c, 0x92, 0x58, 0x92, 0x2a, 0xa4, 0xca, 0xc5, 0x97, 0x98, 0x93, 0x93, 0x5f, 0x9e, 0x9a, 0x12,
-	0x9f, 0x92, 0x9a, 0x97, 0x9f, 0x5b, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x19, 0xc4, 0x0b, 0x15,
-	0x75, 0x01, 0x0b, 0x0a, 0xc5, 0x70, 0xf1, 0xe7, 0x95, 0xe6, 0xc6, 0xe7, 0xa7, 0xc5, 0x27, 0x26,
-	0x27, 0xe7, 0x97, 0xe6, 0x95, 0x14, 0x4b, 0x30, 0x29, 0x30, 0x6b, 0x70, 0x1b, 0x99, 0xe8, 0x61,
-	0xb1, 0x46, 0x0f, 0xd9, 0x0a, 0x3d, 0xbf, 0xd2, 0x5c, 0xff, 0x34, 0x47, 0xa8, 0x36, 0xd7, 0xbc,
-	0x92, 0xa2, 0xca, 0x20, 0xde, 0x3c, 0x64, 0x31, 0x24, 0xd3, 0xa1, 0xc6, 0x14, 0x4b, 0x30, 0x93,
-	0x64, 0xba, 0x1b, 0x54, 0x1b, 0xb2, 0xe9, 0x30, 0x31, 0xa1, 0x38, 0x2e, 0xfe, 0x92, 0xfc, 0x92,
-	0xc4, 0x1c, 0x98, 0xe1, 0xa9, 0x29, 0x12, 0x2c, 0x60, 0xd3, 0x4d, 0x09, 0x9b, 0x1e, 0x02, 0xd2,
-	0xe8, 0x06, 0xd3, 0x07, 0x31, 0x9e, 0xaf, 0x04, 0x45, 0x50, 0xca, 0x81, 0x4b, 0x08, 0xd3, 0x8b,
-	0x42, 0x02, 0x5c, 0xcc, 0xd9, 0xa9, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x20, 0xa6,
-	0x90, 0x08, 0x17, 0x6b, 0x59, 0x62, 0x4e, 0x69, 0xaa, 0x04, 0x93, 0x02, 0xa3, 0x06, 0x4b, 0x10,
-	0x84, 0x63, 0xc5, 0x64, 0xc1, 0x08, 0x37, 0x01, 0xc5, 0x1b, 0x24, 0x99, 0xe0, 0xc8, 0x25, 0x8c,
-	0xc5, 0xa9, 0x84, 0x8c, 0xe0, 0x44, 0x32, 0xc2, 0xc9, 0xf5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0xc1, 0x21, 0xa6, 0x9b, 0x58, 0x5c, 0x9c, 0x5a, 0x52, 0x8c, 0x92, 0xfc, 0x8c, 0xf4, 0x4b, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xa9, 0xcf, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x3c, 0xd4,
-	0x7d, 0x19, 0xa2, 0x02, 0x00, 0x00,
+	// 1078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x72, 0xdb, 0x36,
+	0x14, 0xb5, 0x62, 0x27, 0xb6, 0xa1, 0xa7, 0xe1, 0x47, 0x51, 0xb5, 0x95, 0x95, 0xa4, 0x0f, 0x75,
+	0x3a, 0x95, 0x1a, 0xa5, 0xc9, 0x78, 0x32, 0x5d, 0xd4, 0x8e, 0xad, 0xb4, 0xd3, 0xa4, 0x76, 0x98,
+	0x64, 0xd3, 0x76, 0xc2, 0x81, 0xc8, 0x2b, 0x8a, 0x63, 0x12, 0x54, 0x01, 0xd0, 0xb6, 0xfe, 0xa2,
+	0x9f, 0xd0, 0xcf, 0xc9, 0x32, 0xcb, 0xae, 0x3a, 0x1d, 0xfb, 0x47, 0x3a, 0x04, 0x01, 0x8b, 0x74,
+	0x18, 0x3b, 0xde, 0x91, 0x07, 0xe7, 0x9e, 0x7b, 0x88, 0x7b, 0x81, 0x4b, 0x74, 0x9b, 0x45, 0xc3,
+	0x00, 0x7a, 0xa3, 0x88, 0x1f, 0x53, 0xee, 0xfa, 0xcc, 0xeb, 0x1d, 0xdd, 0xeb, 0x79, 0xc0, 0x40,
+	0xf8, 0xa2, 0x3b, 0xe1, 0x91, 0x8c, 0xf0, 0xaa, 0xa2, 0x74, 0x67, 0x94, 0xee, 0xd1, 0xbd, 0xe6,
+	0x9a, 0x17, 0x79, 0x91, 0x5a, 0xef, 0x25, 0x4f, 0x29, 0xb5, 0xb9, 0x59, 0xa4, 0x26, 0x24, 0x95,
+	0x90, 0x12, 0xee, 0xfc, 0xbd, 0x8e, 0x2a, 0x4f, 0x52, 0xf5, 0x17, 0x09, 0x8c, 0xbf, 0x40, 0x35,
+	0x1a, 0x04, 0xd1, 0x31, 0xb8, 0xb6, 0x0b, 0x2c, 0x0a, 0x05, 0x29, 0xb5, 0xe7, 0x3b, 0xcb, 0x56,
+	0x55, 0xa3, 0xbb, 0x0a, 0xc4, 0x7f, 0xa0, 0x3a, 0x8b, 0x43, 0x3b, 0x1a, 0xd9, 0xd4, 0x71, 0xa2,
+	0x98, 0x49, 0x41, 0x6e, 0xb4, 0xe7, 0x3b, 0xe5, 0xfe, 0xf7, 0xdd, 0x02, 0x77, 0xdd, 0x6c, 0x8a,
+	0xee, 0xaf, 0x71, 0xb8, 0x3f, 0xda, 0xd6, 0x61, 0x7b, 0x4c, 0xf2, 0xa9, 0x55, 0x65, 0x59, 0x2c,
+	0xa3, 0xae, 0x65, 0x04, 0x99, 0xbf, 0x96, 0xfa, 0x40, 0x87, 0x65, 0xd5, 0x0d, 0x86, 0x5f, 0xa3,
+	0xba, 0x8c, 0x24, 0x0d, 0x8c, 0x38, 0xb8, 0x64, 0x41, 0xa9, 0x3f, 0xb8, 0x5a, 0xfd, 0x65, 0x12,
+	0x38, 0x30, 0x71, 0xa9, 0x7c, 0x4d, 0xe6, 0x40, 0x3c, 0x40, 0x55, 0x0e, 0xa3, 0x98, 0xb9, 0xf6,
+	0x24, 0x0a, 0x7c, 0x67, 0x4a, 0x6e, 0xb6, 0x4b, 0x9d, 0x5a, 0xff, 0x76, 0xa1, 0xba, 0xa5, 0x98,
+	0x07, 0x8a, 0x68, 0x55, 0x78, 0xe6, 0x0d, 0x7b, 0xa8, 0x21, 0xfd, 0x10, 0xa2, 0x58, 0xa6, 0x42,
+	0x3e, 0x08, 0x72, 0x4b, 0x19, 0x7d, 0xf8, 0x01, 0x46, 0xd3, 0xc8, 0x03, 0x1d, 0xa8, 0x9c, 0xee,
+	0x2c, 0xbc, 0xf9, 0x77, 0x73, 0xce, 0xaa, 0xcb, 0xfc, 0x1a, 0xfe, 0x12, 0xd5, 0x43, 0x7a, 0x62,
+	0x87, 0x10, 0x46, 0x76, 0x00, 0xcc, 0x93, 0x63, 0xb2, 0xd8, 0x2e, 0x75, 0x16, 0xac, 0x6a, 0x48,
+	0x4f, 0x9e, 0x41, 0x18, 0x3d, 0x55, 0x20, 0x1e, 0xa3, 0xd5, 0xd0, 0x67, 0x66, 0xdb, 0x6c, 0x1a,
+	0xa6, 0x85, 0x5f, 0x52, 0x9e, 0xb6, 0xae, 0xf6, 0xf4, 0xcc, 0x67, 0x7a, 0x97, 0xb6, 0xc3, 0x4c,
+	0xf1, 0x57, 0xc2, 0x8b, 0x38, 0xb6, 0x50, 0x43, 0x37, 0x80, 0x0b, 0x23, 0xe0, 0x9c, 0x06, 0x82,
+	0x2c, 0xab, 0x34, 0x77, 0x0a, 0xd3, 0xec, 0x6a, 0xd6, 0xe3, 0x24, 0x5c, 0x7f, 0x66, 0x4d, 0x55,
+	0xdd, 0xac, 0x08, 0xfc, 0x0a, 0x35, 0xe0, 0x04, 0x9c, 0x58, 0xfa, 0x11, 0xb3, 0x03, 0x3f, 0xf4,
+	0xa5, 0x20, 0xa8, 0x5d, 0xea, 0x94, 0xfb, 0x9f, 0x17, 0x6a, 0xee, 0x19, 0xf2, 0x53, 0xc5, 0x35,
+	0x9b, 0x07, 0x79, 0x18, 0xdf, 0x45, 0x55, 0xb3, 0x21, 0x7f, 0xc6, 0x10, 0x03, 0x29, 0xab, 0xf3,
+	0x52, 0xd1, 0xe0, 0xf3, 0x04, 0xc3, 0xaf, 0x51, 0x75, 0x04, 0x60, 0x0b, 0x67, 0x0c, 0x6e, 0x1c,
+	0x80, 0x20, 0x15, 0xf5, 0x31, 0xf7, 0xaf, 0xde, 0xb3, 0x01, 0xc0, 0x0b, 0x13, 0x95, 0x2d, 0x62,
+	0x65, 0x94, 0x59, 0x50, 0x26, 0x00, 0x6c, 0x0e, 0x8e, 0x3f, 0xf1, 0x81, 0x49, 0x52, 0x6d, 0x97,
+	0x94, 0x09, 0x00, 0xcb, 0x60, 0x78, 0x1f, 0x21, 0xdd, 0xf7, 0x00, 0x82, 0xd4, 0x94, 0x83, 0xef,
+	0x3e, 0xb4, 0xe5, 0x41, 0xa7, 0xb7, 0x96, 0xa5, 0x79, 0xc7, 0xbf, 0xa3, 0x0a, 0x87, 0x80, 0x4e,
+	0x81, 0xa7, 0x92, 0x75, 0x25, 0xd9, 0xbf, 0x5a, 0xd2, 0x4a, 0xa3, 0x06, 0x90, 0xff, 0xa6, 0x32,
+	0x9f, 0xe1, 0xb8, 0x8f, 0xd6, 0x47, 0x34, 0x08, 0x86, 0xd4, 0x39, 0xb4, 0x3d, 0x4e, 0x1d, 0xb0,
+	0x27, 0xc0, 0xfd, 0xc8, 0x25, 0x0d, 0xd5, 0x9a, 0xab, 0x66, 0xf1, 0x49, 0xb2, 0x76, 0xa0, 0x96,
+	0xf0, 0x4b, 0xd4, 0x18, 0x06, 0x91, 0x73, 0x08, 0xee, 0xec, 0xe2, 0x58, 0x51, 0xa6, 0xee, 0x16,
+	0x9a, 0xda, 0x49, 0xc9, 0xba, 0xf9, 0x4c, 0x85, 0x87, 0x39, 0x54, 0xe0, 0xe7, 0xa8, 0x3e, 0xa1,
+	0xb1, 0x00, 0xd7, 0x76, 0xc6, 0x94, 0x31, 0x08, 0x04, 0xc1, 0x97, 0xf4, 0xe2, 0x81, 0xe2, 0x3e,
+	0x4e, 0xa9, 0xa6, 0x17, 0x27, 0x59, 0x50, 0xe0, 0xaf, 0x50, 0xdd, 0xf1, 0xb9, 0x13, 0xfb, 0xd2,
+	0x1e, 0x72, 0xa0, 0x87, 0xc0, 0xc9, 0x6a, 0xbb, 0xd4, 0x59, 0xb2, 0x6a, 0x1a, 0xde, 0x49, 0x51,
+	0xdc, 0x44, 0x4b, 0x5e, 0x9c, 0x68, 0x53, 0x46, 0xd6, 0x54, 0x4d, 0xcf, 0xdf, 0xf1, 0x1e, 0x2a,
+	0x73, 0x2a, 0xc1, 0xf4, 0xf2, 0xba, 0xf2, 0xd4, 0x2a, 0xbe, 0x65, 0xa8, 0x04, 0xd5, 0xaf, 0xda,
+	0x0f, 0xe2, 0x06, 0x48, 0xce, 0xc5, 0xca, 0x4c, 0xc6, 0x8e, 0x05, 0xf5, 0x40, 0x90, 0x8d, 0x4b,
+	0x76, 0xed, 0x5c, 0xec, 0x55, 0xc2, 0x35, 0xbb, 0xc6, 0x73, 0xa8, 0xc0, 0xbf, 0xa0, 0xea, 0x18,
+	0x82, 0x64, 0x8a, 0x4c, 0x22, 0x91, 0xf8, 0xfb, 0x48, 0x49, 0xb6, 0x0b, 0x25, 0x7f, 0x82, 0xc0,
+	0xdd, 0x4d, 0x89, 0xa6, 0xbf, 0xc7, 0x33, 0x48, 0xe0, 0x47, 0x68, 0x71, 0x48, 0xa5, 0x33, 0x06,
+	0x41, 0x88, 0x92, 0x69, 0x16, 0xd7, 0x33, 0xe1, 0x68, 0x01, 0x13, 0x90, 0x4c, 0x34, 0xd3, 0x14,
+	0x7a, 0xa2, 0x7d, 0x9c, 0x4e, 0x34, 0x8d, 0xea, 0x89, 0x06, 0x68, 0x43, 0x97, 0xd7, 0xbe, 0x30,
+	0x00, 0x9b, 0x2a, 0xe3, 0xd7, 0x85, 0x19, 0x75, 0x45, 0xb7, 0xb3, 0xc3, 0x51, 0x1b, 0x58, 0x73,
+	0x0a, 0xd6, 0xf0, 0x26, 0x2a, 0xc7, 0xec, 0xd8, 0x67, 0xae, 0x1d, 0xb1, 0x60, 0x4a, 0x3e, 0x51,
+	0x55, 0x47, 0x29, 0xb4, 0xcf, 0x82, 0x29, 0xde, 0x46, 0x8b, 0x1c, 0x24, 0x4f, 0x2e, 0xfb, 0x4f,
+	0x55, 0xe2, 0xe2, 0xb9, 0xa1, 0xbb, 0xd3, 0x82, 0xd9, 0xf1, 0x31, 0x71, 0x49, 0x45, 0x93, 0x6b,
+	0x3a, 0xf0, 0xbd, 0xb1, 0xb4, 0x27, 0xd4, 0x39, 0x04, 0x29, 0xc8, 0x67, 0x97, 0x54, 0xf4, 0x67,
+	0x36, 0x50, 0xe4, 0x03, 0xc5, 0x35, 0x15, 0xf5, 0x73, 0xa8, 0x68, 0xfe, 0x88, 0xf0, 0xbb, 0xa3,
+	0x1b, 0x37, 0xd0, 0xfc, 0x21, 0x4c, 0x49, 0x49, 0x35, 0x67, 0xf2, 0x88, 0xd7, 0xd0, 0xcd, 0x23,
+	0x1a, 0xc4, 0x40, 0x6e, 0xa8, 0x93, 0x9a, 0xbe, 0x3c, 0xba, 0xb1, 0x55, 0x3a, 0x57, 0xc8, 0x8d,
+	0xe7, 0x6b, 0x29, 0x6c, 0xa3, 0xd5, 0x82, 0x11, 0x7c, 0x95, 0xc4, 0x72, 0x56, 0x62, 0x84, 0xd6,
+	0x8a, 0x86, 0x63, 0x81, 0xc6, 0x56, 0x56, 0xe3, 0x7d, 0xc7, 0x3d, 0xab, 0x35, 0xcd, 0xe6, 0xd9,
+	0x45, 0x1b, 0xc5, 0x03, 0xef, 0x5a, 0x6e, 0x29, 0x5a, 0x79, 0x67, 0x04, 0x14, 0x08, 0x3c, 0xcc,
+	0x5b, 0x2d, 0x3e, 0x65, 0x19, 0xa1, 0x6c, 0x8a, 0x1f, 0x50, 0x2d, 0x7f, 0xc7, 0x5f, 0xcb, 0xa0,
+	0x8d, 0x1a, 0x17, 0xaf, 0xf3, 0x82, 0xf8, 0x07, 0x79, 0x7f, 0x9b, 0xef, 0xf9, 0x17, 0x32, 0x3a,
+	0x99, 0x04, 0x3b, 0x7b, 0x6f, 0x4e, 0x5b, 0xa5, 0xb7, 0xa7, 0xad, 0xd2, 0x7f, 0xa7, 0xad, 0xd2,
+	0x5f, 0x67, 0xad, 0xb9, 0xb7, 0x67, 0xad, 0xb9, 0x7f, 0xce, 0x5a, 0x73, 0xbf, 0x7d, 0xe3, 0xf9,
+	0x72, 0x1c, 0x0f, 0xbb, 0x4e, 0x14, 0xf6, 0x94, 0xde, 0xb7, 0x54, 0x08, 0x90, 0x22, 0xf7, 0xbf,
+	0xdb, 0xef, 0xc9, 0xe9, 0x04, 0xc4, 0xf0, 0x96, 0xfa, 0xe1, 0xbd, 0xff, 0xff, 0x00, 0xab, 0x13,
+	0x54, 0x60, 0x61, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -145,9 +397,228 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.TotalForwarded) > 0 {
-		for k := range m.TotalForwarded {
-			v := m.TotalForwarded[k]
+	if len(m.InFlightPackets) > 0 {
+		for iNdEx := len(m.InFlightPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InFlightPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xea
+		}
+	}
+	if len(m.Retries) > 0 {
+		for iNdEx := len(m.Retries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xe2
+		}
+	}
+	if m.UnwindOnly {
+		i--
+		if m.UnwindOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.ChannelAllowedDenoms) > 0 {
+		for iNdEx := len(m.ChannelAllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelAllowedDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for iNdEx := len(m.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.HeldDeposits) > 0 {
+		for iNdEx := len(m.HeldDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for iNdEx := len(m.RateLimitUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimitUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.CircuitBreaker {
+		i--
+		if m.CircuitBreaker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.BlockedForwards) > 0 {
+		for iNdEx := len(m.BlockedForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if m.FallbackGracePeriod != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FallbackGracePeriod))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.RelayerFees) > 0 {
+		for k := range m.RelayerFees {
+			v := m.RelayerFees[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.TotalFees) > 0 {
+		for k := range m.TotalFees {
+			v := m.TotalFees[k]
 			baseI := i
 			i -= len(v)
 			copy(dAtA[i:], v)
@@ -161,16 +632,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x72
 		}
 	}
-	if len(m.NumOfForwards) > 0 {
-		for k := range m.NumOfForwards {
-			v := m.NumOfForwards[k]
+	if len(m.FeeRecipient) > 0 {
+		i -= len(m.FeeRecipient)
+		copy(dAtA[i:], m.FeeRecipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.FeeRecipient)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.FeeSchedules) > 0 {
+		for k := range m.FeeSchedules {
+			v := m.FeeSchedules[k]
 			baseI := i
-			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
@@ -178,16 +663,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x62
 		}
 	}
-	if len(m.NumOfAccounts) > 0 {
-		for k := range m.NumOfAccounts {
-			v := m.NumOfAccounts[k]
+	if len(m.ForwardQueue) > 0 {
+		for iNdEx := len(m.ForwardQueue) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ForwardQueue[iNdEx])
+			copy(dAtA[i:], m.ForwardQueue[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ForwardQueue[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	{
+		size, err := m.ExecutionLimits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.NumOfDeferrals) > 0 {
+		for iNdEx := len(m.NumOfDeferrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NumOfDeferrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MinForwardAmounts) > 0 {
+		for k := range m.MinForwardAmounts {
+			v := m.MinForwardAmounts[k]
 			baseI := i
-			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 			i -= len(k)
 			copy(dAtA[i:], k)
 			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
@@ -195,28 +715,115 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0xa
 			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.AllowedDenoms) > 0 {
-		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedDenoms[iNdEx])
-			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
-			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.TimeoutPolicies) > 0 {
+		for k := range m.TimeoutPolicies {
+			v := m.TimeoutPolicies[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
 			i--
 			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if m.RefundPolicy != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RefundPolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TotalForwarded) > 0 {
+		for k := range m.TotalForwarded {
+			v := m.TotalForwarded[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NumOfForwards) > 0 {
+		for k := range m.NumOfForwards {
+			v := m.NumOfForwards[k]
+			baseI := i
+			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.NumOfAccounts) > 0 {
+		for k := range m.NumOfAccounts {
+			v := m.NumOfAccounts[k]
+			baseI := i
+			i = encodeVarintGenesis(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintGenesis(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenesis(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -257,6 +864,146 @@ func (m *GenesisState) Size() (n int) {
 			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
 		}
 	}
+	if m.RefundPolicy != 0 {
+		n += 1 + sovGenesis(uint64(m.RefundPolicy))
+	}
+	if len(m.TimeoutPolicies) > 0 {
+		for k, v := range m.TimeoutPolicies {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxMemoLength))
+	}
+	if len(m.MinForwardAmounts) > 0 {
+		for k, v := range m.MinForwardAmounts {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.NumOfDeferrals) > 0 {
+		for _, e := range m.NumOfDeferrals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.ExecutionLimits.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.ForwardQueue) > 0 {
+		for _, s := range m.ForwardQueue {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeSchedules) > 0 {
+		for k, v := range m.FeeSchedules {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.FeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.TotalFees) > 0 {
+		for k, v := range m.TotalFees {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + len(v) + sovGenesis(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if len(m.RelayerFees) > 0 {
+		for k, v := range m.RelayerFees {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenesis(uint64(len(k))) + 1 + l + sovGenesis(uint64(l))
+			n += mapEntrySize + 1 + sovGenesis(uint64(mapEntrySize))
+		}
+	}
+	if m.FallbackGracePeriod != 0 {
+		n += 2 + sovGenesis(uint64(m.FallbackGracePeriod))
+	}
+	if len(m.BlockedForwards) > 0 {
+		for _, e := range m.BlockedForwards {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedChannels) > 0 {
+		for _, e := range m.PausedChannels {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CircuitBreaker {
+		n += 3
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RateLimitUsages) > 0 {
+		for _, e := range m.RateLimitUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HeldDeposits) > 0 {
+		for _, e := range m.HeldDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for _, s := range m.BlockedDenoms {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelAllowedDenoms) > 0 {
+		for _, e := range m.ChannelAllowedDenoms {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.UnwindOnly {
+		n += 3
+	}
+	if len(m.Retries) > 0 {
+		for _, e := range m.Retries {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InFlightPackets) > 0 {
+		for _, e := range m.InFlightPackets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -680,6 +1427,1245 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.TotalForwarded[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundPolicy", wireType)
+			}
+			m.RefundPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RefundPolicy |= RefundPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TimeoutPolicies == nil {
+				m.TimeoutPolicies = make(map[string]TimeoutPolicy)
+			}
+			var mapkey string
+			mapvalue := &TimeoutPolicy{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &TimeoutPolicy{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TimeoutPolicies[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinForwardAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinForwardAmounts == nil {
+				m.MinForwardAmounts = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MinForwardAmounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfDeferrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NumOfDeferrals = append(m.NumOfDeferrals, DeferralCount{})
+			if err := m.NumOfDeferrals[len(m.NumOfDeferrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardQueue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardQueue = append(m.ForwardQueue, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSchedules", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeSchedules == nil {
+				m.FeeSchedules = make(map[string]FeeSchedule)
+			}
+			var mapkey string
+			mapvalue := &FeeSchedule{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FeeSchedule{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FeeSchedules[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalFees == nil {
+				m.TotalFees = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalFees[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelayerFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RelayerFees == nil {
+				m.RelayerFees = make(map[string]RelayerFee)
+			}
+			var mapkey string
+			mapvalue := &RelayerFee{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &RelayerFee{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenesis(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenesis
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.RelayerFees[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackGracePeriod", wireType)
+			}
+			m.FallbackGracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FallbackGracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedForwards = append(m.BlockedForwards, BlockedForward{})
+			if err := m.BlockedForwards[len(m.BlockedForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, PausedChannel{})
+			if err := m.PausedChannels[len(m.PausedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreaker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CircuitBreaker = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimitUsages = append(m.RateLimitUsages, RateLimitUsage{})
+			if err := m.RateLimitUsages[len(m.RateLimitUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldDeposits = append(m.HeldDeposits, HeldDeposit{})
+			if err := m.HeldDeposits[len(m.HeldDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, Batch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedDenoms = append(m.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelAllowedDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelAllowedDenoms = append(m.ChannelAllowedDenoms, ChannelAllowedDenoms{})
+			if err := m.ChannelAllowedDenoms[len(m.ChannelAllowedDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnwindOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnwindOnly = bool(v != 0)
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Retries = append(m.Retries, ForwardRetry{})
+			if err := m.Retries[len(m.Retries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlightPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InFlightPackets = append(m.InFlightPackets, InFlightPacket{})
+			if err := m.InFlightPackets[len(m.InFlightPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,124 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ForwardingHooks defines the hooks that other modules can implement to react
+// to the lifecycle of forwarding accounts.
+type ForwardingHooks interface {
+	// BeforeRegister is called before a forwarding account is registered.
+	// Returning an error rejects the registration.
+	BeforeRegister(ctx context.Context, account ForwardingAccount) error
+	// AfterRegister is called after a forwarding account has been registered.
+	// Returning an error rejects the registration.
+	AfterRegister(ctx context.Context, account ForwardingAccount) error
+	// BeforeForward is called before a balance of a forwarding account is
+	// forwarded. The returned coin is forwarded instead, allowing hooks to
+	// modify the amount, or swap it into another denom held by the account.
+	// Returning an error vetoes the forward, leaving the balance inside the
+	// account until a future forward.
+	BeforeForward(ctx context.Context, account ForwardingAccount, coin sdk.Coin) (sdk.Coin, error)
+	// AfterForward is called after a transfer of a forward has been sent.
+	AfterForward(ctx context.Context, account ForwardingAccount, channel string, recipient string, coin sdk.Coin, sequence uint64) error
+	// OnForwardFailed is called when a forward fails, either because its
+	// transfer couldn't be sent, or because it was acknowledged with an
+	// error or timed out.
+	OnForwardFailed(ctx context.Context, account ForwardingAccount, coin sdk.Coin, reason string) error
+}
+
+var _ ForwardingHooks = MultiForwardingHooks{}
+
+// MultiForwardingHooks composes several forwarding hooks, that are called in
+// the order they are defined in.
+type MultiForwardingHooks []ForwardingHooks
+
+func NewMultiForwardingHooks(hooks ...ForwardingHooks) MultiForwardingHooks {
+	return hooks
+}
+
+func (h MultiForwardingHooks) BeforeRegister(ctx context.Context, account ForwardingAccount) error {
+	for _, hook := range h {
+		if err := hook.BeforeRegister(ctx, account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h MultiForwardingHooks) AfterRegister(ctx context.Context, account ForwardingAccount) error {
+	for _, hook := range h {
+		if err := hook.AfterRegister(ctx, account); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BeforeForward passes the coin returned by every hook on to the next one,
+// with the first error vetoing the forward.
+func (h MultiForwardingHooks) BeforeForward(ctx context.Context, account ForwardingAccount, coin sdk.Coin) (sdk.Coin, error) {
+	for _, hook := range h {
+		var err error
+		coin, err = hook.BeforeForward(ctx, account, coin)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	return coin, nil
+}
+
+// AfterForward calls every hook, even if a previous one failed, as the
+// forward has already been sent.
+func (h MultiForwardingHooks) AfterForward(ctx context.Context, account ForwardingAccount, channel string, recipient string, coin sdk.Coin, sequence uint64) error {
+	var errs []error
+	for _, hook := range h {
+		errs = append(errs, hook.AfterForward(ctx, account, channel, recipient, coin, sequence))
+	}
+
+	return errors.Join(errs...)
+}
+
+// OnForwardFailed calls every hook, even if a previous one failed, as the
+// forward has already failed.
+func (h MultiForwardingHooks) OnForwardFailed(ctx context.Context, account ForwardingAccount, coin sdk.Coin, reason string) error {
+	var errs []error
+	for _, hook := range h {
+		errs = append(errs, hook.OnForwardFailed(ctx, account, coin, reason))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ForwardingHooksWrapper is a wrapper for modules to inject ForwardingHooks
+// using depinject.
+type ForwardingHooksWrapper struct{ ForwardingHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (ForwardingHooksWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// MaxHops is the maximum number of hops a forwarding account can define.
+const MaxHops = 4
+
+// ValidateHops checks if a specified list of hops is valid, alongside the
+// memo that is included in the transfer of the final hop.
+func ValidateHops(hops []Hop, memo string) error {
+	if len(hops) > MaxHops {
+		return fmt.Errorf("hops must not exceed %d entries", MaxHops)
+	}
+
+	// NOTE: Packet-forward-middleware only accepts JSON objects as the next
+	// payload of a forward, so other memos can't be included in the final hop.
+	if len(hops) > 0 && memo != "" && !isJSONObject(memo) {
+		return errors.New("memo must be a JSON object when hops are defined")
+	}
+
+	for _, hop := range hops {
+		if !channeltypes.IsValidChannelID(hop.Channel) {
+			return fmt.Errorf("invalid hop channel: %s", hop.Channel)
+		}
+
+		if hop.Receiver == "" {
+			return errors.New("hop receiver cannot be empty")
+		}
+
+		if len(hop.Receiver) > transfertypes.MaximumReceiverLength {
+			return fmt.Errorf("hop receiver must not exceed %d bytes", transfertypes.MaximumReceiverLength)
+		}
+	}
+
+	return nil
+}
+
+// EncodeHops deterministically encodes a list of hops, for use in the
+// derivation of forwarding account addresses.
+func EncodeHops(hops []Hop) []byte {
+	var bz []byte
+	for _, hop := range hops {
+		bz = binary.AppendUvarint(bz, uint64(len(hop.Channel)))
+		bz = append(bz, hop.Channel...)
+		bz = binary.AppendUvarint(bz, uint64(len(hop.Receiver)))
+		bz = append(bz, hop.Receiver...)
+	}
+
+	return bz
+}
+
+type forwardMemo struct {
+	Forward forwardMetadata `json:"forward"`
+}
+
+type forwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// BuildForwardMemo constructs the packet-forward-middleware memo that routes
+// a forward through the specified hops. The provided memo is included in the
+// transfer of the final hop.
+func BuildForwardMemo(hops []Hop, memo string) (string, error) {
+	if len(hops) == 0 {
+		return memo, nil
+	}
+
+	// NOTE: Memos are embedded as is, as expected by middleware such as wasm
+	// hooks, and must therefore be JSON objects.
+	var next json.RawMessage
+	if memo != "" {
+		if !isJSONObject(memo) {
+			return "", errors.New("memo must be a JSON object when hops are defined")
+		}
+		next = json.RawMessage(memo)
+	}
+
+	for i := len(hops) - 1; i >= 0; i-- {
+		bz, err := json.Marshal(forwardMemo{
+			Forward: forwardMetadata{
+				Receiver: hops[i].Receiver,
+				Port:     transfertypes.PortID,
+				Channel:  hops[i].Channel,
+				Next:     next,
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+		next = bz
+	}
+
+	return string(next), nil
+}
+
+// isJSONObject returns true if a memo is a JSON object.
+func isJSONObject(memo string) bool {
+	var object map[string]json.RawMessage
+	return json.Unmarshal([]byte(memo), &object) == nil && object != nil
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestValidateHops(t *testing.T) {
+	hops := []types.Hop{{Channel: "channel-1", Receiver: "osmo1final"}}
+
+	require.NoError(t, types.ValidateHops(hops, ""))
+	require.NoError(t, types.ValidateHops(hops, `{"wasm":{"contract":"osmo1contract"}}`))
+	require.NoError(t, types.ValidateHops(nil, "tag"))
+
+	require.ErrorContains(t, types.ValidateHops(hops, "tag"), "memo must be a JSON object")
+	require.ErrorContains(t, types.ValidateHops(hops, `"tag"`), "memo must be a JSON object")
+	require.ErrorContains(t, types.ValidateHops([]types.Hop{{Channel: "channel", Receiver: "osmo1final"}}, ""), "invalid hop channel")
+	require.ErrorContains(t, types.ValidateHops([]types.Hop{{Channel: "channel-1"}}, ""), "hop receiver cannot be empty")
+	require.ErrorContains(t, types.ValidateHops(make([]types.Hop, types.MaxHops+1), ""), "hops must not exceed")
+}
+
+func TestBuildForwardMemo(t *testing.T) {
+	hops := []types.Hop{
+		{Channel: "channel-1", Receiver: "osmo1second"},
+		{Channel: "channel-2", Receiver: "juno1final"},
+	}
+
+	memo, err := types.BuildForwardMemo(nil, "tag")
+	require.NoError(t, err)
+	require.Equal(t, "tag", memo)
+
+	memo, err = types.BuildForwardMemo(hops, "")
+	require.NoError(t, err)
+	require.JSONEq(t, `{"forward":{"receiver":"osmo1second","port":"transfer","channel":"channel-1","next":{"forward":{"receiver":"juno1final","port":"transfer","channel":"channel-2"}}}}`, memo)
+
+	memo, err = types.BuildForwardMemo(hops[1:], `{"wasm":{"contract":"juno1contract"}}`)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"forward":{"receiver":"juno1final","port":"transfer","channel":"channel-2","next":{"wasm":{"contract":"juno1contract"}}}}`, memo)
+
+	_, err = types.BuildForwardMemo(hops, "tag")
+	require.ErrorContains(t, err, "memo must be a JSON object")
+}
@@ -32,4 +32,41 @@ var (
 	NumOfForwardsPrefix   = []byte("num_of_forwards")
 	TotalForwardedPrefix  = []byte("total_forwarded")
 	PendingForwardsPrefix = []byte("pending_forwards")
+
+	RetryQueuePrefix                  = []byte("retry_queue")
+	RetryQueueByNextAttemptPrefix     = []byte("retry_index_by_next_attempt")
+	InFlightPacketsPrefix             = []byte("in_flight_packets")
+	RefundPolicyKey                   = []byte("refund_policy")
+	TimeoutPoliciesPrefix             = []byte("timeout_policies")
+	MaxMemoLengthKey                  = []byte("max_memo_length")
+	MinForwardAmountsPrefix           = []byte("min_forward_amounts")
+	NumOfDeferralsPrefix              = []byte("num_of_deferrals")
+	ExecutionLimitsKey                = []byte("execution_limits")
+	ForwardQueuePrefix                = []byte("forward_queue")
+	ForwardQueueSequenceKey           = []byte("queue_sequence")
+	QueuedForwardsPrefix              = []byte("queued_forwards")
+	QueueLengthsPrefix                = []byte("queue_lengths")
+	QueueDepthKey                     = []byte("queue_depth")
+	FeeSchedulesPrefix                = []byte("fee_schedules")
+	FeeRecipientKey                   = []byte("fee_recipient")
+	TotalFeesPrefix                   = []byte("total_fees")
+	RelayerFeesPrefix                 = []byte("relayer_fees")
+	FallbackGracePeriodKey            = []byte("fallback_grace_period")
+	BlockedForwardsPrefix             = []byte("blocked_forwards")
+	BlockedBySincePrefix              = []byte("blocked_index_by_since")
+	BlockedByChannelPrefix            = []byte("blocked_index_by_channel")
+	PausedChannelsPrefix              = []byte("paused_channels")
+	CircuitBreakerKey                 = []byte("circuit_breaker")
+	GuardianKey                       = []byte("guardian")
+	RateLimitsPrefix                  = []byte("rate_limits")
+	RateLimitUsagesPrefix             = []byte("rate_limit_usages")
+	HeldDepositsPrefix                = []byte("held_deposits")
+	HeldByEligibilityPrefix           = []byte("held_index_by_eligibility")
+	BatchesPrefix                     = []byte("batches")
+	BatchesByForwardAtPrefix          = []byte("batch_index_by_forward_at")
+	BlockedDenomsPrefix               = []byte("blocked_denoms")
+	ChannelAllowedDenomsPrefix        = []byte("channel_allowed_denoms")
+	AllowedDenomPatternsPrefix        = []byte("allowed_denom_patterns")
+	ChannelAllowedDenomPatternsPrefix = []byte("channel_allowed_denom_patterns")
+	UnwindOnlyKey                     = []byte("unwind_only")
 )
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -23,9 +24,19 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type RegisterAccountData struct {
-	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Channel   string `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
-	Fallback  string `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Recipient       string       `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Channel         string       `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Fallback        string       `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Memo            string       `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Hops            []Hop        `protobuf:"bytes,5,rep,name=hops,proto3" json:"hops"`
+	Schedule        Schedule     `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule"`
+	Splits          []Split      `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits"`
+	Batching        Batching     `protobuf:"bytes,8,opt,name=batching,proto3" json:"batching"`
+	DenomRoutes     []DenomRoute `protobuf:"bytes,9,rep,name=denom_routes,json=denomRoutes,proto3" json:"denom_routes"`
+	AllowedDenoms   []string     `protobuf:"bytes,10,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	UnwindOnly      bool         `protobuf:"varint,11,opt,name=unwind_only,json=unwindOnly,proto3" json:"unwind_only,omitempty"`
+	DestinationType string       `protobuf:"bytes,12,opt,name=destination_type,json=destinationType,proto3" json:"destination_type,omitempty"`
+	RouteData       []byte       `protobuf:"bytes,13,opt,name=route_data,json=routeData,proto3" json:"route_data,omitempty"`
 }
 
 func (m *RegisterAccountData) Reset()         { *m = RegisterAccountData{} }
@@ -82,6 +93,76 @@ func (m *RegisterAccountData) GetFallback() string {
 	return ""
 }
 
+func (m *RegisterAccountData) GetMemo() string {
+	if m != nil {
+		return m.Memo
+	}
+	return ""
+}
+
+func (m *RegisterAccountData) GetHops() []Hop {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *RegisterAccountData) GetSchedule() Schedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return Schedule{}
+}
+
+func (m *RegisterAccountData) GetSplits() []Split {
+	if m != nil {
+		return m.Splits
+	}
+	return nil
+}
+
+func (m *RegisterAccountData) GetBatching() Batching {
+	if m != nil {
+		return m.Batching
+	}
+	return Batching{}
+}
+
+func (m *RegisterAccountData) GetDenomRoutes() []DenomRoute {
+	if m != nil {
+		return m.DenomRoutes
+	}
+	return nil
+}
+
+func (m *RegisterAccountData) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *RegisterAccountData) GetUnwindOnly() bool {
+	if m != nil {
+		return m.UnwindOnly
+	}
+	return false
+}
+
+func (m *RegisterAccountData) GetDestinationType() string {
+	if m != nil {
+		return m.DestinationType
+	}
+	return ""
+}
+
+func (m *RegisterAccountData) GetRouteData() []byte {
+	if m != nil {
+		return m.RouteData
+	}
+	return nil
+}
+
 type RegisterAccountMemo struct {
 	Noble *RegisterAccountMemo_RegisterAccountDataWrapper `protobuf:"bytes,1,opt,name=noble,proto3" json:"noble,omitempty"`
 }
@@ -183,25 +264,40 @@ func init() {
 func init() { proto.RegisterFile("noble/forwarding/v1/packet.proto", fileDescriptor_9a0a2a88e68b1d25) }
 
 var fileDescriptor_9a0a2a88e68b1d25 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xcd, 0x4a, 0x03, 0x31,
-	0x14, 0x85, 0x1b, 0xc5, 0x9f, 0xc6, 0x5d, 0xba, 0x19, 0x06, 0x09, 0xa5, 0xab, 0x82, 0x98, 0xd0,
-	0xfa, 0x04, 0xfe, 0x81, 0x1b, 0x37, 0xb3, 0x11, 0xdd, 0x65, 0xe2, 0x9d, 0x69, 0xe8, 0x34, 0x09,
-	0x49, 0x5a, 0xf1, 0x2d, 0x7c, 0x2c, 0x97, 0x5d, 0x76, 0x29, 0x33, 0x2f, 0x22, 0xc6, 0x9f, 0x51,
-	0x1c, 0xc1, 0xe5, 0xbd, 0xf7, 0xdc, 0xf3, 0x1d, 0x38, 0x78, 0xa8, 0x4d, 0x5e, 0x01, 0x2f, 0x8c,
-	0x7b, 0x10, 0xee, 0x5e, 0xe9, 0x92, 0xaf, 0x26, 0xdc, 0x0a, 0x39, 0x87, 0xc0, 0xac, 0x33, 0xc1,
-	0x90, 0x41, 0x54, 0xb0, 0x56, 0xc1, 0x56, 0x93, 0x91, 0xc2, 0x83, 0x0c, 0x4a, 0xe5, 0x03, 0xb8,
-	0x53, 0x29, 0xcd, 0x52, 0x87, 0x0b, 0x11, 0x04, 0x39, 0xc4, 0x7d, 0x07, 0x52, 0x59, 0x05, 0x3a,
-	0x24, 0x68, 0x88, 0xc6, 0xfd, 0xac, 0x5d, 0x90, 0x04, 0xef, 0xc9, 0x99, 0xd0, 0x1a, 0xaa, 0x64,
-	0x2b, 0xde, 0x3e, 0x47, 0x92, 0xe2, 0xfd, 0x42, 0x54, 0x55, 0x2e, 0xe4, 0x3c, 0xd9, 0x8e, 0xa7,
-	0xaf, 0x79, 0xb4, 0x41, 0xbf, 0x58, 0xd7, 0xb0, 0x30, 0xe4, 0x16, 0xef, 0xc4, 0x64, 0x91, 0x73,
-	0x30, 0x3d, 0x67, 0x1d, 0x39, 0x59, 0xc7, 0x23, 0xeb, 0x08, 0x7e, 0xe3, 0x84, 0xb5, 0xe0, 0xb2,
-	0x77, 0xc7, 0xb4, 0xc0, 0xe9, 0xdf, 0x22, 0x72, 0x85, 0x71, 0x0b, 0xf9, 0xa0, 0x8f, 0xff, 0x43,
-	0x7f, 0x33, 0xc9, 0xbe, 0xfd, 0x9e, 0x5d, 0x3e, 0xd7, 0x14, 0xad, 0x6b, 0x8a, 0x5e, 0x6a, 0x8a,
-	0x9e, 0x1a, 0xda, 0x5b, 0x37, 0xb4, 0xb7, 0x69, 0x68, 0xef, 0xee, 0xa8, 0x54, 0x61, 0xb6, 0xcc,
-	0x99, 0x34, 0x0b, 0x1e, 0x9d, 0x8f, 0x85, 0xf7, 0x10, 0xfc, 0x8f, 0xa2, 0xa6, 0x3c, 0x3c, 0x5a,
-	0xf0, 0xf9, 0x6e, 0x2c, 0xea, 0xe4, 0x35, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x8b, 0xd8, 0x9a, 0xcc,
-	0x01, 0x00, 0x00,
+	// 515 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4f, 0x6f, 0xd3, 0x30,
+	0x14, 0x6f, 0x58, 0xd7, 0xb5, 0x2f, 0x1d, 0x20, 0x8f, 0x83, 0x15, 0xb1, 0x34, 0x4c, 0x42, 0x0a,
+	0x42, 0x24, 0x5a, 0xb9, 0x70, 0x43, 0x94, 0x21, 0xf5, 0x82, 0x90, 0x02, 0x12, 0x82, 0x4b, 0xe5,
+	0x26, 0x6e, 0x6a, 0xcd, 0xb5, 0xad, 0xd8, 0x5d, 0xd5, 0x6f, 0xc1, 0xc7, 0x1a, 0xb7, 0x1d, 0x77,
+	0x42, 0xa8, 0xfd, 0x22, 0x28, 0x6e, 0xda, 0x0e, 0x11, 0x10, 0x37, 0xfb, 0xf7, 0x7e, 0x7f, 0xfc,
+	0xec, 0x67, 0x08, 0x84, 0x1c, 0x73, 0x1a, 0x4f, 0x64, 0xb1, 0x20, 0x45, 0xc6, 0x44, 0x1e, 0x5f,
+	0x9d, 0xc7, 0x8a, 0xa4, 0x97, 0xd4, 0x44, 0xaa, 0x90, 0x46, 0xa2, 0x13, 0xcb, 0x88, 0xf6, 0x8c,
+	0xe8, 0xea, 0xdc, 0x7b, 0x94, 0xcb, 0x5c, 0xda, 0x7a, 0x5c, 0xae, 0x36, 0x54, 0xef, 0x49, 0x9d,
+	0x19, 0x49, 0x53, 0x39, 0x17, 0x95, 0xdb, 0xd9, 0xf7, 0x26, 0x9c, 0x24, 0x34, 0x67, 0xda, 0xd0,
+	0xe2, 0xcd, 0xa6, 0x72, 0x41, 0x0c, 0x41, 0x8f, 0xa1, 0x53, 0xd0, 0x94, 0x29, 0x46, 0x85, 0xc1,
+	0x4e, 0xe0, 0x84, 0x9d, 0x64, 0x0f, 0x20, 0x0c, 0x47, 0xe9, 0x94, 0x08, 0x41, 0x39, 0xbe, 0x67,
+	0x6b, 0xdb, 0x2d, 0xf2, 0xa0, 0x3d, 0x21, 0x9c, 0x8f, 0x49, 0x7a, 0x89, 0x0f, 0x6c, 0x69, 0xb7,
+	0x47, 0x08, 0x9a, 0x33, 0x3a, 0x93, 0xb8, 0x69, 0x71, 0xbb, 0x46, 0x7d, 0x68, 0x4e, 0xa5, 0xd2,
+	0xf8, 0x30, 0x38, 0x08, 0xdd, 0x3e, 0x8e, 0x6a, 0x9a, 0x8b, 0x86, 0x52, 0x0d, 0x9a, 0xd7, 0x3f,
+	0x7a, 0x8d, 0xc4, 0x72, 0xd1, 0x6b, 0x68, 0xeb, 0x74, 0x4a, 0xb3, 0x39, 0xa7, 0xb8, 0x15, 0x38,
+	0xa1, 0xdb, 0x3f, 0xad, 0xd5, 0x7d, 0xac, 0x48, 0x95, 0x78, 0x27, 0x42, 0xaf, 0xa0, 0xa5, 0x15,
+	0x67, 0x46, 0xe3, 0x23, 0x1b, 0xeb, 0xd5, 0xcb, 0x4b, 0x4a, 0xa5, 0xad, 0xf8, 0x65, 0xf4, 0x98,
+	0x98, 0x74, 0xca, 0x44, 0x8e, 0xdb, 0xff, 0x88, 0x1e, 0x54, 0xa4, 0x6d, 0xf4, 0x56, 0x84, 0x86,
+	0xd0, 0xcd, 0xa8, 0x90, 0xb3, 0x51, 0x21, 0xe7, 0x86, 0x6a, 0xdc, 0xb1, 0x07, 0xe8, 0xd5, 0x9a,
+	0x5c, 0x94, 0xc4, 0xa4, 0xe4, 0x55, 0x36, 0x6e, 0xb6, 0x43, 0x34, 0x7a, 0x0a, 0xf7, 0x09, 0xe7,
+	0x72, 0x41, 0xb3, 0x91, 0x85, 0x35, 0x86, 0xe0, 0x20, 0xec, 0x24, 0xc7, 0x15, 0x6a, 0xd5, 0x1a,
+	0xf5, 0xc0, 0x9d, 0x8b, 0x05, 0x13, 0xd9, 0x48, 0x0a, 0xbe, 0xc4, 0x6e, 0xe0, 0x84, 0xed, 0x04,
+	0x36, 0xd0, 0x07, 0xc1, 0x97, 0xe8, 0x19, 0x3c, 0xcc, 0xa8, 0x36, 0x4c, 0x10, 0xc3, 0xa4, 0x18,
+	0x99, 0xa5, 0xa2, 0xb8, 0x6b, 0x5f, 0xe8, 0xc1, 0x1d, 0xfc, 0xd3, 0x52, 0x51, 0x74, 0x0a, 0x60,
+	0x8f, 0x3d, 0xca, 0x88, 0x21, 0xf8, 0x38, 0x70, 0xc2, 0x6e, 0xd2, 0xb1, 0x48, 0x39, 0x33, 0x67,
+	0xb7, 0xce, 0x1f, 0xb3, 0xf4, 0xbe, 0x7c, 0xe3, 0x2f, 0x70, 0x68, 0xdb, 0xb3, 0x73, 0xe4, 0xf6,
+	0xdf, 0xd6, 0x36, 0x5b, 0x23, 0x8c, 0x6a, 0x06, 0xf3, 0x73, 0x41, 0x94, 0xa2, 0x45, 0xb2, 0x71,
+	0xf4, 0x26, 0xe0, 0xfd, 0x9d, 0x84, 0x86, 0x00, 0xfb, 0x90, 0x2a, 0x3d, 0xfc, 0x9f, 0xf4, 0xd2,
+	0x24, 0xb9, 0xa3, 0x1d, 0xbc, 0xbb, 0x5e, 0xf9, 0xce, 0xcd, 0xca, 0x77, 0x7e, 0xae, 0x7c, 0xe7,
+	0xdb, 0xda, 0x6f, 0xdc, 0xac, 0xfd, 0xc6, 0xed, 0xda, 0x6f, 0x7c, 0x7d, 0x9e, 0x33, 0x33, 0x9d,
+	0x8f, 0xa3, 0x54, 0xce, 0x62, 0xeb, 0xfc, 0x82, 0x68, 0x4d, 0x8d, 0xfe, 0xed, 0xd7, 0xf5, 0xe3,
+	0xf2, 0x5a, 0xf5, 0xb8, 0x65, 0x3f, 0xdd, 0xcb, 0x5f, 0x03, 0x00, 0x93, 0x4e, 0x9b, 0x39, 0xe6,
+	0x03, 0x00, 0x00,
 }
 
 func (m *RegisterAccountData) Marshal() (dAtA []byte, err error) {
@@ -224,6 +320,108 @@ func (m *RegisterAccountData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RouteData) > 0 {
+		i -= len(m.RouteData)
+		copy(dAtA[i:], m.RouteData)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.RouteData)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DestinationType) > 0 {
+		i -= len(m.DestinationType)
+		copy(dAtA[i:], m.DestinationType)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.DestinationType)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UnwindOnly {
+		i--
+		if m.UnwindOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DenomRoutes) > 0 {
+		for iNdEx := len(m.DenomRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Batching.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPacket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Fallback) > 0 {
 		i -= len(m.Fallback)
 		copy(dAtA[i:], m.Fallback)
@@ -347,6 +545,49 @@ func (m *RegisterAccountData) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPacket(uint64(l))
 	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	l = m.Batching.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if len(m.DenomRoutes) > 0 {
+		for _, e := range m.DenomRoutes {
+			l = e.Size()
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	if m.UnwindOnly {
+		n += 2
+	}
+	l = len(m.DestinationType)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.RouteData)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -507,6 +748,324 @@ func (m *RegisterAccountData) Unmarshal(dAtA []byte) error {
 			}
 			m.Fallback = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, Hop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, Split{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batching", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRoutes = append(m.DenomRoutes, DenomRoute{})
+			if err := m.DenomRoutes[len(m.DenomRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnwindOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnwindOnly = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteData = append(m.RouteData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RouteData == nil {
+				m.RouteData = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
@@ -5,11 +5,12 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -72,6 +73,7 @@ var xxx_messageInfo_QueryDenoms proto.InternalMessageInfo
 
 type QueryDenomsResponse struct {
 	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	BlockedDenoms []string `protobuf:"bytes,2,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty"`
 }
 
 func (m *QueryDenomsResponse) Reset()         { *m = QueryDenomsResponse{} }
@@ -114,10 +116,27 @@ func (m *QueryDenomsResponse) GetAllowedDenoms() []string {
 	return nil
 }
 
+func (m *QueryDenomsResponse) GetBlockedDenoms() []string {
+	if m != nil {
+		return m.BlockedDenoms
+	}
+	return nil
+}
+
 type QueryAddress struct {
-	Channel   string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Fallback  string `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Channel         string       `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Recipient       string       `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Fallback        string       `protobuf:"bytes,3,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	Memo            string       `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	Hops            []Hop        `protobuf:"bytes,5,rep,name=hops,proto3" json:"hops"`
+	Schedule        Schedule     `protobuf:"bytes,6,opt,name=schedule,proto3" json:"schedule"`
+	Splits          []Split      `protobuf:"bytes,7,rep,name=splits,proto3" json:"splits"`
+	Batching        Batching     `protobuf:"bytes,8,opt,name=batching,proto3" json:"batching"`
+	DenomRoutes     []DenomRoute `protobuf:"bytes,9,rep,name=denom_routes,json=denomRoutes,proto3" json:"denom_routes"`
+	AllowedDenoms   []string     `protobuf:"bytes,10,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	UnwindOnly      bool         `protobuf:"varint,11,opt,name=unwind_only,json=unwindOnly,proto3" json:"unwind_only,omitempty"`
+	DestinationType string       `protobuf:"bytes,12,opt,name=destination_type,json=destinationType,proto3" json:"destination_type,omitempty"`
+	RouteData       []byte       `protobuf:"bytes,13,opt,name=route_data,json=routeData,proto3" json:"route_data,omitempty"`
 }
 
 func (m *QueryAddress) Reset()         { *m = QueryAddress{} }
@@ -326,6 +345,8 @@ type QueryStatsByChannelResponse struct {
 	NumOfAccounts  uint64                                   `protobuf:"varint,1,opt,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty"`
 	NumOfForwards  uint64                                   `protobuf:"varint,2,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
 	TotalForwarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+	NumOfDeferrals map[string]uint64                        `protobuf:"bytes,4,rep,name=num_of_deferrals,json=numOfDeferrals,proto3" json:"num_of_deferrals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TotalFees      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
 }
 
 func (m *QueryStatsByChannelResponse) Reset()         { *m = QueryStatsByChannelResponse{} }
@@ -382,25 +403,35 @@ func (m *QueryStatsByChannelResponse) GetTotalForwarded() github_com_cosmos_cosm
 	return nil
 }
 
-type Stats struct {
-	ChainId        string                                   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	NumOfAccounts  uint64                                   `protobuf:"varint,2,opt,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty"`
-	NumOfForwards  uint64                                   `protobuf:"varint,3,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
-	TotalForwarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+func (m *QueryStatsByChannelResponse) GetNumOfDeferrals() map[string]uint64 {
+	if m != nil {
+		return m.NumOfDeferrals
+	}
+	return nil
 }
 
-func (m *Stats) Reset()         { *m = Stats{} }
-func (m *Stats) String() string { return proto.CompactTextString(m) }
-func (*Stats) ProtoMessage()    {}
-func (*Stats) Descriptor() ([]byte, []int) {
+func (m *QueryStatsByChannelResponse) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
+type QueryRefundPolicy struct {
+}
+
+func (m *QueryRefundPolicy) Reset()         { *m = QueryRefundPolicy{} }
+func (m *QueryRefundPolicy) String() string { return proto.CompactTextString(m) }
+func (*QueryRefundPolicy) ProtoMessage()    {}
+func (*QueryRefundPolicy) Descriptor() ([]byte, []int) {
 	return fileDescriptor_fc601bfb5b0b1c63, []int{8}
 }
-func (m *Stats) XXX_Unmarshal(b []byte) error {
+func (m *QueryRefundPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRefundPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRefundPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -410,811 +441,9926 @@ func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Stats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Stats.Merge(m, src)
+func (m *QueryRefundPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefundPolicy.Merge(m, src)
 }
-func (m *Stats) XXX_Size() int {
+func (m *QueryRefundPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *Stats) XXX_DiscardUnknown() {
-	xxx_messageInfo_Stats.DiscardUnknown(m)
+func (m *QueryRefundPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefundPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Stats proto.InternalMessageInfo
+var xxx_messageInfo_QueryRefundPolicy proto.InternalMessageInfo
 
-func (m *Stats) GetChainId() string {
-	if m != nil {
-		return m.ChainId
-	}
-	return ""
+type QueryRefundPolicyResponse struct {
+	Policy RefundPolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=noble.forwarding.v1.RefundPolicy" json:"policy,omitempty"`
 }
 
-func (m *Stats) GetNumOfAccounts() uint64 {
-	if m != nil {
-		return m.NumOfAccounts
+func (m *QueryRefundPolicyResponse) Reset()         { *m = QueryRefundPolicyResponse{} }
+func (m *QueryRefundPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRefundPolicyResponse) ProtoMessage()    {}
+func (*QueryRefundPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{9}
+}
+func (m *QueryRefundPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRefundPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRefundPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryRefundPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRefundPolicyResponse.Merge(m, src)
+}
+func (m *QueryRefundPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRefundPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRefundPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *Stats) GetNumOfForwards() uint64 {
+var xxx_messageInfo_QueryRefundPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryRefundPolicyResponse) GetPolicy() RefundPolicy {
 	if m != nil {
-		return m.NumOfForwards
+		return m.Policy
 	}
-	return 0
+	return RefundPolicyUnspecified
 }
 
-func (m *Stats) GetTotalForwarded() github_com_cosmos_cosmos_sdk_types.Coins {
-	if m != nil {
-		return m.TotalForwarded
-	}
-	return nil
+type QueryTimeoutPolicy struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*QueryDenoms)(nil), "noble.forwarding.v1.QueryDenoms")
-	proto.RegisterType((*QueryDenomsResponse)(nil), "noble.forwarding.v1.QueryDenomsResponse")
-	proto.RegisterType((*QueryAddress)(nil), "noble.forwarding.v1.QueryAddress")
-	proto.RegisterType((*QueryAddressResponse)(nil), "noble.forwarding.v1.QueryAddressResponse")
-	proto.RegisterType((*QueryStats)(nil), "noble.forwarding.v1.QueryStats")
-	proto.RegisterType((*QueryStatsResponse)(nil), "noble.forwarding.v1.QueryStatsResponse")
-	proto.RegisterMapType((map[string]Stats)(nil), "noble.forwarding.v1.QueryStatsResponse.StatsEntry")
-	proto.RegisterType((*QueryStatsByChannel)(nil), "noble.forwarding.v1.QueryStatsByChannel")
-	proto.RegisterType((*QueryStatsByChannelResponse)(nil), "noble.forwarding.v1.QueryStatsByChannelResponse")
-	proto.RegisterType((*Stats)(nil), "noble.forwarding.v1.Stats")
+func (m *QueryTimeoutPolicy) Reset()         { *m = QueryTimeoutPolicy{} }
+func (m *QueryTimeoutPolicy) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeoutPolicy) ProtoMessage()    {}
+func (*QueryTimeoutPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{10}
+}
+func (m *QueryTimeoutPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeoutPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeoutPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimeoutPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeoutPolicy.Merge(m, src)
+}
+func (m *QueryTimeoutPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeoutPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeoutPolicy.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("noble/forwarding/v1/query.proto", fileDescriptor_fc601bfb5b0b1c63) }
+var xxx_messageInfo_QueryTimeoutPolicy proto.InternalMessageInfo
 
-var fileDescriptor_fc601bfb5b0b1c63 = []byte{
-	// 820 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xbf, 0x6f, 0x23, 0x45,
-	0x14, 0xf6, 0xfa, 0x47, 0xec, 0x4c, 0x7e, 0xc1, 0x24, 0x85, 0xb3, 0x49, 0x6c, 0xb3, 0x42, 0x60,
-	0x02, 0xde, 0x8d, 0x4d, 0x03, 0x91, 0x10, 0x8a, 0x43, 0x22, 0x41, 0x01, 0xc2, 0xa1, 0xa2, 0xb1,
-	0xc6, 0xbb, 0xe3, 0xcd, 0x2a, 0xeb, 0x19, 0xb3, 0x33, 0x76, 0xb0, 0xa2, 0x50, 0x50, 0x45, 0x54,
-	0x48, 0x50, 0xd1, 0x10, 0x89, 0x06, 0x51, 0xa5, 0xa0, 0xe2, 0x0f, 0x40, 0x29, 0x23, 0x68, 0xae,
-	0xba, 0x3b, 0x25, 0x27, 0xe5, 0xfe, 0x8c, 0xd3, 0xce, 0xcc, 0xae, 0x7d, 0x39, 0x5b, 0x4e, 0xaa,
-	0x6b, 0xec, 0x79, 0xef, 0x7d, 0xf3, 0xde, 0xe7, 0x6f, 0xde, 0x7b, 0x06, 0x45, 0x42, 0x5b, 0x3e,
-	0xb6, 0xda, 0x34, 0x38, 0x46, 0x81, 0xe3, 0x11, 0xd7, 0xea, 0x57, 0xad, 0xef, 0x7a, 0x38, 0x18,
-	0x98, 0xdd, 0x80, 0x72, 0x0a, 0x97, 0x05, 0xc0, 0x1c, 0x02, 0xcc, 0x7e, 0x55, 0x7f, 0x13, 0x75,
-	0x3c, 0x42, 0x2d, 0xf1, 0x29, 0x71, 0x7a, 0xc1, 0xa6, 0xac, 0x43, 0x99, 0xd5, 0x42, 0x0c, 0x5b,
-	0xfd, 0x6a, 0x0b, 0x73, 0x54, 0xb5, 0x6c, 0xea, 0x11, 0x15, 0x5f, 0x53, 0x71, 0x91, 0xfb, 0x4e,
-	0x11, 0x7d, 0x55, 0x06, 0x9b, 0xc2, 0xb2, 0xa4, 0xa1, 0x42, 0x2b, 0x2e, 0x75, 0xa9, 0xf4, 0x87,
-	0x27, 0xe5, 0x5d, 0x77, 0x29, 0x75, 0x7d, 0x6c, 0xa1, 0xae, 0x67, 0x21, 0x42, 0x28, 0x47, 0xdc,
-	0xa3, 0x44, 0xdd, 0x31, 0x16, 0xc0, 0xdc, 0xd7, 0x61, 0xf6, 0xcf, 0x30, 0xa1, 0x1d, 0x66, 0xec,
-	0x82, 0xe5, 0x11, 0xb3, 0x81, 0x59, 0x97, 0x12, 0x86, 0xe1, 0x07, 0x60, 0x11, 0xf9, 0x3e, 0x3d,
-	0xc6, 0x4e, 0xd3, 0x11, 0x91, 0xbc, 0x56, 0x4a, 0x95, 0x67, 0xeb, 0x99, 0x3f, 0x6f, 0x2f, 0x36,
-	0xb5, 0xc6, 0x82, 0x0a, 0xaa, 0x24, 0x3e, 0x98, 0x17, 0x49, 0x76, 0x1c, 0x27, 0xc0, 0x8c, 0xc1,
-	0x3c, 0xc8, 0xda, 0x87, 0x88, 0x10, 0xec, 0xe7, 0xb5, 0x92, 0x56, 0x9e, 0x6d, 0x44, 0x26, 0x5c,
-	0x07, 0xb3, 0x01, 0xb6, 0xbd, 0xae, 0x87, 0x09, 0xcf, 0x27, 0x45, 0x6c, 0xe8, 0x80, 0x3a, 0xc8,
-	0xb5, 0x91, 0xef, 0xb7, 0x90, 0x7d, 0x94, 0x4f, 0x89, 0x60, 0x6c, 0x6f, 0xe7, 0xce, 0xce, 0x8b,
-	0x89, 0xe7, 0xe7, 0xc5, 0x84, 0xe1, 0x81, 0x95, 0xd1, 0x6a, 0x31, 0xe7, 0x1a, 0xc8, 0x22, 0xe9,
-	0x92, 0x55, 0xeb, 0xf9, 0xff, 0xfe, 0xae, 0xac, 0x28, 0xc1, 0x14, 0xf8, 0x80, 0x07, 0x1e, 0x71,
-	0x1b, 0x11, 0x10, 0x6e, 0x80, 0x19, 0xfc, 0xbd, 0xc7, 0x38, 0x13, 0x64, 0x72, 0xd1, 0xef, 0x53,
-	0x4e, 0x63, 0x1e, 0x00, 0x51, 0xea, 0x80, 0x23, 0xce, 0x8c, 0x7f, 0x34, 0x00, 0x87, 0x66, 0x5c,
-	0xf7, 0x4b, 0x90, 0x61, 0xa1, 0x43, 0x48, 0x34, 0x57, 0xab, 0x99, 0x63, 0xba, 0xc2, 0x7c, 0xf5,
-	0x9e, 0x29, 0xac, 0x3d, 0xc2, 0x83, 0x41, 0x3d, 0x7d, 0xf9, 0xb8, 0x98, 0x68, 0xc8, 0x34, 0xfa,
-	0x37, 0x00, 0x0c, 0x43, 0xf0, 0x0d, 0x90, 0x3a, 0xc2, 0x03, 0xa5, 0x63, 0x78, 0x84, 0x5b, 0x20,
-	0xd3, 0x47, 0x7e, 0x0f, 0x0b, 0xca, 0x73, 0x35, 0x7d, 0x6c, 0x3d, 0x59, 0x4a, 0x02, 0xb7, 0x93,
-	0x1f, 0x69, 0xc6, 0xc7, 0xea, 0xa1, 0x45, 0xa0, 0x3e, 0xd8, 0x55, 0x0f, 0x32, 0xf1, 0xa9, 0x46,
-	0x04, 0x3f, 0x4b, 0x82, 0xb5, 0x31, 0x77, 0x63, 0x01, 0x2a, 0x60, 0x89, 0xf4, 0x3a, 0x4d, 0xda,
-	0x6e, 0x22, 0xdb, 0xa6, 0x3d, 0xc2, 0xe5, 0x03, 0xa4, 0xe3, 0x6e, 0x21, 0xbd, 0xce, 0x57, 0xed,
-	0x1d, 0x15, 0x1b, 0x81, 0x2b, 0xca, 0x52, 0xfc, 0x3b, 0xf0, 0x7d, 0x15, 0x83, 0x3f, 0x69, 0x60,
-	0x89, 0x53, 0x8e, 0xfc, 0x08, 0x8e, 0x9d, 0x7c, 0x4a, 0x28, 0xbd, 0x6a, 0xaa, 0xc7, 0x0d, 0xe7,
-	0xca, 0x54, 0x73, 0x65, 0xee, 0x52, 0x8f, 0xd4, 0xf7, 0x43, 0x41, 0xff, 0x7a, 0x52, 0x2c, 0xbb,
-	0x1e, 0x3f, 0xec, 0xb5, 0x4c, 0x9b, 0x76, 0xd4, 0xe8, 0xa8, 0xaf, 0x0a, 0x73, 0x8e, 0x2c, 0x3e,
-	0xe8, 0x62, 0x26, 0x2e, 0xb0, 0xdf, 0x6e, 0x2f, 0x36, 0xe7, 0x7d, 0xec, 0x22, 0x7b, 0xd0, 0x0c,
-	0x27, 0x93, 0x49, 0x2e, 0x8b, 0xa2, 0xf2, 0x7e, 0x54, 0xd8, 0xf8, 0x35, 0x09, 0x32, 0x42, 0x05,
-	0x58, 0x02, 0x39, 0xfb, 0x10, 0x79, 0xa4, 0xe9, 0x39, 0xaa, 0xdd, 0x14, 0xfd, 0xac, 0x70, 0x7f,
-	0xee, 0x8c, 0x93, 0x25, 0xf9, 0x30, 0x59, 0x52, 0x0f, 0x94, 0x25, 0xfd, 0x9a, 0x64, 0xa9, 0xfd,
-	0x9b, 0x06, 0x19, 0xd1, 0x21, 0xf0, 0x07, 0x30, 0x23, 0x97, 0x02, 0x2c, 0x4d, 0x9e, 0x03, 0x89,
-	0xd0, 0xcb, 0xd3, 0x10, 0x51, 0x87, 0x19, 0xe5, 0xb3, 0xb0, 0xec, 0x8f, 0xff, 0x3f, 0xfb, 0x25,
-	0xb9, 0x01, 0xd7, 0xac, 0x71, 0x7b, 0x59, 0xae, 0x29, 0xf8, 0x87, 0x06, 0xb2, 0xd1, 0x1a, 0x7a,
-	0x6b, 0x72, 0x7e, 0x05, 0xd1, 0xdf, 0x9b, 0x0a, 0x89, 0x39, 0x7c, 0x31, 0xe4, 0xf0, 0x29, 0xfc,
-	0x64, 0x2c, 0x07, 0xb5, 0x55, 0xac, 0x13, 0x35, 0x43, 0xa7, 0xd6, 0x49, 0xbc, 0xdc, 0x4e, 0xad,
-	0x93, 0x68, 0x97, 0x9d, 0xc2, 0x5e, 0xd4, 0x45, 0xc5, 0x29, 0xcb, 0x42, 0x7f, 0xf7, 0x9e, 0xdb,
-	0xc4, 0x30, 0x04, 0xb3, 0x75, 0xa8, 0x8f, 0x65, 0x26, 0x36, 0x0b, 0xfc, 0x5d, 0x03, 0x8b, 0x77,
-	0xe6, 0xbf, 0x3c, 0x25, 0x7f, 0x8c, 0xd4, 0xb7, 0xee, 0x8b, 0x8c, 0x29, 0x55, 0x87, 0x8a, 0xbd,
-	0x03, 0xdf, 0x9e, 0xcc, 0x6b, 0xa8, 0x57, 0x7d, 0xef, 0xf2, 0xba, 0xa0, 0x5d, 0x5d, 0x17, 0xb4,
-	0xa7, 0xd7, 0x05, 0xed, 0xe7, 0x9b, 0x42, 0xe2, 0xea, 0xa6, 0x90, 0x78, 0x74, 0x53, 0x48, 0x7c,
-	0xfb, 0xfe, 0x48, 0xcb, 0x8a, 0x4c, 0x15, 0xc4, 0x18, 0xe6, 0xec, 0xa5, 0x84, 0x35, 0xd9, 0xbb,
-	0xad, 0x19, 0xf1, 0x5f, 0xf7, 0xe1, 0x8b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x71, 0xbf, 0x2e, 0x19,
-	0xc2, 0x07, 0x00, 0x00,
+type QueryTimeoutPolicyResponse struct {
+	Policy TimeoutPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *QueryTimeoutPolicyResponse) Reset()         { *m = QueryTimeoutPolicyResponse{} }
+func (m *QueryTimeoutPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeoutPolicyResponse) ProtoMessage()    {}
+func (*QueryTimeoutPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{11}
+}
+func (m *QueryTimeoutPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeoutPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeoutPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTimeoutPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeoutPolicyResponse.Merge(m, src)
+}
+func (m *QueryTimeoutPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeoutPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeoutPolicyResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTimeoutPolicyResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
-	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
-	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
-	StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error)
+func (m *QueryTimeoutPolicyResponse) GetPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return TimeoutPolicy{}
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryTimeoutPolicies struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTimeoutPolicies) Reset()         { *m = QueryTimeoutPolicies{} }
+func (m *QueryTimeoutPolicies) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeoutPolicies) ProtoMessage()    {}
+func (*QueryTimeoutPolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{12}
 }
-
-func (c *queryClient) Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error) {
-	out := new(QueryDenomsResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Denoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTimeoutPolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeoutPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeoutPolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTimeoutPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeoutPolicies.Merge(m, src)
+}
+func (m *QueryTimeoutPolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeoutPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeoutPolicies.DiscardUnknown(m)
 }
 
-func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
-	out := new(QueryAddressResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Address", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTimeoutPolicies proto.InternalMessageInfo
+
+func (m *QueryTimeoutPolicies) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
-	out := new(QueryStatsResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Stats", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryTimeoutPoliciesResponse struct {
+	Policies   []ChannelTimeoutPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTimeoutPoliciesResponse) Reset()         { *m = QueryTimeoutPoliciesResponse{} }
+func (m *QueryTimeoutPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTimeoutPoliciesResponse) ProtoMessage()    {}
+func (*QueryTimeoutPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{13}
+}
+func (m *QueryTimeoutPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTimeoutPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTimeoutPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTimeoutPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTimeoutPoliciesResponse.Merge(m, src)
+}
+func (m *QueryTimeoutPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTimeoutPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTimeoutPoliciesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error) {
-	out := new(QueryStatsByChannelResponse)
-	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/StatsByChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTimeoutPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryTimeoutPoliciesResponse) GetPolicies() []ChannelTimeoutPolicy {
+	if m != nil {
+		return m.Policies
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	Denoms(context.Context, *QueryDenoms) (*QueryDenomsResponse, error)
-	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
-	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
-	StatsByChannel(context.Context, *QueryStatsByChannel) (*QueryStatsByChannelResponse, error)
+func (m *QueryTimeoutPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// ChannelTimeoutPolicy is the effective timeout policy of a transfer channel.
+type ChannelTimeoutPolicy struct {
+	// channel is the identifier of the channel.
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	// policy is the timeout policy applied to forwards through the channel.
+	Policy TimeoutPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	// configured is false if the channel uses the default timeout policy.
+	Configured bool `protobuf:"varint,3,opt,name=configured,proto3" json:"configured,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Denoms(ctx context.Context, req *QueryDenoms) (*QueryDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
+func (m *ChannelTimeoutPolicy) Reset()         { *m = ChannelTimeoutPolicy{} }
+func (m *ChannelTimeoutPolicy) String() string { return proto.CompactTextString(m) }
+func (*ChannelTimeoutPolicy) ProtoMessage()    {}
+func (*ChannelTimeoutPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{14}
 }
-func (*UnimplementedQueryServer) Address(ctx context.Context, req *QueryAddress) (*QueryAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
+func (m *ChannelTimeoutPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStats) (*QueryStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+func (m *ChannelTimeoutPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelTimeoutPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) StatsByChannel(ctx context.Context, req *QueryStatsByChannel) (*QueryStatsByChannelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method StatsByChannel not implemented")
+func (m *ChannelTimeoutPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelTimeoutPolicy.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *ChannelTimeoutPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelTimeoutPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelTimeoutPolicy.DiscardUnknown(m)
 }
 
-func _Query_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenoms)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Denoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/Denoms",
+var xxx_messageInfo_ChannelTimeoutPolicy proto.InternalMessageInfo
+
+func (m *ChannelTimeoutPolicy) GetChannel() string {
+	if m != nil {
+		return m.Channel
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Denoms(ctx, req.(*QueryDenoms))
+	return ""
+}
+
+func (m *ChannelTimeoutPolicy) GetPolicy() TimeoutPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return interceptor(ctx, in, info, handler)
+	return TimeoutPolicy{}
 }
 
-func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAddress)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ChannelTimeoutPolicy) GetConfigured() bool {
+	if m != nil {
+		return m.Configured
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Address(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/Address",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Address(ctx, req.(*QueryAddress))
-	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStats)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Stats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/Stats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Stats(ctx, req.(*QueryStats))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryMaxMemoLength struct {
 }
 
-func _Query_StatsByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryStatsByChannel)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).StatsByChannel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/noble.forwarding.v1.Query/StatsByChannel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).StatsByChannel(ctx, req.(*QueryStatsByChannel))
+func (m *QueryMaxMemoLength) Reset()         { *m = QueryMaxMemoLength{} }
+func (m *QueryMaxMemoLength) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxMemoLength) ProtoMessage()    {}
+func (*QueryMaxMemoLength) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{15}
+}
+func (m *QueryMaxMemoLength) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxMemoLength) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxMemoLength.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMaxMemoLength) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxMemoLength.Merge(m, src)
+}
+func (m *QueryMaxMemoLength) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxMemoLength) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxMemoLength.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "noble.forwarding.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Denoms",
-			Handler:    _Query_Denoms_Handler,
-		},
-		{
-			MethodName: "Address",
-			Handler:    _Query_Address_Handler,
-		},
-		{
-			MethodName: "Stats",
-			Handler:    _Query_Stats_Handler,
-		},
-		{
-			MethodName: "StatsByChannel",
-			Handler:    _Query_StatsByChannel_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "noble/forwarding/v1/query.proto",
+var xxx_messageInfo_QueryMaxMemoLength proto.InternalMessageInfo
+
+type QueryMaxMemoLengthResponse struct {
+	MaxMemoLength uint64 `protobuf:"varint,1,opt,name=max_memo_length,json=maxMemoLength,proto3" json:"max_memo_length,omitempty"`
 }
 
-func (m *QueryDenoms) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMaxMemoLengthResponse) Reset()         { *m = QueryMaxMemoLengthResponse{} }
+func (m *QueryMaxMemoLengthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMaxMemoLengthResponse) ProtoMessage()    {}
+func (*QueryMaxMemoLengthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{16}
+}
+func (m *QueryMaxMemoLengthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMaxMemoLengthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMaxMemoLengthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMaxMemoLengthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMaxMemoLengthResponse.Merge(m, src)
+}
+func (m *QueryMaxMemoLengthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMaxMemoLengthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMaxMemoLengthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenoms) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMaxMemoLengthResponse proto.InternalMessageInfo
+
+func (m *QueryMaxMemoLengthResponse) GetMaxMemoLength() uint64 {
+	if m != nil {
+		return m.MaxMemoLength
+	}
+	return 0
 }
 
-func (m *QueryDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryMinForwardAmount struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMinForwardAmount) Reset()         { *m = QueryMinForwardAmount{} }
+func (m *QueryMinForwardAmount) String() string { return proto.CompactTextString(m) }
+func (*QueryMinForwardAmount) ProtoMessage()    {}
+func (*QueryMinForwardAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{17}
+}
+func (m *QueryMinForwardAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinForwardAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinForwardAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMinForwardAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinForwardAmount.Merge(m, src)
+}
+func (m *QueryMinForwardAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinForwardAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinForwardAmount.DiscardUnknown(m)
 }
 
-func (m *QueryDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMinForwardAmount proto.InternalMessageInfo
+
+type QueryMinForwardAmountResponse struct {
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 }
 
-func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedDenoms) > 0 {
-		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedDenoms[iNdEx])
-			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMinForwardAmountResponse) Reset()         { *m = QueryMinForwardAmountResponse{} }
+func (m *QueryMinForwardAmountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinForwardAmountResponse) ProtoMessage()    {}
+func (*QueryMinForwardAmountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{18}
+}
+func (m *QueryMinForwardAmountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinForwardAmountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinForwardAmountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMinForwardAmountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinForwardAmountResponse.Merge(m, src)
+}
+func (m *QueryMinForwardAmountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinForwardAmountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinForwardAmountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMinForwardAmountResponse proto.InternalMessageInfo
+
+type QueryExecutionLimits struct {
+}
+
+func (m *QueryExecutionLimits) Reset()         { *m = QueryExecutionLimits{} }
+func (m *QueryExecutionLimits) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionLimits) ProtoMessage()    {}
+func (*QueryExecutionLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{19}
+}
+func (m *QueryExecutionLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryExecutionLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionLimits.Merge(m, src)
+}
+func (m *QueryExecutionLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionLimits.DiscardUnknown(m)
 }
 
-func (m *QueryAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryExecutionLimits proto.InternalMessageInfo
+
+type QueryExecutionLimitsResponse struct {
+	Limits ExecutionLimits `protobuf:"bytes,1,opt,name=limits,proto3" json:"limits"`
 }
 
-func (m *QueryAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fallback) > 0 {
-		i -= len(m.Fallback)
-		copy(dAtA[i:], m.Fallback)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fallback)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryExecutionLimitsResponse) Reset()         { *m = QueryExecutionLimitsResponse{} }
+func (m *QueryExecutionLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExecutionLimitsResponse) ProtoMessage()    {}
+func (*QueryExecutionLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{20}
+}
+func (m *QueryExecutionLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExecutionLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExecutionLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExecutionLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExecutionLimitsResponse.Merge(m, src)
+}
+func (m *QueryExecutionLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExecutionLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExecutionLimitsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExecutionLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryExecutionLimitsResponse) GetLimits() ExecutionLimits {
+	if m != nil {
+		return m.Limits
 	}
-	return dAtA[:n], nil
+	return ExecutionLimits{}
 }
 
-func (m *QueryAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryQueueDepth struct {
 }
 
-func (m *QueryAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Exists {
-		i--
-		if m.Exists {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryQueueDepth) Reset()         { *m = QueryQueueDepth{} }
+func (m *QueryQueueDepth) String() string { return proto.CompactTextString(m) }
+func (*QueryQueueDepth) ProtoMessage()    {}
+func (*QueryQueueDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{21}
+}
+func (m *QueryQueueDepth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueueDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueueDepth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryQueueDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueueDepth.Merge(m, src)
+}
+func (m *QueryQueueDepth) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueueDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueueDepth.DiscardUnknown(m)
 }
 
-func (m *QueryStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryQueueDepth proto.InternalMessageInfo
+
+type QueryQueueDepthResponse struct {
+	Depth uint64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *QueryQueueDepthResponse) Reset()         { *m = QueryQueueDepthResponse{} }
+func (m *QueryQueueDepthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryQueueDepthResponse) ProtoMessage()    {}
+func (*QueryQueueDepthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{22}
+}
+func (m *QueryQueueDepthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryQueueDepthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryQueueDepthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryQueueDepthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryQueueDepthResponse.Merge(m, src)
+}
+func (m *QueryQueueDepthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryQueueDepthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryQueueDepthResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryQueueDepthResponse proto.InternalMessageInfo
+
+func (m *QueryQueueDepthResponse) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
 }
 
-func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryFeeSchedule struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeSchedule) Reset()         { *m = QueryFeeSchedule{} }
+func (m *QueryFeeSchedule) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeSchedule) ProtoMessage()    {}
+func (*QueryFeeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{23}
+}
+func (m *QueryFeeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeSchedule.Merge(m, src)
+}
+func (m *QueryFeeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeSchedule.DiscardUnknown(m)
 }
 
-func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeeSchedule proto.InternalMessageInfo
+
+type QueryFeeScheduleResponse struct {
+	Schedule FeeSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
 }
 
-func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Stats) > 0 {
-		for k := range m.Stats {
-			v := m.Stats[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFeeScheduleResponse) Reset()         { *m = QueryFeeScheduleResponse{} }
+func (m *QueryFeeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeScheduleResponse) ProtoMessage()    {}
+func (*QueryFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{24}
+}
+func (m *QueryFeeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeScheduleResponse.Merge(m, src)
+}
+func (m *QueryFeeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeScheduleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatsByChannel) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryFeeScheduleResponse) GetSchedule() FeeSchedule {
+	if m != nil {
+		return m.Schedule
 	}
-	return dAtA[:n], nil
+	return FeeSchedule{}
 }
 
-func (m *QueryStatsByChannel) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFeeRecipient struct {
 }
 
-func (m *QueryStatsByChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Channel) > 0 {
-		i -= len(m.Channel)
-		copy(dAtA[i:], m.Channel)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryFeeRecipient) Reset()         { *m = QueryFeeRecipient{} }
+func (m *QueryFeeRecipient) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRecipient) ProtoMessage()    {}
+func (*QueryFeeRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{25}
 }
-
-func (m *QueryStatsByChannelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryFeeRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRecipient.Merge(m, src)
+}
+func (m *QueryFeeRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRecipient.DiscardUnknown(m)
 }
 
-func (m *QueryStatsByChannelResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryFeeRecipient proto.InternalMessageInfo
+
+type QueryFeeRecipientResponse struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (m *QueryStatsByChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalForwarded) > 0 {
-		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryFeeRecipientResponse) Reset()         { *m = QueryFeeRecipientResponse{} }
+func (m *QueryFeeRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRecipientResponse) ProtoMessage()    {}
+func (*QueryFeeRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{26}
+}
+func (m *QueryFeeRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NumOfForwards != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.NumOfAccounts != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRecipientResponse.Merge(m, src)
+}
+func (m *QueryFeeRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRecipientResponse.DiscardUnknown(m)
 }
 
-func (m *Stats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRecipientResponse) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRelayerFee struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalForwarded) > 0 {
-		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *QueryRelayerFee) Reset()         { *m = QueryRelayerFee{} }
+func (m *QueryRelayerFee) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerFee) ProtoMessage()    {}
+func (*QueryRelayerFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{27}
+}
+func (m *QueryRelayerFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NumOfForwards != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.NumOfAccounts != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryRelayerFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerFee.Merge(m, src)
+}
+func (m *QueryRelayerFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRelayerFee proto.InternalMessageInfo
+
+type QueryRelayerFeeResponse struct {
+	Fee RelayerFee `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *QueryRelayerFeeResponse) Reset()         { *m = QueryRelayerFeeResponse{} }
+func (m *QueryRelayerFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelayerFeeResponse) ProtoMessage()    {}
+func (*QueryRelayerFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{28}
+}
+func (m *QueryRelayerFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelayerFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelayerFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRelayerFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelayerFeeResponse.Merge(m, src)
+}
+func (m *QueryRelayerFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelayerFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelayerFeeResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryRelayerFeeResponse proto.InternalMessageInfo
+
+func (m *QueryRelayerFeeResponse) GetFee() RelayerFee {
+	if m != nil {
+		return m.Fee
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return RelayerFee{}
 }
-func (m *QueryDenoms) Size() (n int) {
-	if m == nil {
-		return 0
+
+type QueryRetries struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRetries) Reset()         { *m = QueryRetries{} }
+func (m *QueryRetries) String() string { return proto.CompactTextString(m) }
+func (*QueryRetries) ProtoMessage()    {}
+func (*QueryRetries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{29}
+}
+func (m *QueryRetries) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetries.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryRetries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetries.Merge(m, src)
+}
+func (m *QueryRetries) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetries) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetries.DiscardUnknown(m)
 }
 
-func (m *QueryDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRetries proto.InternalMessageInfo
+
+func (m *QueryRetries) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.AllowedDenoms) > 0 {
-		for _, s := range m.AllowedDenoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryRetriesResponse struct {
+	Retries    []ForwardRetry      `protobuf:"bytes,1,rep,name=retries,proto3" json:"retries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRetriesResponse) Reset()         { *m = QueryRetriesResponse{} }
+func (m *QueryRetriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRetriesResponse) ProtoMessage()    {}
+func (*QueryRetriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{30}
+}
+func (m *QueryRetriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRetriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRetriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryRetriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRetriesResponse.Merge(m, src)
+}
+func (m *QueryRetriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRetriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRetriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAddress) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRetriesResponse proto.InternalMessageInfo
+
+func (m *QueryRetriesResponse) GetRetries() []ForwardRetry {
+	if m != nil {
+		return m.Retries
 	}
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryRetriesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryFallbackGracePeriod struct {
+}
+
+func (m *QueryFallbackGracePeriod) Reset()         { *m = QueryFallbackGracePeriod{} }
+func (m *QueryFallbackGracePeriod) String() string { return proto.CompactTextString(m) }
+func (*QueryFallbackGracePeriod) ProtoMessage()    {}
+func (*QueryFallbackGracePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{31}
+}
+func (m *QueryFallbackGracePeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFallbackGracePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFallbackGracePeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Fallback)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryFallbackGracePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFallbackGracePeriod.Merge(m, src)
+}
+func (m *QueryFallbackGracePeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFallbackGracePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFallbackGracePeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFallbackGracePeriod proto.InternalMessageInfo
+
+type QueryFallbackGracePeriodResponse struct {
+	GracePeriod uint64 `protobuf:"varint,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+}
+
+func (m *QueryFallbackGracePeriodResponse) Reset()         { *m = QueryFallbackGracePeriodResponse{} }
+func (m *QueryFallbackGracePeriodResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFallbackGracePeriodResponse) ProtoMessage()    {}
+func (*QueryFallbackGracePeriodResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{32}
+}
+func (m *QueryFallbackGracePeriodResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFallbackGracePeriodResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFallbackGracePeriodResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryFallbackGracePeriodResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFallbackGracePeriodResponse.Merge(m, src)
+}
+func (m *QueryFallbackGracePeriodResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFallbackGracePeriodResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFallbackGracePeriodResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryFallbackGracePeriodResponse proto.InternalMessageInfo
+
+func (m *QueryFallbackGracePeriodResponse) GetGracePeriod() uint64 {
+	if m != nil {
+		return m.GracePeriod
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+type QueryBlockedForwards struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedForwards) Reset()         { *m = QueryBlockedForwards{} }
+func (m *QueryBlockedForwards) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedForwards) ProtoMessage()    {}
+func (*QueryBlockedForwards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{33}
+}
+func (m *QueryBlockedForwards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedForwards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedForwards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Exists {
-		n += 2
+}
+func (m *QueryBlockedForwards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedForwards.Merge(m, src)
+}
+func (m *QueryBlockedForwards) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedForwards) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedForwards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockedForwards proto.InternalMessageInfo
+
+func (m *QueryBlockedForwards) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryStats) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryBlockedForwardsResponse struct {
+	BlockedForwards []BlockedForward    `protobuf:"bytes,1,rep,name=blocked_forwards,json=blockedForwards,proto3" json:"blocked_forwards"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockedForwardsResponse) Reset()         { *m = QueryBlockedForwardsResponse{} }
+func (m *QueryBlockedForwardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockedForwardsResponse) ProtoMessage()    {}
+func (*QueryBlockedForwardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{34}
+}
+func (m *QueryBlockedForwardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockedForwardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockedForwardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryBlockedForwardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockedForwardsResponse.Merge(m, src)
+}
+func (m *QueryBlockedForwardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockedForwardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockedForwardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBlockedForwardsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockedForwardsResponse) GetBlockedForwards() []BlockedForward {
+	if m != nil {
+		return m.BlockedForwards
 	}
-	var l int
-	_ = l
-	if len(m.Stats) > 0 {
-		for k, v := range m.Stats {
-			_ = k
-			_ = v
-			l = v.Size()
+	return nil
+}
+
+func (m *QueryBlockedForwardsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPausedChannels struct {
+}
+
+func (m *QueryPausedChannels) Reset()         { *m = QueryPausedChannels{} }
+func (m *QueryPausedChannels) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedChannels) ProtoMessage()    {}
+func (*QueryPausedChannels) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{35}
+}
+func (m *QueryPausedChannels) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedChannels) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedChannels.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedChannels) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedChannels.Merge(m, src)
+}
+func (m *QueryPausedChannels) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedChannels) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedChannels.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedChannels proto.InternalMessageInfo
+
+type QueryPausedChannelsResponse struct {
+	PausedChannels []PausedChannel `protobuf:"bytes,1,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels"`
+}
+
+func (m *QueryPausedChannelsResponse) Reset()         { *m = QueryPausedChannelsResponse{} }
+func (m *QueryPausedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedChannelsResponse) ProtoMessage()    {}
+func (*QueryPausedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{36}
+}
+func (m *QueryPausedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedChannelsResponse.Merge(m, src)
+}
+func (m *QueryPausedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedChannelsResponse) GetPausedChannels() []PausedChannel {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+type QueryCircuitBreaker struct {
+}
+
+func (m *QueryCircuitBreaker) Reset()         { *m = QueryCircuitBreaker{} }
+func (m *QueryCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreaker) ProtoMessage()    {}
+func (*QueryCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{37}
+}
+func (m *QueryCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreaker.Merge(m, src)
+}
+func (m *QueryCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreaker proto.InternalMessageInfo
+
+type QueryCircuitBreakerResponse struct {
+	Enabled  bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Guardian string `protobuf:"bytes,2,opt,name=guardian,proto3" json:"guardian,omitempty"`
+}
+
+func (m *QueryCircuitBreakerResponse) Reset()         { *m = QueryCircuitBreakerResponse{} }
+func (m *QueryCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCircuitBreakerResponse) ProtoMessage()    {}
+func (*QueryCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{38}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCircuitBreakerResponse.Merge(m, src)
+}
+func (m *QueryCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCircuitBreakerResponse proto.InternalMessageInfo
+
+func (m *QueryCircuitBreakerResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *QueryCircuitBreakerResponse) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+type QueryRateLimits struct {
+}
+
+func (m *QueryRateLimits) Reset()         { *m = QueryRateLimits{} }
+func (m *QueryRateLimits) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimits) ProtoMessage()    {}
+func (*QueryRateLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{39}
+}
+func (m *QueryRateLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimits.Merge(m, src)
+}
+func (m *QueryRateLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimits proto.InternalMessageInfo
+
+type QueryRateLimitsResponse struct {
+	RateLimits []RateLimit `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+}
+
+func (m *QueryRateLimitsResponse) Reset()         { *m = QueryRateLimitsResponse{} }
+func (m *QueryRateLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitsResponse) ProtoMessage()    {}
+func (*QueryRateLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{40}
+}
+func (m *QueryRateLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitsResponse.Merge(m, src)
+}
+func (m *QueryRateLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitsResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitsResponse) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+type QueryRateLimitUsage struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryRateLimitUsage) Reset()         { *m = QueryRateLimitUsage{} }
+func (m *QueryRateLimitUsage) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitUsage) ProtoMessage()    {}
+func (*QueryRateLimitUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{41}
+}
+func (m *QueryRateLimitUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitUsage.Merge(m, src)
+}
+func (m *QueryRateLimitUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitUsage proto.InternalMessageInfo
+
+type QueryRateLimitUsageResponse struct {
+	Limit     RateLimit             `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit"`
+	Usage     RateLimitUsage        `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage"`
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+}
+
+func (m *QueryRateLimitUsageResponse) Reset()         { *m = QueryRateLimitUsageResponse{} }
+func (m *QueryRateLimitUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRateLimitUsageResponse) ProtoMessage()    {}
+func (*QueryRateLimitUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{42}
+}
+func (m *QueryRateLimitUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRateLimitUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRateLimitUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRateLimitUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRateLimitUsageResponse.Merge(m, src)
+}
+func (m *QueryRateLimitUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRateLimitUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRateLimitUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRateLimitUsageResponse proto.InternalMessageInfo
+
+func (m *QueryRateLimitUsageResponse) GetLimit() RateLimit {
+	if m != nil {
+		return m.Limit
+	}
+	return RateLimit{}
+}
+
+func (m *QueryRateLimitUsageResponse) GetUsage() RateLimitUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return RateLimitUsage{}
+}
+
+type QueryHeldDeposits struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryHeldDeposits) Reset()         { *m = QueryHeldDeposits{} }
+func (m *QueryHeldDeposits) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldDeposits) ProtoMessage()    {}
+func (*QueryHeldDeposits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{43}
+}
+func (m *QueryHeldDeposits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldDeposits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldDeposits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeldDeposits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldDeposits.Merge(m, src)
+}
+func (m *QueryHeldDeposits) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldDeposits) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldDeposits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeldDeposits proto.InternalMessageInfo
+
+type QueryHeldDepositsResponse struct {
+	HeldDeposits []HeldDeposit `protobuf:"bytes,1,rep,name=held_deposits,json=heldDeposits,proto3" json:"held_deposits"`
+}
+
+func (m *QueryHeldDepositsResponse) Reset()         { *m = QueryHeldDepositsResponse{} }
+func (m *QueryHeldDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHeldDepositsResponse) ProtoMessage()    {}
+func (*QueryHeldDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{44}
+}
+func (m *QueryHeldDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHeldDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHeldDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHeldDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHeldDepositsResponse.Merge(m, src)
+}
+func (m *QueryHeldDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHeldDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHeldDepositsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHeldDepositsResponse proto.InternalMessageInfo
+
+func (m *QueryHeldDepositsResponse) GetHeldDeposits() []HeldDeposit {
+	if m != nil {
+		return m.HeldDeposits
+	}
+	return nil
+}
+
+type QueryBatch struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryBatch) Reset()         { *m = QueryBatch{} }
+func (m *QueryBatch) String() string { return proto.CompactTextString(m) }
+func (*QueryBatch) ProtoMessage()    {}
+func (*QueryBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{45}
+}
+func (m *QueryBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatch.Merge(m, src)
+}
+func (m *QueryBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatch proto.InternalMessageInfo
+
+type QueryBatchResponse struct {
+	Batch  Batch `protobuf:"bytes,1,opt,name=batch,proto3" json:"batch"`
+	Exists bool  `protobuf:"varint,2,opt,name=exists,proto3" json:"exists,omitempty"`
+}
+
+func (m *QueryBatchResponse) Reset()         { *m = QueryBatchResponse{} }
+func (m *QueryBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchResponse) ProtoMessage()    {}
+func (*QueryBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{46}
+}
+func (m *QueryBatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchResponse.Merge(m, src)
+}
+func (m *QueryBatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchResponse proto.InternalMessageInfo
+
+func (m *QueryBatchResponse) GetBatch() Batch {
+	if m != nil {
+		return m.Batch
+	}
+	return Batch{}
+}
+
+func (m *QueryBatchResponse) GetExists() bool {
+	if m != nil {
+		return m.Exists
+	}
+	return false
+}
+
+type QueryChannelDenoms struct {
+	Channel string `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+}
+
+func (m *QueryChannelDenoms) Reset()         { *m = QueryChannelDenoms{} }
+func (m *QueryChannelDenoms) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelDenoms) ProtoMessage()    {}
+func (*QueryChannelDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{47}
+}
+func (m *QueryChannelDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelDenoms.Merge(m, src)
+}
+func (m *QueryChannelDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelDenoms proto.InternalMessageInfo
+
+type QueryChannelDenomsResponse struct {
+	AllowedDenoms []string `protobuf:"bytes,1,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	Scoped        bool     `protobuf:"varint,2,opt,name=scoped,proto3" json:"scoped,omitempty"`
+}
+
+func (m *QueryChannelDenomsResponse) Reset()         { *m = QueryChannelDenomsResponse{} }
+func (m *QueryChannelDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelDenomsResponse) ProtoMessage()    {}
+func (*QueryChannelDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{48}
+}
+func (m *QueryChannelDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelDenomsResponse.Merge(m, src)
+}
+func (m *QueryChannelDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelDenomsResponse) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *QueryChannelDenomsResponse) GetScoped() bool {
+	if m != nil {
+		return m.Scoped
+	}
+	return false
+}
+
+type QueryUnwindOnly struct {
+}
+
+func (m *QueryUnwindOnly) Reset()         { *m = QueryUnwindOnly{} }
+func (m *QueryUnwindOnly) String() string { return proto.CompactTextString(m) }
+func (*QueryUnwindOnly) ProtoMessage()    {}
+func (*QueryUnwindOnly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{49}
+}
+func (m *QueryUnwindOnly) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnwindOnly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnwindOnly.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnwindOnly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnwindOnly.Merge(m, src)
+}
+func (m *QueryUnwindOnly) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnwindOnly) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnwindOnly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnwindOnly proto.InternalMessageInfo
+
+type QueryUnwindOnlyResponse struct {
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *QueryUnwindOnlyResponse) Reset()         { *m = QueryUnwindOnlyResponse{} }
+func (m *QueryUnwindOnlyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnwindOnlyResponse) ProtoMessage()    {}
+func (*QueryUnwindOnlyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{50}
+}
+func (m *QueryUnwindOnlyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnwindOnlyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnwindOnlyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnwindOnlyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnwindOnlyResponse.Merge(m, src)
+}
+func (m *QueryUnwindOnlyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnwindOnlyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnwindOnlyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnwindOnlyResponse proto.InternalMessageInfo
+
+func (m *QueryUnwindOnlyResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type Stats struct {
+	ChainId        string                                   `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	NumOfAccounts  uint64                                   `protobuf:"varint,2,opt,name=num_of_accounts,json=numOfAccounts,proto3" json:"num_of_accounts,omitempty"`
+	NumOfForwards  uint64                                   `protobuf:"varint,3,opt,name=num_of_forwards,json=numOfForwards,proto3" json:"num_of_forwards,omitempty"`
+	TotalForwarded github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_forwarded,json=totalForwarded,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_forwarded"`
+	NumOfDeferrals map[string]uint64                        `protobuf:"bytes,5,rep,name=num_of_deferrals,json=numOfDeferrals,proto3" json:"num_of_deferrals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	TotalFees      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=total_fees,json=totalFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_fees"`
+}
+
+func (m *Stats) Reset()         { *m = Stats{} }
+func (m *Stats) String() string { return proto.CompactTextString(m) }
+func (*Stats) ProtoMessage()    {}
+func (*Stats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fc601bfb5b0b1c63, []int{51}
+}
+func (m *Stats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stats.Merge(m, src)
+}
+func (m *Stats) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stats) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stats proto.InternalMessageInfo
+
+func (m *Stats) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
+}
+
+func (m *Stats) GetNumOfAccounts() uint64 {
+	if m != nil {
+		return m.NumOfAccounts
+	}
+	return 0
+}
+
+func (m *Stats) GetNumOfForwards() uint64 {
+	if m != nil {
+		return m.NumOfForwards
+	}
+	return 0
+}
+
+func (m *Stats) GetTotalForwarded() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalForwarded
+	}
+	return nil
+}
+
+func (m *Stats) GetNumOfDeferrals() map[string]uint64 {
+	if m != nil {
+		return m.NumOfDeferrals
+	}
+	return nil
+}
+
+func (m *Stats) GetTotalFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalFees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryDenoms)(nil), "noble.forwarding.v1.QueryDenoms")
+	proto.RegisterType((*QueryDenomsResponse)(nil), "noble.forwarding.v1.QueryDenomsResponse")
+	proto.RegisterType((*QueryAddress)(nil), "noble.forwarding.v1.QueryAddress")
+	proto.RegisterType((*QueryAddressResponse)(nil), "noble.forwarding.v1.QueryAddressResponse")
+	proto.RegisterType((*QueryStats)(nil), "noble.forwarding.v1.QueryStats")
+	proto.RegisterType((*QueryStatsResponse)(nil), "noble.forwarding.v1.QueryStatsResponse")
+	proto.RegisterMapType((map[string]Stats)(nil), "noble.forwarding.v1.QueryStatsResponse.StatsEntry")
+	proto.RegisterType((*QueryStatsByChannel)(nil), "noble.forwarding.v1.QueryStatsByChannel")
+	proto.RegisterType((*QueryStatsByChannelResponse)(nil), "noble.forwarding.v1.QueryStatsByChannelResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.QueryStatsByChannelResponse.NumOfDeferralsEntry")
+	proto.RegisterType((*QueryRefundPolicy)(nil), "noble.forwarding.v1.QueryRefundPolicy")
+	proto.RegisterType((*QueryRefundPolicyResponse)(nil), "noble.forwarding.v1.QueryRefundPolicyResponse")
+	proto.RegisterType((*QueryTimeoutPolicy)(nil), "noble.forwarding.v1.QueryTimeoutPolicy")
+	proto.RegisterType((*QueryTimeoutPolicyResponse)(nil), "noble.forwarding.v1.QueryTimeoutPolicyResponse")
+	proto.RegisterType((*QueryTimeoutPolicies)(nil), "noble.forwarding.v1.QueryTimeoutPolicies")
+	proto.RegisterType((*QueryTimeoutPoliciesResponse)(nil), "noble.forwarding.v1.QueryTimeoutPoliciesResponse")
+	proto.RegisterType((*ChannelTimeoutPolicy)(nil), "noble.forwarding.v1.ChannelTimeoutPolicy")
+	proto.RegisterType((*QueryMaxMemoLength)(nil), "noble.forwarding.v1.QueryMaxMemoLength")
+	proto.RegisterType((*QueryMaxMemoLengthResponse)(nil), "noble.forwarding.v1.QueryMaxMemoLengthResponse")
+	proto.RegisterType((*QueryMinForwardAmount)(nil), "noble.forwarding.v1.QueryMinForwardAmount")
+	proto.RegisterType((*QueryMinForwardAmountResponse)(nil), "noble.forwarding.v1.QueryMinForwardAmountResponse")
+	proto.RegisterType((*QueryExecutionLimits)(nil), "noble.forwarding.v1.QueryExecutionLimits")
+	proto.RegisterType((*QueryExecutionLimitsResponse)(nil), "noble.forwarding.v1.QueryExecutionLimitsResponse")
+	proto.RegisterType((*QueryQueueDepth)(nil), "noble.forwarding.v1.QueryQueueDepth")
+	proto.RegisterType((*QueryQueueDepthResponse)(nil), "noble.forwarding.v1.QueryQueueDepthResponse")
+	proto.RegisterType((*QueryFeeSchedule)(nil), "noble.forwarding.v1.QueryFeeSchedule")
+	proto.RegisterType((*QueryFeeScheduleResponse)(nil), "noble.forwarding.v1.QueryFeeScheduleResponse")
+	proto.RegisterType((*QueryFeeRecipient)(nil), "noble.forwarding.v1.QueryFeeRecipient")
+	proto.RegisterType((*QueryFeeRecipientResponse)(nil), "noble.forwarding.v1.QueryFeeRecipientResponse")
+	proto.RegisterType((*QueryRelayerFee)(nil), "noble.forwarding.v1.QueryRelayerFee")
+	proto.RegisterType((*QueryRelayerFeeResponse)(nil), "noble.forwarding.v1.QueryRelayerFeeResponse")
+	proto.RegisterType((*QueryRetries)(nil), "noble.forwarding.v1.QueryRetries")
+	proto.RegisterType((*QueryRetriesResponse)(nil), "noble.forwarding.v1.QueryRetriesResponse")
+	proto.RegisterType((*QueryFallbackGracePeriod)(nil), "noble.forwarding.v1.QueryFallbackGracePeriod")
+	proto.RegisterType((*QueryFallbackGracePeriodResponse)(nil), "noble.forwarding.v1.QueryFallbackGracePeriodResponse")
+	proto.RegisterType((*QueryBlockedForwards)(nil), "noble.forwarding.v1.QueryBlockedForwards")
+	proto.RegisterType((*QueryBlockedForwardsResponse)(nil), "noble.forwarding.v1.QueryBlockedForwardsResponse")
+	proto.RegisterType((*QueryPausedChannels)(nil), "noble.forwarding.v1.QueryPausedChannels")
+	proto.RegisterType((*QueryPausedChannelsResponse)(nil), "noble.forwarding.v1.QueryPausedChannelsResponse")
+	proto.RegisterType((*QueryCircuitBreaker)(nil), "noble.forwarding.v1.QueryCircuitBreaker")
+	proto.RegisterType((*QueryCircuitBreakerResponse)(nil), "noble.forwarding.v1.QueryCircuitBreakerResponse")
+	proto.RegisterType((*QueryRateLimits)(nil), "noble.forwarding.v1.QueryRateLimits")
+	proto.RegisterType((*QueryRateLimitsResponse)(nil), "noble.forwarding.v1.QueryRateLimitsResponse")
+	proto.RegisterType((*QueryRateLimitUsage)(nil), "noble.forwarding.v1.QueryRateLimitUsage")
+	proto.RegisterType((*QueryRateLimitUsageResponse)(nil), "noble.forwarding.v1.QueryRateLimitUsageResponse")
+	proto.RegisterType((*QueryHeldDeposits)(nil), "noble.forwarding.v1.QueryHeldDeposits")
+	proto.RegisterType((*QueryHeldDepositsResponse)(nil), "noble.forwarding.v1.QueryHeldDepositsResponse")
+	proto.RegisterType((*QueryBatch)(nil), "noble.forwarding.v1.QueryBatch")
+	proto.RegisterType((*QueryBatchResponse)(nil), "noble.forwarding.v1.QueryBatchResponse")
+	proto.RegisterType((*QueryChannelDenoms)(nil), "noble.forwarding.v1.QueryChannelDenoms")
+	proto.RegisterType((*QueryChannelDenomsResponse)(nil), "noble.forwarding.v1.QueryChannelDenomsResponse")
+	proto.RegisterType((*QueryUnwindOnly)(nil), "noble.forwarding.v1.QueryUnwindOnly")
+	proto.RegisterType((*QueryUnwindOnlyResponse)(nil), "noble.forwarding.v1.QueryUnwindOnlyResponse")
+	proto.RegisterType((*Stats)(nil), "noble.forwarding.v1.Stats")
+	proto.RegisterMapType((map[string]uint64)(nil), "noble.forwarding.v1.Stats.NumOfDeferralsEntry")
+}
+
+func init() { proto.RegisterFile("noble/forwarding/v1/query.proto", fileDescriptor_fc601bfb5b0b1c63) }
+
+var fileDescriptor_fc601bfb5b0b1c63 = []byte{
+	// 2614 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5b, 0x8f, 0x1c, 0x47,
+	0x15, 0xde, 0xde, 0xfb, 0x9e, 0xbd, 0xa6, 0xbc, 0xc1, 0xe3, 0xde, 0xab, 0x3b, 0xbe, 0xcc, 0x3a,
+	0xbb, 0xd3, 0xbb, 0x03, 0xf1, 0x8d, 0x20, 0xe3, 0xf5, 0x7a, 0x6d, 0x07, 0xc7, 0x76, 0xc6, 0x4e,
+	0x10, 0x48, 0xd0, 0xea, 0xed, 0xa9, 0x9d, 0x6d, 0x6d, 0x4f, 0xf7, 0xb8, 0x2f, 0xb6, 0x57, 0x96,
+	0x11, 0x8a, 0x78, 0x88, 0x78, 0x01, 0x89, 0x87, 0x88, 0x9b, 0x08, 0x8a, 0x10, 0x88, 0x27, 0x0b,
+	0xf9, 0x05, 0x78, 0x43, 0x42, 0xca, 0x03, 0x0f, 0x51, 0x90, 0x10, 0xe2, 0x21, 0x20, 0x1b, 0x29,
+	0xfc, 0x00, 0x7e, 0x00, 0xea, 0xaa, 0xea, 0xea, 0xea, 0x9e, 0x9e, 0x9e, 0x9e, 0xc4, 0x02, 0xf1,
+	0x62, 0x6f, 0x9f, 0xfa, 0x4e, 0xd5, 0x57, 0xa7, 0x4e, 0x9d, 0xaa, 0xfa, 0x76, 0x61, 0xc9, 0x76,
+	0x76, 0x2c, 0xac, 0xee, 0x3a, 0xee, 0x7d, 0xdd, 0xad, 0x9b, 0x76, 0x43, 0xbd, 0xb7, 0xa1, 0xde,
+	0x0d, 0xb0, 0x7b, 0x50, 0x69, 0xb9, 0x8e, 0xef, 0xa0, 0x43, 0x04, 0x50, 0x89, 0x01, 0x95, 0x7b,
+	0x1b, 0xf2, 0x0b, 0x7a, 0xd3, 0xb4, 0x1d, 0x95, 0xfc, 0x4b, 0x71, 0xf2, 0x29, 0xc3, 0xf1, 0x9a,
+	0x8e, 0xa7, 0xee, 0xe8, 0x1e, 0xa6, 0x1d, 0xa8, 0xf7, 0x36, 0x76, 0xb0, 0xaf, 0x6f, 0xa8, 0x2d,
+	0xbd, 0x61, 0xda, 0xba, 0x6f, 0x3a, 0x36, 0xc3, 0x2e, 0x8a, 0xd8, 0x08, 0x65, 0x38, 0x66, 0xd4,
+	0x3e, 0xc7, 0xda, 0xa3, 0x6e, 0x44, 0x42, 0xf2, 0x11, 0xda, 0xa8, 0x91, 0x2f, 0x95, 0x7e, 0xb0,
+	0xa6, 0xd9, 0x86, 0xd3, 0x70, 0xa8, 0x3d, 0xfc, 0x89, 0x59, 0xe7, 0x1b, 0x8e, 0xd3, 0xb0, 0xb0,
+	0xaa, 0xb7, 0x4c, 0x55, 0xb7, 0x6d, 0xc7, 0x27, 0x54, 0x22, 0x9f, 0xa3, 0x59, 0x01, 0xd0, 0x0d,
+	0xc3, 0x09, 0x6c, 0x9f, 0x41, 0x32, 0x63, 0xe4, 0xf9, 0xba, 0x8f, 0x29, 0x40, 0x99, 0x84, 0xf1,
+	0x37, 0x42, 0x86, 0x5b, 0xd8, 0x76, 0x9a, 0x9e, 0x72, 0x17, 0x0e, 0x09, 0x9f, 0x35, 0xec, 0xb5,
+	0x1c, 0xdb, 0xc3, 0x68, 0x15, 0xa6, 0x74, 0xcb, 0x72, 0xee, 0xe3, 0xba, 0x56, 0x27, 0x2d, 0x25,
+	0x69, 0x79, 0xa0, 0x3c, 0xb6, 0x39, 0xf4, 0xab, 0x4f, 0x1e, 0x9f, 0x92, 0x6a, 0x93, 0xac, 0x91,
+	0x7a, 0x85, 0xe8, 0x1d, 0xcb, 0x31, 0xf6, 0x63, 0x74, 0x7f, 0x02, 0xcd, 0x1a, 0xd9, 0x90, 0x7f,
+	0x1a, 0x84, 0x09, 0x32, 0xe6, 0xc5, 0x7a, 0xdd, 0xc5, 0x9e, 0x87, 0x4a, 0x30, 0x62, 0xec, 0xe9,
+	0xb6, 0x8d, 0xad, 0x92, 0xb4, 0x2c, 0x95, 0xc7, 0x6a, 0xd1, 0x27, 0x9a, 0x87, 0x31, 0x17, 0x1b,
+	0x66, 0xcb, 0xc4, 0xb6, 0x5f, 0xea, 0x27, 0x6d, 0xb1, 0x01, 0xc9, 0x30, 0xba, 0xab, 0x5b, 0xd6,
+	0x8e, 0x6e, 0xec, 0x97, 0x06, 0x48, 0x23, 0xff, 0x46, 0x08, 0x06, 0x9b, 0xb8, 0xe9, 0x94, 0x06,
+	0x89, 0x9d, 0xfc, 0x8c, 0xaa, 0x30, 0xb8, 0xe7, 0xb4, 0xbc, 0xd2, 0xd0, 0xf2, 0x40, 0x79, 0xbc,
+	0x5a, 0xaa, 0x64, 0x64, 0x4b, 0xe5, 0xaa, 0xd3, 0xda, 0x1c, 0xfc, 0xe0, 0xe3, 0xa5, 0xbe, 0x1a,
+	0xc1, 0xa2, 0x0b, 0x30, 0xea, 0x19, 0x7b, 0xb8, 0x1e, 0x58, 0xb8, 0x34, 0xbc, 0x2c, 0x95, 0xc7,
+	0xab, 0x0b, 0x99, 0x7e, 0xb7, 0x19, 0x88, 0x39, 0x73, 0x27, 0x74, 0x16, 0x86, 0xbd, 0x96, 0x65,
+	0xfa, 0x5e, 0x69, 0x84, 0x0c, 0x2b, 0x67, 0xbb, 0x87, 0x10, 0xe6, 0xcb, 0xf0, 0xe1, 0xd0, 0x3b,
+	0xba, 0x6f, 0xec, 0x99, 0x76, 0xa3, 0x34, 0x9a, 0x33, 0xf4, 0x26, 0x03, 0x45, 0x43, 0x47, 0x4e,
+	0xe8, 0x2a, 0x4c, 0x90, 0xe5, 0xd0, 0x5c, 0x27, 0xf0, 0xb1, 0x57, 0x1a, 0x23, 0x04, 0x96, 0x32,
+	0x3b, 0x21, 0x6b, 0x53, 0x0b, 0x71, 0xac, 0x9b, 0xf1, 0x3a, 0xb7, 0x78, 0xe8, 0x78, 0x5b, 0x3a,
+	0x40, 0xb8, 0xc0, 0xe9, 0x3c, 0x58, 0x82, 0xf1, 0xc0, 0xbe, 0x6f, 0xda, 0x75, 0xcd, 0xb1, 0xad,
+	0x83, 0xd2, 0xf8, 0xb2, 0x54, 0x1e, 0xad, 0x01, 0x35, 0xdd, 0xb4, 0xad, 0x03, 0xb4, 0x02, 0x33,
+	0x75, 0xec, 0xf9, 0x6c, 0x87, 0x69, 0xfe, 0x41, 0x0b, 0x97, 0x26, 0xc8, 0x0a, 0x4d, 0x0b, 0xf6,
+	0x3b, 0x07, 0x2d, 0x8c, 0x16, 0x00, 0x08, 0x6d, 0xad, 0xae, 0xfb, 0x7a, 0x69, 0x72, 0x59, 0x2a,
+	0x4f, 0xd4, 0xc6, 0x88, 0x65, 0x4b, 0xf7, 0xf5, 0xf3, 0xa3, 0xef, 0xbc, 0xb7, 0xd4, 0xf7, 0xaf,
+	0xf7, 0x96, 0xfa, 0x14, 0x13, 0x66, 0xc5, 0x6c, 0xe2, 0x29, 0x5c, 0x85, 0x11, 0x9d, 0x9a, 0x68,
+	0x56, 0x6d, 0x96, 0x3e, 0x7a, 0xb2, 0x36, 0xcb, 0xf6, 0x20, 0x03, 0xdf, 0xf6, 0x5d, 0xd3, 0x6e,
+	0xd4, 0x22, 0x20, 0x5a, 0x80, 0x61, 0xfc, 0xc0, 0xf4, 0x7c, 0x8f, 0x24, 0xdb, 0x68, 0x94, 0xc0,
+	0xcc, 0xa8, 0x4c, 0x00, 0x90, 0xa1, 0x6e, 0xfb, 0xba, 0xef, 0x29, 0xbf, 0x93, 0x00, 0xc5, 0x9f,
+	0x7c, 0xdc, 0x1b, 0x30, 0x14, 0xee, 0x37, 0xba, 0x63, 0xc6, 0xab, 0xd5, 0xcc, 0x70, 0xb7, 0xfb,
+	0x55, 0xc8, 0xd7, 0x65, 0xdb, 0x77, 0x0f, 0xd8, 0x0a, 0xd0, 0x6e, 0xe4, 0x3b, 0x00, 0x71, 0x13,
+	0x9a, 0x81, 0x81, 0x7d, 0x7c, 0xc0, 0xf6, 0x49, 0xf8, 0x23, 0x5a, 0x87, 0xa1, 0x7b, 0xba, 0x15,
+	0x60, 0x42, 0xb9, 0x63, 0x7e, 0x91, 0xa1, 0x28, 0xf0, 0x7c, 0xff, 0x59, 0x49, 0x39, 0xc7, 0xf6,
+	0x3d, 0x69, 0xd8, 0x3c, 0xb8, 0xc4, 0x36, 0x5c, 0xc7, 0xad, 0x28, 0x04, 0xfc, 0x8f, 0x83, 0x30,
+	0x97, 0xe1, 0xcb, 0x03, 0xb0, 0x06, 0xd3, 0x76, 0xd0, 0xd4, 0x9c, 0x5d, 0x8d, 0x95, 0x26, 0xba,
+	0x00, 0x83, 0xbc, 0x1c, 0xd8, 0x41, 0xf3, 0xe6, 0xee, 0x45, 0xd6, 0x26, 0xc0, 0x19, 0x65, 0x1a,
+	0xfc, 0x14, 0x7c, 0x9b, 0xb5, 0xa1, 0xef, 0x4a, 0x30, 0xed, 0x3b, 0xbe, 0x6e, 0x45, 0x70, 0x5c,
+	0x2f, 0x0d, 0x90, 0x48, 0x1f, 0xa9, 0xb0, 0xc5, 0x0d, 0x4b, 0x75, 0x85, 0x95, 0xea, 0xca, 0x25,
+	0xc7, 0xb4, 0x37, 0xb7, 0xc3, 0x80, 0xfe, 0xfa, 0xef, 0x4b, 0xe5, 0x86, 0xe9, 0xef, 0x05, 0x3b,
+	0x15, 0xc3, 0x69, 0xb2, 0x6a, 0xcc, 0xfe, 0x5b, 0xf3, 0xea, 0xfb, 0x6a, 0x98, 0x8e, 0x1e, 0x71,
+	0xf0, 0x7e, 0xf4, 0xc9, 0xe3, 0x53, 0x13, 0x16, 0x6e, 0xe8, 0xc6, 0x81, 0x16, 0x16, 0x7b, 0x8f,
+	0x72, 0x99, 0x22, 0x23, 0x6f, 0x47, 0x03, 0xa3, 0x00, 0x66, 0x18, 0xf7, 0x3a, 0xde, 0xc5, 0xae,
+	0xab, 0x5b, 0x5e, 0x69, 0x90, 0x90, 0xd9, 0xea, 0xb2, 0xec, 0x6d, 0x61, 0xab, 0xdc, 0x08, 0xa7,
+	0xb9, 0x15, 0x75, 0x43, 0x13, 0x81, 0x85, 0x60, 0xca, 0x4e, 0xb4, 0xa1, 0x6f, 0x4b, 0x00, 0x2c,
+	0x06, 0x18, 0x47, 0xf5, 0xec, 0xbf, 0x30, 0xfd, 0x31, 0x3a, 0x7d, 0x8c, 0x3d, 0xf9, 0x22, 0x1c,
+	0xca, 0x20, 0x9c, 0x91, 0x9e, 0xb3, 0x62, 0x7a, 0x0e, 0x8a, 0x29, 0x78, 0x08, 0x5e, 0x20, 0xf1,
+	0xa8, 0xe1, 0xdd, 0xc0, 0xae, 0xdf, 0x72, 0x2c, 0xd3, 0x38, 0x50, 0xbe, 0x01, 0x47, 0xda, 0x8c,
+	0x3c, 0xb3, 0xbe, 0x0c, 0xc3, 0x2d, 0x62, 0x21, 0x03, 0x4c, 0x55, 0x8f, 0x66, 0x06, 0x59, 0x74,
+	0xe5, 0x3b, 0x98, 0xfa, 0x29, 0x67, 0xd9, 0x96, 0xbd, 0x63, 0x36, 0xb1, 0x13, 0xf8, 0x14, 0x54,
+	0x28, 0xeb, 0x0d, 0x90, 0xdb, 0x3d, 0x39, 0xb3, 0xcb, 0x09, 0x66, 0xe3, 0x55, 0x25, 0x93, 0x59,
+	0xc2, 0x77, 0x73, 0x2c, 0x5c, 0x95, 0x24, 0xbd, 0x6f, 0xb2, 0x5a, 0x26, 0x02, 0x4d, 0xec, 0xa1,
+	0x6d, 0x80, 0xf8, 0x62, 0xc2, 0x86, 0x38, 0x91, 0x58, 0x6f, 0x7a, 0xeb, 0x88, 0x56, 0xfd, 0x96,
+	0xde, 0xc0, 0x35, 0x7c, 0x37, 0xc0, 0x9e, 0x5f, 0x13, 0x3c, 0x95, 0xdf, 0x4a, 0x30, 0x9f, 0x35,
+	0x00, 0x9f, 0xc7, 0x2d, 0x18, 0x6d, 0x31, 0x1b, 0xab, 0x5f, 0x2b, 0x99, 0x33, 0x61, 0xc9, 0xdb,
+	0x71, 0x42, 0xbc, 0x17, 0x74, 0x25, 0x41, 0x9d, 0xd6, 0xa8, 0x93, 0x5d, 0xa9, 0x53, 0x3a, 0x09,
+	0xee, 0xef, 0x4a, 0x30, 0x9b, 0x35, 0x6c, 0xce, 0xf5, 0x21, 0x5e, 0x95, 0xfe, 0xcf, 0xb0, 0x2a,
+	0x68, 0x11, 0xc0, 0x70, 0xec, 0x5d, 0xb3, 0x11, 0xb8, 0xa4, 0xd8, 0x90, 0x53, 0x2d, 0xb6, 0x28,
+	0xb3, 0x2c, 0xa9, 0x5e, 0xd7, 0x1f, 0xbc, 0x8e, 0x9b, 0xce, 0x75, 0x6c, 0x37, 0xfc, 0x3d, 0xe5,
+	0x2b, 0x20, 0xb7, 0x5b, 0xc5, 0x22, 0xd9, 0xd4, 0x1f, 0x68, 0xe1, 0xbd, 0x44, 0xb3, 0x48, 0x53,
+	0xaa, 0x48, 0x36, 0x13, 0x9d, 0x9d, 0x81, 0x17, 0x69, 0x67, 0xa6, 0xcd, 0xaa, 0xcf, 0xc5, 0x66,
+	0x58, 0x3e, 0xc3, 0xed, 0x45, 0x4e, 0x64, 0x36, 0x75, 0xfa, 0x91, 0x38, 0x1d, 0x17, 0x32, 0x1d,
+	0x39, 0x91, 0xab, 0x30, 0xac, 0x13, 0x0b, 0x3b, 0x25, 0xd7, 0xc3, 0xf9, 0xff, 0xed, 0xe3, 0xa5,
+	0x17, 0xe9, 0x12, 0x79, 0xf5, 0xfd, 0x8a, 0xe9, 0xa8, 0x4d, 0xdd, 0xdf, 0xab, 0x5c, 0xb3, 0xfd,
+	0x8f, 0x9e, 0xac, 0x01, 0x5b, 0xbb, 0x6b, 0xb6, 0xcf, 0xc2, 0x44, 0xfd, 0x95, 0xcf, 0xb1, 0xe4,
+	0xbd, 0xfc, 0x00, 0x1b, 0x41, 0xb8, 0x64, 0xd7, 0xcd, 0xa6, 0xe9, 0x7b, 0x4a, 0x03, 0xe6, 0xb3,
+	0xec, 0x9c, 0xc1, 0x15, 0x18, 0xb6, 0x88, 0x85, 0x25, 0xf6, 0xb1, 0xcc, 0x55, 0x4a, 0x79, 0x27,
+	0xd6, 0x89, 0xba, 0x2b, 0x2f, 0xc0, 0x34, 0x19, 0xe8, 0x8d, 0x00, 0x07, 0x78, 0x0b, 0xb7, 0xfc,
+	0x3d, 0xe5, 0x34, 0x1c, 0x4e, 0x99, 0xf8, 0xb0, 0x73, 0x61, 0xe4, 0x5a, 0xe9, 0xb8, 0x53, 0x9b,
+	0x52, 0x85, 0x19, 0xe2, 0xb7, 0x8d, 0x71, 0x74, 0xb3, 0xeb, 0x1a, 0x6a, 0x03, 0x4a, 0x69, 0x1f,
+	0x61, 0x8e, 0xf1, 0x35, 0x92, 0xce, 0x72, 0x39, 0x73, 0x96, 0x82, 0x6f, 0x62, 0x3b, 0x45, 0xce,
+	0xbc, 0x68, 0x6e, 0x63, 0x5c, 0x8b, 0x2e, 0xc2, 0xca, 0x6d, 0x38, 0xd2, 0x66, 0xe4, 0x43, 0x9f,
+	0x16, 0xef, 0xd0, 0xdd, 0x6e, 0x42, 0x31, 0x54, 0x79, 0x85, 0x45, 0xb3, 0x86, 0x2d, 0xfd, 0x00,
+	0xbb, 0xdb, 0x18, 0x17, 0xaa, 0x93, 0x5f, 0x85, 0xc3, 0x29, 0x37, 0xce, 0xe4, 0x55, 0x18, 0xd8,
+	0xc5, 0xd1, 0xfc, 0x97, 0x3a, 0xd4, 0xee, 0xc8, 0x4b, 0x9c, 0x7e, 0xe8, 0xa6, 0xbc, 0xc5, 0x5e,
+	0x0d, 0x35, 0xec, 0xbb, 0xcf, 0xb3, 0x26, 0xfe, 0x52, 0x82, 0x59, 0xb1, 0x63, 0x4e, 0x77, 0x1b,
+	0x46, 0x5c, 0x6a, 0x62, 0xa5, 0x30, 0xfb, 0xb8, 0x61, 0xdb, 0x2a, 0xf4, 0x4e, 0x54, 0x8f, 0xc8,
+	0xf9, 0xf9, 0x55, 0x40, 0x39, 0x4a, 0x30, 0xf6, 0xc8, 0xb9, 0xe2, 0xea, 0x06, 0xbe, 0x85, 0x5d,
+	0xd3, 0xa9, 0x2b, 0xd7, 0x61, 0xb9, 0x53, 0x1b, 0x9f, 0x50, 0x19, 0x26, 0x1a, 0xa1, 0x59, 0x6b,
+	0x11, 0x7b, 0x32, 0xf1, 0xc7, 0x1b, 0x42, 0x6f, 0xd1, 0x39, 0xb4, 0x49, 0x1f, 0x6e, 0xfc, 0xf2,
+	0xf5, 0xbc, 0x62, 0xfe, 0x87, 0xe8, 0x1c, 0x4a, 0x0d, 0xc0, 0xa9, 0x7e, 0x0d, 0x66, 0xa2, 0x17,
+	0x25, 0xbf, 0x15, 0xd2, 0x45, 0x78, 0x29, 0xfb, 0x0d, 0x94, 0xe8, 0x47, 0x5c, 0x86, 0xe9, 0x9d,
+	0xd4, 0x1c, 0x9e, 0xdb, 0x72, 0xbc, 0xc8, 0xae, 0xd0, 0xb7, 0xf4, 0xc0, 0xc3, 0x75, 0x76, 0x34,
+	0x79, 0x4a, 0x00, 0x73, 0x19, 0x66, 0x3e, 0xb3, 0xb7, 0x60, 0xba, 0x45, 0x5a, 0x34, 0xb6, 0x77,
+	0xa2, 0x89, 0x65, 0x1f, 0x4e, 0x89, 0x5e, 0xc4, 0x79, 0x4d, 0xb5, 0x92, 0xc3, 0x46, 0x6c, 0x2e,
+	0x99, 0xae, 0x11, 0x98, 0xfe, 0xa6, 0x8b, 0xf5, 0x7d, 0xec, 0x2a, 0x3e, 0xcc, 0x65, 0x98, 0x39,
+	0x9b, 0x25, 0x18, 0xc1, 0xb6, 0xbe, 0x63, 0x61, 0x9a, 0x0d, 0xfc, 0xc5, 0x13, 0x59, 0xd1, 0x17,
+	0x60, 0xb4, 0x11, 0x84, 0x74, 0x74, 0x1a, 0xab, 0xbc, 0xe2, 0xc1, 0x91, 0xbc, 0x12, 0xd7, 0x74,
+	0x1f, 0xb3, 0x53, 0x00, 0xc3, 0xe1, 0x94, 0x89, 0x93, 0x78, 0x0d, 0xc6, 0x5d, 0xdd, 0xc7, 0x1a,
+	0x3f, 0x05, 0xc2, 0x70, 0x2c, 0x66, 0xd7, 0x87, 0xc8, 0x5b, 0x0c, 0x05, 0xb8, 0xf1, 0x30, 0x37,
+	0x59, 0x18, 0x38, 0xf0, 0x4d, 0x4f, 0x6f, 0xe4, 0x54, 0xae, 0xb8, 0xaa, 0xf7, 0x67, 0x57, 0xf5,
+	0x7f, 0x4b, 0x30, 0x97, 0xd1, 0x23, 0x27, 0x7f, 0x01, 0x86, 0x08, 0x6f, 0xb6, 0x1b, 0x7a, 0xa0,
+	0x4d, 0xfd, 0xd0, 0x16, 0x0c, 0x05, 0x61, 0x8f, 0x2c, 0x15, 0x5f, 0xca, 0xef, 0x80, 0x0c, 0x9e,
+	0xe8, 0x85, 0x38, 0xa3, 0x1b, 0x61, 0x95, 0x6f, 0xea, 0xa6, 0x1d, 0xaa, 0x05, 0x03, 0x9f, 0xf2,
+	0x24, 0x8f, 0xbb, 0x50, 0xce, 0xb0, 0x73, 0xe6, 0x2a, 0xb6, 0xea, 0x5b, 0xb8, 0xe5, 0x78, 0xa1,
+	0x22, 0x51, 0x4a, 0x3d, 0xa9, 0xf9, 0xc3, 0x59, 0x88, 0x57, 0x13, 0x8e, 0xb4, 0x39, 0x0a, 0xd7,
+	0xcb, 0xc9, 0x3d, 0x6c, 0x85, 0x22, 0x02, 0x6d, 0x60, 0x6b, 0x9d, 0x7d, 0x16, 0x0a, 0x3d, 0x88,
+	0x13, 0x9e, 0xd8, 0x13, 0x7a, 0x56, 0xd6, 0xd9, 0x93, 0x9c, 0x88, 0x20, 0x85, 0x08, 0xb6, 0x00,
+	0xc5, 0x1e, 0x9c, 0xd9, 0x17, 0x61, 0x88, 0xe8, 0x26, 0x25, 0x29, 0xe7, 0x15, 0x4d, 0x5c, 0x12,
+	0xc1, 0x27, 0x3e, 0xdd, 0x64, 0x83, 0xe8, 0xd1, 0xc1, 0xf6, 0x2a, 0x13, 0x4b, 0x8a, 0x1c, 0xa6,
+	0x26, 0xc8, 0xed, 0x9e, 0x9f, 0x52, 0xa4, 0x5b, 0x80, 0x61, 0xcf, 0x70, 0x5a, 0xb8, 0x9e, 0x22,
+	0x49, 0x8d, 0x7c, 0xcb, 0xbe, 0xc9, 0xd5, 0x1a, 0xe5, 0x3c, 0x1c, 0x4e, 0x99, 0x0a, 0xd7, 0x0d,
+	0xe5, 0xf7, 0x83, 0x30, 0x44, 0x1e, 0xba, 0x68, 0x19, 0x46, 0x8d, 0x3d, 0xdd, 0xb4, 0x35, 0xb3,
+	0xce, 0xae, 0x1f, 0x11, 0x96, 0x98, 0xaf, 0xd5, 0xb3, 0x04, 0x83, 0xfe, 0xde, 0x04, 0x83, 0x81,
+	0x1e, 0x05, 0x83, 0xc1, 0xff, 0x95, 0x60, 0xa0, 0x65, 0x08, 0x06, 0xf4, 0xf9, 0x5e, 0xe9, 0xac,
+	0xdb, 0x7c, 0x06, 0x69, 0x60, 0xf8, 0xff, 0x52, 0x1a, 0xa8, 0xfe, 0x65, 0x11, 0x86, 0x48, 0xea,
+	0xa1, 0x6f, 0xc1, 0x30, 0xcb, 0xdf, 0xe5, 0xce, 0x8a, 0x0a, 0x45, 0xc8, 0xe5, 0x6e, 0x88, 0x28,
+	0x7d, 0x95, 0xf2, 0x3b, 0x21, 0xe9, 0xb7, 0xff, 0xfc, 0xcf, 0x1f, 0xf4, 0x2f, 0xa0, 0x39, 0x35,
+	0x4b, 0x33, 0xa7, 0x3b, 0x0a, 0xbd, 0x2f, 0xc1, 0x48, 0xa4, 0x53, 0x1f, 0xed, 0xdc, 0x3f, 0x83,
+	0xc8, 0x2b, 0x5d, 0x21, 0x9c, 0xc3, 0x6b, 0x31, 0x87, 0x0b, 0xe8, 0x4b, 0x99, 0x1c, 0x58, 0xf1,
+	0x52, 0x1f, 0xb2, 0xca, 0xf0, 0x48, 0x7d, 0xc8, 0xef, 0xe7, 0x8f, 0xd4, 0x87, 0x91, 0xd8, 0xfd,
+	0x08, 0x05, 0xd1, 0x66, 0x5b, 0xea, 0x22, 0x3b, 0xc9, 0x27, 0x0b, 0xca, 0x91, 0x8a, 0x42, 0x98,
+	0xcd, 0x23, 0x59, 0xed, 0xf4, 0x1b, 0x05, 0x0f, 0xfd, 0x4c, 0x82, 0xa9, 0x94, 0x80, 0x58, 0x2e,
+	0xaa, 0x7b, 0xc9, 0xeb, 0xbd, 0x2a, 0x64, 0xca, 0x46, 0x1c, 0xb1, 0x13, 0xe8, 0x58, 0x67, 0x5e,
+	0x71, 0xbc, 0xd0, 0xbb, 0x12, 0x4c, 0x88, 0x7a, 0x10, 0x3a, 0xd1, 0x79, 0x54, 0x11, 0x27, 0x57,
+	0x8a, 0xe1, 0x38, 0x37, 0x35, 0xe6, 0x76, 0x0c, 0x29, 0x99, 0xdc, 0x5c, 0xe2, 0xa7, 0x31, 0x51,
+	0xe1, 0x17, 0x12, 0x4c, 0x26, 0x75, 0x8c, 0x9c, 0xa5, 0x49, 0x00, 0x65, 0xb5, 0x20, 0x90, 0x93,
+	0x3b, 0x1f, 0x93, 0x53, 0xd1, 0x5a, 0x26, 0x39, 0x9f, 0x3a, 0x32, 0x76, 0x42, 0x04, 0xdf, 0x97,
+	0x60, 0x3a, 0x2d, 0x47, 0xad, 0x14, 0x23, 0x60, 0x62, 0x4f, 0xde, 0x28, 0x0c, 0xe5, 0x6c, 0xab,
+	0x31, 0xdb, 0x93, 0xe8, 0x78, 0x77, 0xb6, 0x21, 0xa3, 0x9f, 0x48, 0x30, 0x99, 0x10, 0x5a, 0xf2,
+	0xa2, 0x99, 0x00, 0xca, 0x6a, 0x41, 0x60, 0x2f, 0x69, 0x98, 0x92, 0x76, 0xd0, 0x63, 0x09, 0x66,
+	0xda, 0xa4, 0x9b, 0x53, 0x39, 0x03, 0xa7, 0xb0, 0x72, 0xb5, 0x38, 0x96, 0xf3, 0x7c, 0x35, 0xe6,
+	0xb9, 0x81, 0xd4, 0x6c, 0x9e, 0xa6, 0x1d, 0x9d, 0x8a, 0x1a, 0x55, 0x71, 0xd4, 0x87, 0xa4, 0xf0,
+	0xd1, 0x75, 0x4f, 0x69, 0x2e, 0x79, 0xeb, 0x9e, 0x82, 0xca, 0x1b, 0x85, 0xa1, 0xbd, 0xac, 0x3b,
+	0x8e, 0x5c, 0xd9, 0x5b, 0x01, 0x7d, 0x4f, 0x02, 0x88, 0xb5, 0x1d, 0x74, 0xac, 0xf3, 0xa8, 0x31,
+	0x4a, 0x5e, 0x2d, 0x82, 0xe2, 0xb4, 0xd6, 0x62, 0x5a, 0x0a, 0x5a, 0x56, 0x3b, 0xfc, 0x0e, 0x3a,
+	0xc0, 0x1a, 0x51, 0x8e, 0xd0, 0x8f, 0x25, 0x18, 0x17, 0x55, 0xa3, 0xe3, 0x9d, 0x07, 0x13, 0x60,
+	0xf2, 0x5a, 0x21, 0x18, 0x27, 0x75, 0x3a, 0x26, 0xf5, 0x32, 0x5a, 0xc9, 0x24, 0xb5, 0x8b, 0xb1,
+	0x16, 0xc9, 0x46, 0x7c, 0x55, 0xc3, 0x7a, 0x28, 0xaa, 0x44, 0x79, 0xf5, 0x50, 0xc4, 0xc9, 0x95,
+	0x62, 0xb8, 0x5e, 0xea, 0x61, 0x48, 0x30, 0xfe, 0x65, 0xee, 0x0f, 0x25, 0x00, 0x41, 0x6a, 0x3a,
+	0x96, 0x57, 0x7f, 0x23, 0x94, 0xbc, 0x5a, 0x04, 0xc5, 0x39, 0x9d, 0x89, 0x39, 0xad, 0xa2, 0x53,
+	0x1d, 0x6a, 0x34, 0xf1, 0x0a, 0x2f, 0x4f, 0x42, 0x0d, 0x7c, 0x5b, 0x82, 0x91, 0x48, 0x76, 0x3a,
+	0x9a, 0x37, 0x24, 0x81, 0xc8, 0x2b, 0x5d, 0x21, 0x9c, 0xd2, 0x4a, 0x4c, 0x69, 0x11, 0xcd, 0x77,
+	0xa0, 0x44, 0x07, 0x7e, 0x22, 0xc1, 0xa1, 0x0c, 0x75, 0x07, 0xe5, 0x65, 0x4e, 0x3b, 0x5c, 0x7e,
+	0xa5, 0x27, 0x78, 0x2f, 0xb1, 0x8b, 0xae, 0x23, 0x9a, 0x28, 0x32, 0x91, 0x3a, 0x92, 0x96, 0x91,
+	0x72, 0x02, 0x94, 0x82, 0xca, 0x1b, 0x85, 0xa1, 0xbd, 0xd4, 0x91, 0xb4, 0xb6, 0x44, 0x6e, 0x32,
+	0x49, 0xc1, 0x26, 0xef, 0x26, 0x93, 0x44, 0xca, 0xeb, 0x45, 0x91, 0xbd, 0x1c, 0x21, 0x29, 0x91,
+	0x88, 0x30, 0x4c, 0x8a, 0x38, 0x79, 0x0c, 0x93, 0x48, 0x79, 0xbd, 0x28, 0xb2, 0x17, 0x86, 0x06,
+	0xf5, 0xd4, 0x76, 0x18, 0x9d, 0xb0, 0x16, 0xc7, 0xea, 0x4e, 0xee, 0x0e, 0xe6, 0x28, 0x79, 0xb5,
+	0x08, 0xaa, 0x97, 0x5a, 0x2c, 0x28, 0x49, 0xe8, 0x37, 0x12, 0x4c, 0xa5, 0x84, 0xa0, 0x72, 0x81,
+	0xf1, 0x08, 0x52, 0x5e, 0x2f, 0x8a, 0xe4, 0xec, 0xb6, 0x62, 0x76, 0xe7, 0xd0, 0x99, 0x2e, 0xec,
+	0x34, 0xa2, 0xdb, 0x88, 0x57, 0x7b, 0x56, 0xa2, 0x7f, 0x2e, 0xc1, 0x44, 0x42, 0x75, 0xc9, 0x29,
+	0xd1, 0x22, 0x4e, 0xae, 0x14, 0xc3, 0x71, 0xba, 0xe7, 0x62, 0xba, 0x15, 0xb4, 0x9a, 0x49, 0x37,
+	0x21, 0xd6, 0xa8, 0x0f, 0xd9, 0x7b, 0xe4, 0x11, 0xfa, 0x8e, 0x04, 0x43, 0x54, 0x71, 0xc9, 0x79,
+	0x70, 0x10, 0x80, 0x7c, 0xb2, 0x0b, 0xa0, 0x97, 0x8c, 0x23, 0x6a, 0x8b, 0x40, 0xe3, 0xa7, 0x12,
+	0x4c, 0x26, 0x45, 0x95, 0x9c, 0xd1, 0x12, 0x40, 0x59, 0x2d, 0x08, 0xec, 0xa5, 0xaa, 0xd0, 0x27,
+	0xa3, 0x70, 0x6e, 0x84, 0x3b, 0x22, 0x16, 0x4f, 0xf2, 0x76, 0x44, 0x8c, 0x92, 0x57, 0x8b, 0xa0,
+	0x7a, 0xd9, 0x11, 0xc2, 0x9f, 0xe4, 0x6c, 0x5e, 0xfe, 0xe0, 0xe9, 0xa2, 0xf4, 0xe1, 0xd3, 0x45,
+	0xe9, 0x1f, 0x4f, 0x17, 0xa5, 0xef, 0x3f, 0x5b, 0xec, 0xfb, 0xf0, 0xd9, 0x62, 0xdf, 0x5f, 0x9f,
+	0x2d, 0xf6, 0x7d, 0xfd, 0x65, 0x41, 0x00, 0x20, 0xbd, 0xac, 0xe9, 0x9e, 0x87, 0x7d, 0x2f, 0xd1,
+	0x59, 0x95, 0x2a, 0x01, 0x3b, 0xc3, 0xe4, 0x6f, 0xc9, 0x3e, 0xff, 0x9f, 0x01, 0x00, 0xd8, 0x23,
+	0xe1, 0x7a, 0x92, 0x27, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
+	Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error)
+	Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error)
+	StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error)
+	RefundPolicy(ctx context.Context, in *QueryRefundPolicy, opts ...grpc.CallOption) (*QueryRefundPolicyResponse, error)
+	TimeoutPolicy(ctx context.Context, in *QueryTimeoutPolicy, opts ...grpc.CallOption) (*QueryTimeoutPolicyResponse, error)
+	TimeoutPolicies(ctx context.Context, in *QueryTimeoutPolicies, opts ...grpc.CallOption) (*QueryTimeoutPoliciesResponse, error)
+	MaxMemoLength(ctx context.Context, in *QueryMaxMemoLength, opts ...grpc.CallOption) (*QueryMaxMemoLengthResponse, error)
+	MinForwardAmount(ctx context.Context, in *QueryMinForwardAmount, opts ...grpc.CallOption) (*QueryMinForwardAmountResponse, error)
+	ExecutionLimits(ctx context.Context, in *QueryExecutionLimits, opts ...grpc.CallOption) (*QueryExecutionLimitsResponse, error)
+	QueueDepth(ctx context.Context, in *QueryQueueDepth, opts ...grpc.CallOption) (*QueryQueueDepthResponse, error)
+	FeeSchedule(ctx context.Context, in *QueryFeeSchedule, opts ...grpc.CallOption) (*QueryFeeScheduleResponse, error)
+	FeeRecipient(ctx context.Context, in *QueryFeeRecipient, opts ...grpc.CallOption) (*QueryFeeRecipientResponse, error)
+	RelayerFee(ctx context.Context, in *QueryRelayerFee, opts ...grpc.CallOption) (*QueryRelayerFeeResponse, error)
+	Retries(ctx context.Context, in *QueryRetries, opts ...grpc.CallOption) (*QueryRetriesResponse, error)
+	FallbackGracePeriod(ctx context.Context, in *QueryFallbackGracePeriod, opts ...grpc.CallOption) (*QueryFallbackGracePeriodResponse, error)
+	BlockedForwards(ctx context.Context, in *QueryBlockedForwards, opts ...grpc.CallOption) (*QueryBlockedForwardsResponse, error)
+	PausedChannels(ctx context.Context, in *QueryPausedChannels, opts ...grpc.CallOption) (*QueryPausedChannelsResponse, error)
+	CircuitBreaker(ctx context.Context, in *QueryCircuitBreaker, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error)
+	RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error)
+	RateLimitUsage(ctx context.Context, in *QueryRateLimitUsage, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error)
+	HeldDeposits(ctx context.Context, in *QueryHeldDeposits, opts ...grpc.CallOption) (*QueryHeldDepositsResponse, error)
+	Batch(ctx context.Context, in *QueryBatch, opts ...grpc.CallOption) (*QueryBatchResponse, error)
+	ChannelDenoms(ctx context.Context, in *QueryChannelDenoms, opts ...grpc.CallOption) (*QueryChannelDenomsResponse, error)
+	UnwindOnly(ctx context.Context, in *QueryUnwindOnly, opts ...grpc.CallOption) (*QueryUnwindOnlyResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Denoms(ctx context.Context, in *QueryDenoms, opts ...grpc.CallOption) (*QueryDenomsResponse, error) {
+	out := new(QueryDenomsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Denoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Address(ctx context.Context, in *QueryAddress, opts ...grpc.CallOption) (*QueryAddressResponse, error) {
+	out := new(QueryAddressResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Address", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Stats(ctx context.Context, in *QueryStats, opts ...grpc.CallOption) (*QueryStatsResponse, error) {
+	out := new(QueryStatsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Stats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StatsByChannel(ctx context.Context, in *QueryStatsByChannel, opts ...grpc.CallOption) (*QueryStatsByChannelResponse, error) {
+	out := new(QueryStatsByChannelResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/StatsByChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RefundPolicy(ctx context.Context, in *QueryRefundPolicy, opts ...grpc.CallOption) (*QueryRefundPolicyResponse, error) {
+	out := new(QueryRefundPolicyResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/RefundPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimeoutPolicy(ctx context.Context, in *QueryTimeoutPolicy, opts ...grpc.CallOption) (*QueryTimeoutPolicyResponse, error) {
+	out := new(QueryTimeoutPolicyResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/TimeoutPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TimeoutPolicies(ctx context.Context, in *QueryTimeoutPolicies, opts ...grpc.CallOption) (*QueryTimeoutPoliciesResponse, error) {
+	out := new(QueryTimeoutPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/TimeoutPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MaxMemoLength(ctx context.Context, in *QueryMaxMemoLength, opts ...grpc.CallOption) (*QueryMaxMemoLengthResponse, error) {
+	out := new(QueryMaxMemoLengthResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/MaxMemoLength", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinForwardAmount(ctx context.Context, in *QueryMinForwardAmount, opts ...grpc.CallOption) (*QueryMinForwardAmountResponse, error) {
+	out := new(QueryMinForwardAmountResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/MinForwardAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExecutionLimits(ctx context.Context, in *QueryExecutionLimits, opts ...grpc.CallOption) (*QueryExecutionLimitsResponse, error) {
+	out := new(QueryExecutionLimitsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/ExecutionLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueueDepth(ctx context.Context, in *QueryQueueDepth, opts ...grpc.CallOption) (*QueryQueueDepthResponse, error) {
+	out := new(QueryQueueDepthResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/QueueDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeSchedule(ctx context.Context, in *QueryFeeSchedule, opts ...grpc.CallOption) (*QueryFeeScheduleResponse, error) {
+	out := new(QueryFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/FeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRecipient(ctx context.Context, in *QueryFeeRecipient, opts ...grpc.CallOption) (*QueryFeeRecipientResponse, error) {
+	out := new(QueryFeeRecipientResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/FeeRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelayerFee(ctx context.Context, in *QueryRelayerFee, opts ...grpc.CallOption) (*QueryRelayerFeeResponse, error) {
+	out := new(QueryRelayerFeeResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/RelayerFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Retries(ctx context.Context, in *QueryRetries, opts ...grpc.CallOption) (*QueryRetriesResponse, error) {
+	out := new(QueryRetriesResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Retries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FallbackGracePeriod(ctx context.Context, in *QueryFallbackGracePeriod, opts ...grpc.CallOption) (*QueryFallbackGracePeriodResponse, error) {
+	out := new(QueryFallbackGracePeriodResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/FallbackGracePeriod", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockedForwards(ctx context.Context, in *QueryBlockedForwards, opts ...grpc.CallOption) (*QueryBlockedForwardsResponse, error) {
+	out := new(QueryBlockedForwardsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/BlockedForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedChannels(ctx context.Context, in *QueryPausedChannels, opts ...grpc.CallOption) (*QueryPausedChannelsResponse, error) {
+	out := new(QueryPausedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/PausedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CircuitBreaker(ctx context.Context, in *QueryCircuitBreaker, opts ...grpc.CallOption) (*QueryCircuitBreakerResponse, error) {
+	out := new(QueryCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/CircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimits(ctx context.Context, in *QueryRateLimits, opts ...grpc.CallOption) (*QueryRateLimitsResponse, error) {
+	out := new(QueryRateLimitsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/RateLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RateLimitUsage(ctx context.Context, in *QueryRateLimitUsage, opts ...grpc.CallOption) (*QueryRateLimitUsageResponse, error) {
+	out := new(QueryRateLimitUsageResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/RateLimitUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HeldDeposits(ctx context.Context, in *QueryHeldDeposits, opts ...grpc.CallOption) (*QueryHeldDepositsResponse, error) {
+	out := new(QueryHeldDepositsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/HeldDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Batch(ctx context.Context, in *QueryBatch, opts ...grpc.CallOption) (*QueryBatchResponse, error) {
+	out := new(QueryBatchResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/Batch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChannelDenoms(ctx context.Context, in *QueryChannelDenoms, opts ...grpc.CallOption) (*QueryChannelDenomsResponse, error) {
+	out := new(QueryChannelDenomsResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/ChannelDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnwindOnly(ctx context.Context, in *QueryUnwindOnly, opts ...grpc.CallOption) (*QueryUnwindOnlyResponse, error) {
+	out := new(QueryUnwindOnlyResponse)
+	err := c.cc.Invoke(ctx, "/noble.forwarding.v1.Query/UnwindOnly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	Denoms(context.Context, *QueryDenoms) (*QueryDenomsResponse, error)
+	Address(context.Context, *QueryAddress) (*QueryAddressResponse, error)
+	Stats(context.Context, *QueryStats) (*QueryStatsResponse, error)
+	StatsByChannel(context.Context, *QueryStatsByChannel) (*QueryStatsByChannelResponse, error)
+	RefundPolicy(context.Context, *QueryRefundPolicy) (*QueryRefundPolicyResponse, error)
+	TimeoutPolicy(context.Context, *QueryTimeoutPolicy) (*QueryTimeoutPolicyResponse, error)
+	TimeoutPolicies(context.Context, *QueryTimeoutPolicies) (*QueryTimeoutPoliciesResponse, error)
+	MaxMemoLength(context.Context, *QueryMaxMemoLength) (*QueryMaxMemoLengthResponse, error)
+	MinForwardAmount(context.Context, *QueryMinForwardAmount) (*QueryMinForwardAmountResponse, error)
+	ExecutionLimits(context.Context, *QueryExecutionLimits) (*QueryExecutionLimitsResponse, error)
+	QueueDepth(context.Context, *QueryQueueDepth) (*QueryQueueDepthResponse, error)
+	FeeSchedule(context.Context, *QueryFeeSchedule) (*QueryFeeScheduleResponse, error)
+	FeeRecipient(context.Context, *QueryFeeRecipient) (*QueryFeeRecipientResponse, error)
+	RelayerFee(context.Context, *QueryRelayerFee) (*QueryRelayerFeeResponse, error)
+	Retries(context.Context, *QueryRetries) (*QueryRetriesResponse, error)
+	FallbackGracePeriod(context.Context, *QueryFallbackGracePeriod) (*QueryFallbackGracePeriodResponse, error)
+	BlockedForwards(context.Context, *QueryBlockedForwards) (*QueryBlockedForwardsResponse, error)
+	PausedChannels(context.Context, *QueryPausedChannels) (*QueryPausedChannelsResponse, error)
+	CircuitBreaker(context.Context, *QueryCircuitBreaker) (*QueryCircuitBreakerResponse, error)
+	RateLimits(context.Context, *QueryRateLimits) (*QueryRateLimitsResponse, error)
+	RateLimitUsage(context.Context, *QueryRateLimitUsage) (*QueryRateLimitUsageResponse, error)
+	HeldDeposits(context.Context, *QueryHeldDeposits) (*QueryHeldDepositsResponse, error)
+	Batch(context.Context, *QueryBatch) (*QueryBatchResponse, error)
+	ChannelDenoms(context.Context, *QueryChannelDenoms) (*QueryChannelDenomsResponse, error)
+	UnwindOnly(context.Context, *QueryUnwindOnly) (*QueryUnwindOnlyResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Denoms(ctx context.Context, req *QueryDenoms) (*QueryDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
+}
+func (*UnimplementedQueryServer) Address(ctx context.Context, req *QueryAddress) (*QueryAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Address not implemented")
+}
+func (*UnimplementedQueryServer) Stats(ctx context.Context, req *QueryStats) (*QueryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (*UnimplementedQueryServer) StatsByChannel(ctx context.Context, req *QueryStatsByChannel) (*QueryStatsByChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StatsByChannel not implemented")
+}
+func (*UnimplementedQueryServer) RefundPolicy(ctx context.Context, req *QueryRefundPolicy) (*QueryRefundPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPolicy not implemented")
+}
+func (*UnimplementedQueryServer) TimeoutPolicy(ctx context.Context, req *QueryTimeoutPolicy) (*QueryTimeoutPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeoutPolicy not implemented")
+}
+func (*UnimplementedQueryServer) TimeoutPolicies(ctx context.Context, req *QueryTimeoutPolicies) (*QueryTimeoutPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TimeoutPolicies not implemented")
+}
+func (*UnimplementedQueryServer) MaxMemoLength(ctx context.Context, req *QueryMaxMemoLength) (*QueryMaxMemoLengthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MaxMemoLength not implemented")
+}
+func (*UnimplementedQueryServer) MinForwardAmount(ctx context.Context, req *QueryMinForwardAmount) (*QueryMinForwardAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinForwardAmount not implemented")
+}
+func (*UnimplementedQueryServer) ExecutionLimits(ctx context.Context, req *QueryExecutionLimits) (*QueryExecutionLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecutionLimits not implemented")
+}
+func (*UnimplementedQueryServer) QueueDepth(ctx context.Context, req *QueryQueueDepth) (*QueryQueueDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueueDepth not implemented")
+}
+func (*UnimplementedQueryServer) FeeSchedule(ctx context.Context, req *QueryFeeSchedule) (*QueryFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeSchedule not implemented")
+}
+func (*UnimplementedQueryServer) FeeRecipient(ctx context.Context, req *QueryFeeRecipient) (*QueryFeeRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRecipient not implemented")
+}
+func (*UnimplementedQueryServer) RelayerFee(ctx context.Context, req *QueryRelayerFee) (*QueryRelayerFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayerFee not implemented")
+}
+func (*UnimplementedQueryServer) Retries(ctx context.Context, req *QueryRetries) (*QueryRetriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retries not implemented")
+}
+func (*UnimplementedQueryServer) FallbackGracePeriod(ctx context.Context, req *QueryFallbackGracePeriod) (*QueryFallbackGracePeriodResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FallbackGracePeriod not implemented")
+}
+func (*UnimplementedQueryServer) BlockedForwards(ctx context.Context, req *QueryBlockedForwards) (*QueryBlockedForwardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockedForwards not implemented")
+}
+func (*UnimplementedQueryServer) PausedChannels(ctx context.Context, req *QueryPausedChannels) (*QueryPausedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedChannels not implemented")
+}
+func (*UnimplementedQueryServer) CircuitBreaker(ctx context.Context, req *QueryCircuitBreaker) (*QueryCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CircuitBreaker not implemented")
+}
+func (*UnimplementedQueryServer) RateLimits(ctx context.Context, req *QueryRateLimits) (*QueryRateLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimits not implemented")
+}
+func (*UnimplementedQueryServer) RateLimitUsage(ctx context.Context, req *QueryRateLimitUsage) (*QueryRateLimitUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RateLimitUsage not implemented")
+}
+func (*UnimplementedQueryServer) HeldDeposits(ctx context.Context, req *QueryHeldDeposits) (*QueryHeldDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HeldDeposits not implemented")
+}
+func (*UnimplementedQueryServer) Batch(ctx context.Context, req *QueryBatch) (*QueryBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
+}
+func (*UnimplementedQueryServer) ChannelDenoms(ctx context.Context, req *QueryChannelDenoms) (*QueryChannelDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelDenoms not implemented")
+}
+func (*UnimplementedQueryServer) UnwindOnly(ctx context.Context, req *QueryUnwindOnly) (*QueryUnwindOnlyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwindOnly not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Denoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Denoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Denoms(ctx, req.(*QueryDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Address_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Address(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Address",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Address(ctx, req.(*QueryAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStats)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Stats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Stats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Stats(ctx, req.(*QueryStats))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StatsByChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStatsByChannel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StatsByChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/StatsByChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StatsByChannel(ctx, req.(*QueryStatsByChannel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RefundPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRefundPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RefundPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/RefundPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RefundPolicy(ctx, req.(*QueryRefundPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeoutPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeoutPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeoutPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/TimeoutPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeoutPolicy(ctx, req.(*QueryTimeoutPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TimeoutPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTimeoutPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TimeoutPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/TimeoutPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TimeoutPolicies(ctx, req.(*QueryTimeoutPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MaxMemoLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMaxMemoLength)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MaxMemoLength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/MaxMemoLength",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MaxMemoLength(ctx, req.(*QueryMaxMemoLength))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinForwardAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinForwardAmount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinForwardAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/MinForwardAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinForwardAmount(ctx, req.(*QueryMinForwardAmount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExecutionLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExecutionLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExecutionLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/ExecutionLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExecutionLimits(ctx, req.(*QueryExecutionLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueueDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQueueDepth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueueDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/QueueDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueueDepth(ctx, req.(*QueryQueueDepth))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/FeeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeSchedule(ctx, req.(*QueryFeeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/FeeRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRecipient(ctx, req.(*QueryFeeRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelayerFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelayerFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelayerFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/RelayerFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelayerFee(ctx, req.(*QueryRelayerFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Retries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetries)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Retries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Retries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Retries(ctx, req.(*QueryRetries))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FallbackGracePeriod_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFallbackGracePeriod)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FallbackGracePeriod(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/FallbackGracePeriod",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FallbackGracePeriod(ctx, req.(*QueryFallbackGracePeriod))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockedForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockedForwards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockedForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/BlockedForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockedForwards(ctx, req.(*QueryBlockedForwards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedChannels)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/PausedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedChannels(ctx, req.(*QueryPausedChannels))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/CircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CircuitBreaker(ctx, req.(*QueryCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/RateLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimits(ctx, req.(*QueryRateLimits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RateLimitUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRateLimitUsage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RateLimitUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/RateLimitUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RateLimitUsage(ctx, req.(*QueryRateLimitUsage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HeldDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHeldDeposits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HeldDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/HeldDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HeldDeposits(ctx, req.(*QueryHeldDeposits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Batch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Batch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/Batch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Batch(ctx, req.(*QueryBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChannelDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/ChannelDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelDenoms(ctx, req.(*QueryChannelDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnwindOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnwindOnly)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnwindOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/noble.forwarding.v1.Query/UnwindOnly",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnwindOnly(ctx, req.(*QueryUnwindOnly))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "noble.forwarding.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Denoms",
+			Handler:    _Query_Denoms_Handler,
+		},
+		{
+			MethodName: "Address",
+			Handler:    _Query_Address_Handler,
+		},
+		{
+			MethodName: "Stats",
+			Handler:    _Query_Stats_Handler,
+		},
+		{
+			MethodName: "StatsByChannel",
+			Handler:    _Query_StatsByChannel_Handler,
+		},
+		{
+			MethodName: "RefundPolicy",
+			Handler:    _Query_RefundPolicy_Handler,
+		},
+		{
+			MethodName: "TimeoutPolicy",
+			Handler:    _Query_TimeoutPolicy_Handler,
+		},
+		{
+			MethodName: "TimeoutPolicies",
+			Handler:    _Query_TimeoutPolicies_Handler,
+		},
+		{
+			MethodName: "MaxMemoLength",
+			Handler:    _Query_MaxMemoLength_Handler,
+		},
+		{
+			MethodName: "MinForwardAmount",
+			Handler:    _Query_MinForwardAmount_Handler,
+		},
+		{
+			MethodName: "ExecutionLimits",
+			Handler:    _Query_ExecutionLimits_Handler,
+		},
+		{
+			MethodName: "QueueDepth",
+			Handler:    _Query_QueueDepth_Handler,
+		},
+		{
+			MethodName: "FeeSchedule",
+			Handler:    _Query_FeeSchedule_Handler,
+		},
+		{
+			MethodName: "FeeRecipient",
+			Handler:    _Query_FeeRecipient_Handler,
+		},
+		{
+			MethodName: "RelayerFee",
+			Handler:    _Query_RelayerFee_Handler,
+		},
+		{
+			MethodName: "Retries",
+			Handler:    _Query_Retries_Handler,
+		},
+		{
+			MethodName: "FallbackGracePeriod",
+			Handler:    _Query_FallbackGracePeriod_Handler,
+		},
+		{
+			MethodName: "BlockedForwards",
+			Handler:    _Query_BlockedForwards_Handler,
+		},
+		{
+			MethodName: "PausedChannels",
+			Handler:    _Query_PausedChannels_Handler,
+		},
+		{
+			MethodName: "CircuitBreaker",
+			Handler:    _Query_CircuitBreaker_Handler,
+		},
+		{
+			MethodName: "RateLimits",
+			Handler:    _Query_RateLimits_Handler,
+		},
+		{
+			MethodName: "RateLimitUsage",
+			Handler:    _Query_RateLimitUsage_Handler,
+		},
+		{
+			MethodName: "HeldDeposits",
+			Handler:    _Query_HeldDeposits_Handler,
+		},
+		{
+			MethodName: "Batch",
+			Handler:    _Query_Batch_Handler,
+		},
+		{
+			MethodName: "ChannelDenoms",
+			Handler:    _Query_ChannelDenoms_Handler,
+		},
+		{
+			MethodName: "UnwindOnly",
+			Handler:    _Query_UnwindOnly_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "noble/forwarding/v1/query.proto",
+}
+
+func (m *QueryDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedDenoms) > 0 {
+		for iNdEx := len(m.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.BlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RouteData) > 0 {
+		i -= len(m.RouteData)
+		copy(dAtA[i:], m.RouteData)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RouteData)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.DestinationType) > 0 {
+		i -= len(m.DestinationType)
+		copy(dAtA[i:], m.DestinationType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationType)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.UnwindOnly {
+		i--
+		if m.UnwindOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.DenomRoutes) > 0 {
+		for iNdEx := len(m.DenomRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.Batching.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.Splits) > 0 {
+		for iNdEx := len(m.Splits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Splits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Fallback) > 0 {
+		i -= len(m.Fallback)
+		copy(dAtA[i:], m.Fallback)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fallback)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for k := range m.Stats {
+			v := m.Stats[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStatsByChannelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStatsByChannelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStatsByChannelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.NumOfDeferrals) > 0 {
+		for k := range m.NumOfDeferrals {
+			v := m.NumOfDeferrals[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalForwarded) > 0 {
+		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.NumOfForwards != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumOfAccounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefundPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefundPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefundPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRefundPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRefundPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRefundPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeoutPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeoutPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeoutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeoutPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeoutPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeoutPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeoutPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeoutPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeoutPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTimeoutPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTimeoutPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTimeoutPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelTimeoutPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelTimeoutPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelTimeoutPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Configured {
+		i--
+		if m.Configured {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxMemoLength) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxMemoLength) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxMemoLength) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMaxMemoLengthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMaxMemoLengthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMaxMemoLengthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxMemoLength != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxMemoLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinForwardAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinForwardAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinForwardAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinForwardAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinForwardAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinForwardAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExecutionLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExecutionLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExecutionLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Limits.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueueDepth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueueDepth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueueDepth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryQueueDepthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryQueueDepthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryQueueDepthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelayerFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelayerFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelayerFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetries) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetries) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetries) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRetriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRetriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRetriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Retries) > 0 {
+		for iNdEx := len(m.Retries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Retries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFallbackGracePeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFallbackGracePeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFallbackGracePeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFallbackGracePeriodResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFallbackGracePeriodResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFallbackGracePeriodResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GracePeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GracePeriod))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedForwards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedForwards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedForwards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockedForwardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockedForwardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockedForwardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BlockedForwards) > 0 {
+		for iNdEx := len(m.BlockedForwards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedForwards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedChannels) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedChannels) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedChannels) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRateLimitUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRateLimitUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRateLimitUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Limit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldDeposits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldDeposits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldDeposits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHeldDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHeldDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHeldDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeldDeposits) > 0 {
+		for iNdEx := len(m.HeldDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exists {
+		i--
+		if m.Exists {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Batch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Scoped {
+		i--
+		if m.Scoped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnwindOnly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnwindOnly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnwindOnly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnwindOnlyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnwindOnlyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnwindOnlyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalFees) > 0 {
+		for iNdEx := len(m.TotalFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.NumOfDeferrals) > 0 {
+		for k := range m.NumOfDeferrals {
+			v := m.NumOfDeferrals[k]
+			baseI := i
+			i = encodeVarintQuery(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuery(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuery(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalForwarded) > 0 {
+		for iNdEx := len(m.TotalForwarded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalForwarded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NumOfForwards != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfForwards))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumOfAccounts != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOfAccounts))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for _, s := range m.BlockedDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Fallback)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Splits) > 0 {
+		for _, e := range m.Splits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Batching.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DenomRoutes) > 0 {
+		for _, e := range m.DenomRoutes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.UnwindOnly {
+		n += 2
+	}
+	l = len(m.DestinationType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RouteData)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Exists {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for k, v := range m.Stats {
+			_ = k
+			_ = v
+			l = v.Size()
 			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + l + sovQuery(uint64(l))
 			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
 		}
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryStatsByChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStatsByChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumOfAccounts != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfAccounts))
+	}
+	if m.NumOfForwards != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfForwards))
+	}
+	if len(m.TotalForwarded) > 0 {
+		for _, e := range m.TotalForwarded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NumOfDeferrals) > 0 {
+		for k, v := range m.NumOfDeferrals {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRefundPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRefundPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		n += 1 + sovQuery(uint64(m.Policy))
+	}
+	return n
+}
+
+func (m *QueryTimeoutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimeoutPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTimeoutPolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTimeoutPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ChannelTimeoutPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Configured {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryMaxMemoLength) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMaxMemoLengthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxMemoLength != 0 {
+		n += 1 + sovQuery(uint64(m.MaxMemoLength))
+	}
+	return n
+}
+
+func (m *QueryMinForwardAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinForwardAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExecutionLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryExecutionLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limits.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryQueueDepth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryQueueDepthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
+func (m *QueryFeeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Schedule.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelayerFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRetries) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRetriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Retries) > 0 {
+		for _, e := range m.Retries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFallbackGracePeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFallbackGracePeriodResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GracePeriod != 0 {
+		n += 1 + sovQuery(uint64(m.GracePeriod))
+	}
+	return n
+}
+
+func (m *QueryBlockedForwards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockedForwardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedForwards) > 0 {
+		for _, e := range m.BlockedForwards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedChannels) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPausedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PausedChannels) > 0 {
+		for _, e := range m.PausedChannels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRateLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRateLimitUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRateLimitUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Limit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Usage.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryHeldDeposits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHeldDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.HeldDeposits) > 0 {
+		for _, e := range m.HeldDeposits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Batch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Exists {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryChannelDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Scoped {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryUnwindOnly) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUnwindOnlyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *Stats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumOfAccounts != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfAccounts))
+	}
+	if m.NumOfForwards != 0 {
+		n += 1 + sovQuery(uint64(m.NumOfForwards))
+	}
+	if len(m.TotalForwarded) > 0 {
+		for _, e := range m.TotalForwarded {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.NumOfDeferrals) > 0 {
+		for k, v := range m.NumOfDeferrals {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovQuery(uint64(len(k))) + 1 + sovQuery(uint64(v))
+			n += mapEntrySize + 1 + sovQuery(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TotalFees) > 0 {
+		for _, e := range m.TotalFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedDenoms = append(m.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fallback = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, Hop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Splits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Splits = append(m.Splits, Split{})
+			if err := m.Splits[len(m.Splits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batching", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Batching.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRoutes = append(m.DenomRoutes, DenomRoute{})
+			if err := m.DenomRoutes[len(m.DenomRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnwindOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UnwindOnly = bool(v != 0)
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteData = append(m.RouteData[:0], dAtA[iNdEx:postIndex]...)
+			if m.RouteData == nil {
+				m.RouteData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exists", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exists = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stats == nil {
+				m.Stats = make(map[string]Stats)
+			}
+			var mapkey string
+			mapvalue := &Stats{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Stats{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Stats[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStatsByChannelResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStatsByChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
+			}
+			m.NumOfAccounts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfAccounts |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
+			}
+			m.NumOfForwards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOfForwards |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
+			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfDeferrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumOfDeferrals == nil {
+				m.NumOfDeferrals = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NumOfDeferrals[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefundPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefundPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefundPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRefundPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRefundPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRefundPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= RefundPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeoutPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeoutPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeoutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeoutPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeoutPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeoutPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeoutPolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeoutPolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeoutPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTimeoutPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTimeoutPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTimeoutPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, ChannelTimeoutPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelTimeoutPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelTimeoutPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelTimeoutPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Configured", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Configured = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaxMemoLength) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaxMemoLength: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaxMemoLength: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMaxMemoLengthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMaxMemoLengthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMaxMemoLengthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMemoLength", wireType)
+			}
+			m.MaxMemoLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMemoLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinForwardAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinForwardAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinForwardAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinForwardAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinForwardAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinForwardAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExecutionLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExecutionLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExecutionLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueueDepth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueueDepth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueueDepth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryQueueDepthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryQueueDepthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryQueueDepthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayerFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRelayerFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRelayerFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRelayerFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetries) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetries: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetries: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRetriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRetriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRetriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Retries = append(m.Retries, ForwardRetry{})
+			if err := m.Retries[len(m.Retries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFallbackGracePeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFallbackGracePeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFallbackGracePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFallbackGracePeriodResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFallbackGracePeriodResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFallbackGracePeriodResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GracePeriod", wireType)
+			}
+			m.GracePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GracePeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedForwards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedForwards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedForwards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockedForwardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockedForwardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockedForwardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedForwards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedForwards = append(m.BlockedForwards, BlockedForward{})
+			if err := m.BlockedForwards[len(m.BlockedForwards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedChannels) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedChannels: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedChannels: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, PausedChannel{})
+			if err := m.PausedChannels[len(m.PausedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRateLimitUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryStatsByChannel) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Channel)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryStatsByChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NumOfAccounts != 0 {
-		n += 1 + sovQuery(uint64(m.NumOfAccounts))
-	}
-	if m.NumOfForwards != 0 {
-		n += 1 + sovQuery(uint64(m.NumOfForwards))
-	}
-	if len(m.TotalForwarded) > 0 {
-		for _, e := range m.TotalForwarded {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRateLimitUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	return n
-}
-
-func (m *Stats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.NumOfAccounts != 0 {
-		n += 1 + sovQuery(uint64(m.NumOfAccounts))
-	}
-	if m.NumOfForwards != 0 {
-		n += 1 + sovQuery(uint64(m.NumOfForwards))
-	}
-	if len(m.TotalForwarded) > 0 {
-		for _, e := range m.TotalForwarded {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRateLimitUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRateLimitUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Limit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
+func (m *QueryHeldDeposits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1237,12 +10383,44 @@ func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenoms: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeldDeposits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeldDeposits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1264,7 +10442,7 @@ func (m *QueryDenoms) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryHeldDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1287,17 +10465,17 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryHeldDepositsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryHeldDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldDeposits", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1307,23 +10485,25 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			m.HeldDeposits = append(m.HeldDeposits, HeldDeposit{})
+			if err := m.HeldDeposits[len(m.HeldDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1346,7 +10526,7 @@ func (m *QueryDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddress) Unmarshal(dAtA []byte) error {
+func (m *QueryBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,79 +10549,15 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fallback", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1469,7 +10585,7 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fallback = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1492,7 +10608,7 @@ func (m *QueryAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1515,17 +10631,17 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1535,23 +10651,24 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -1594,7 +10711,7 @@ func (m *QueryAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStats) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1617,12 +10734,44 @@ func (m *QueryStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1644,7 +10793,7 @@ func (m *QueryStats) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1667,17 +10816,17 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1687,121 +10836,44 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Stats == nil {
-				m.Stats = make(map[string]Stats)
-			}
-			var mapkey string
-			mapvalue := &Stats{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQuery
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Stats{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipQuery(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthQuery
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Stats[mapkey] = *mapvalue
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scoped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Scoped = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1823,7 +10895,7 @@ func (m *QueryStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
+func (m *QueryUnwindOnly) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1846,44 +10918,12 @@ func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByChannel: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnwindOnly: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnwindOnly: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Channel = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1905,7 +10945,7 @@ func (m *QueryStatsByChannel) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUnwindOnlyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1928,55 +10968,17 @@ func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStatsByChannelResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUnwindOnlyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStatsByChannelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUnwindOnlyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfAccounts", wireType)
-			}
-			m.NumOfAccounts = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumOfAccounts |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumOfForwards", wireType)
-			}
-			m.NumOfForwards = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumOfForwards |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalForwarded", wireType)
-			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1986,26 +10988,12 @@ func (m *QueryStatsByChannelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalForwarded = append(m.TotalForwarded, types.Coin{})
-			if err := m.TotalForwarded[len(m.TotalForwarded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2160,6 +11148,153 @@ func (m *Stats) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOfDeferrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NumOfDeferrals == nil {
+				m.NumOfDeferrals = make(map[string]uint64)
+			}
+			var mapkey string
+			var mapvalue uint64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuery(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQuery
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NumOfDeferrals[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalFees = append(m.TotalFees, types.Coin{})
+			if err := m.TotalFees[len(m.TotalFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
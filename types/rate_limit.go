@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// RateLimitBuckets is the number of intervals that the usage of a rate limit
+// is grouped into within its window.
+const RateLimitBuckets = 10
+
+// BucketWidth returns the duration of a single interval of the usage of the
+// rate limit, in nanoseconds.
+func (limit RateLimit) BucketWidth() int64 {
+	return max(int64(limit.Window)/RateLimitBuckets, 1)
+}
+
+// ValidateRateLimit checks if a rate limit is valid. A valid rate limit must
+// define a positive amount over a non zero window.
+func ValidateRateLimit(limit RateLimit) error {
+	if !channeltypes.IsValidChannelID(limit.Channel) {
+		return errors.New("invalid channel")
+	}
+
+	if err := sdk.ValidateDenom(limit.Denom); err != nil {
+		return err
+	}
+
+	if limit.Amount.IsNil() || !limit.Amount.IsPositive() {
+		return errors.New("rate limit amount must be positive")
+	}
+
+	if limit.Window == 0 || limit.Window > math.MaxInt64 {
+		return errors.New("rate limit window must be non zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	feetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// IsZero returns true if the relayer fee doesn't escrow any fees.
+func (f RelayerFee) IsZero() bool {
+	return f.RecvFee.IsZero() && f.AckFee.IsZero() && f.TimeoutFee.IsZero()
+}
+
+// PacketFee returns the ICS-29 fee escrowed for a single packet.
+func (f RelayerFee) PacketFee() feetypes.Fee {
+	return feetypes.NewFee(f.RecvFee, f.AckFee, f.TimeoutFee)
+}
+
+// Total returns the maximum amount paid for a single packet.
+func (f RelayerFee) Total() sdk.Coins {
+	return f.PacketFee().Total()
+}
+
+// ValidateRelayerFee checks if a relayer fee is valid for a specified channel.
+// Relayer fees paid from the forwarded amount must use a single denom.
+func ValidateRelayerFee(channel string, fee RelayerFee) error {
+	if !channeltypes.IsValidChannelID(channel) {
+		return errors.New("invalid channel")
+	}
+
+	if _, ok := RelayerFeeSource_name[int32(fee.Source)]; !ok {
+		return errors.New("invalid relayer fee source")
+	}
+
+	for _, coins := range []sdk.Coins{fee.RecvFee, fee.AckFee, fee.TimeoutFee} {
+		if err := coins.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if fee.Source != RelayerFeeSourcePool && len(fee.Total()) > 1 {
+		return errors.New("relayer fees paid from the forwarded amount must use a single denom")
+	}
+
+	return nil
+}
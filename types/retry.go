@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+const (
+	// MaxRetryAttempts is the number of times a failed forward is retried
+	// before the account is dropped from the retry queue. Any remaining funds
+	// can still be recovered by clearing the account.
+	MaxRetryAttempts = 10
+
+	// RetryBaseDelay is the number of blocks to wait before the first retry of
+	// a failed forward. Subsequent retries back off exponentially.
+	RetryBaseDelay int64 = 10
+)
+
+// RetryDelay returns the number of blocks to wait before retrying a forward
+// that has failed the specified number of times.
+func RetryDelay(attempts uint64) int64 {
+	if attempts == 0 {
+		return 0
+	}
+
+	return RetryBaseDelay << (attempts - 1)
+}
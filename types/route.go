@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+// MaxDenomRoutes is the maximum number of denom routes a forwarding account
+// can define.
+const MaxDenomRoutes = 16
+
+// ValidateDenomRoutes checks if a specified list of denom routes is valid.
+func ValidateDenomRoutes(routes []DenomRoute) error {
+	if len(routes) > MaxDenomRoutes {
+		return fmt.Errorf("denom routes must not exceed %d entries", MaxDenomRoutes)
+	}
+
+	seen := make(map[string]bool)
+	for _, route := range routes {
+		if err := sdk.ValidateDenom(route.Denom); err != nil {
+			return fmt.Errorf("invalid denom route: %w", err)
+		}
+
+		if seen[route.Denom] {
+			return fmt.Errorf("duplicate denom route for %s", route.Denom)
+		}
+		seen[route.Denom] = true
+
+		if !channeltypes.IsValidChannelID(route.Channel) {
+			return fmt.Errorf("invalid denom route channel: %s", route.Channel)
+		}
+
+		if route.Recipient == "" {
+			return errors.New("denom route recipient cannot be empty")
+		}
+
+		if len(route.Recipient) > transfertypes.MaximumReceiverLength {
+			return fmt.Errorf("denom route recipient must not exceed %d bytes", transfertypes.MaximumReceiverLength)
+		}
+	}
+
+	return nil
+}
+
+// EncodeDenomRoutes deterministically encodes a list of denom routes, for use
+// in the derivation of forwarding account addresses. Routes are encoded in
+// the order of their denoms, as the order they are defined in is irrelevant.
+func EncodeDenomRoutes(routes []DenomRoute) []byte {
+	sorted := slices.Clone(routes)
+	slices.SortFunc(sorted, func(a, b DenomRoute) int {
+		return strings.Compare(a.Denom, b.Denom)
+	})
+
+	var bz []byte
+	for _, route := range sorted {
+		for _, field := range []string{route.Denom, route.Channel, route.Recipient} {
+			bz = binary.AppendUvarint(bz, uint64(len(field)))
+			bz = append(bz, field...)
+		}
+	}
+
+	return bz
+}
+
+// GetDenomRoute returns the route of a specific denom, if the forwarding
+// account defines one.
+func (fa *ForwardingAccount) GetDenomRoute(denom string) (DenomRoute, bool) {
+	for _, route := range fa.DenomRoutes {
+		if route.Denom == denom {
+			return route, true
+		}
+	}
+
+	return DenomRoute{}, false
+}
+
+// Routes returns the shares that funds of a denom received by a forwarding
+// account are forwarded in. Denoms without a route are forwarded through the
+// default route of the account, split across its splits if defined. Accounts
+// with a non IBC destination don't forward through any channel.
+func (fa *ForwardingAccount) Routes(denom string) []Split {
+	if !fa.IsIBC() {
+		return nil
+	}
+
+	if route, found := fa.GetDenomRoute(denom); found {
+		return []Split{{Channel: route.Channel, Recipient: route.Recipient, Bps: BasisPoints}}
+	}
+
+	if len(fa.Splits) > 0 {
+		return fa.Splits
+	}
+
+	return []Split{{Channel: fa.Channel, Recipient: fa.Recipient, Bps: BasisPoints}}
+}
+
+// Channels returns all channels that a forwarding account forwards through,
+// starting with the channel of its default route. Accounts with a non IBC
+// destination don't forward through any channel.
+func (fa *ForwardingAccount) Channels() []string {
+	if !fa.IsIBC() {
+		return nil
+	}
+
+	channels := []string{fa.Channel}
+	for _, split := range fa.Splits {
+		if !slices.Contains(channels, split.Channel) {
+			channels = append(channels, split.Channel)
+		}
+	}
+	for _, route := range fa.DenomRoutes {
+		if !slices.Contains(channels, route.Channel) {
+			channels = append(channels, route.Channel)
+		}
+	}
+
+	return channels
+}
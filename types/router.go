@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+const (
+	// DestinationTypeIBC is the destination type of forwarding accounts that
+	// forward through ICS-20 channels. Accounts without a destination type
+	// forward through IBC.
+	DestinationTypeIBC = "ibc"
+	// DestinationTypeLocal is the destination type of forwarding accounts
+	// that forward to a native address, using a bank send.
+	DestinationTypeLocal = "local"
+)
+
+// MaxRouteDataLength is the maximum length, in bytes, of the route data of a
+// forwarding account.
+const MaxRouteDataLength = 1024
+
+// Router forwards the funds of forwarding accounts to a specific type of
+// destination, e.g. an ICS-20 channel, a native address, or a bridge.
+type Router interface {
+	// ValidateRoute checks if the route of a forwarding account that is
+	// being registered is valid. Returning an error rejects the registration.
+	ValidateRoute(ctx context.Context, account ForwardingAccount) error
+	// Forward forwards a balance of a forwarding account to its destination.
+	// Returning an error fails the forward, in which case it is retried.
+	Forward(ctx context.Context, account ForwardingAccount, balance sdk.Coin) error
+}
+
+var destinationTypeRegex = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+
+// ValidateDestinationType checks if the name of a destination type is valid.
+func ValidateDestinationType(name string) error {
+	if !destinationTypeRegex.MatchString(name) {
+		return fmt.Errorf("invalid destination type: %s", name)
+	}
+
+	return nil
+}
+
+// ValidateRouteData checks if the route data of a forwarding account is
+// valid for its destination type. Route data is only supported by non IBC
+// destinations.
+func ValidateRouteData(destinationType string, routeData []byte) error {
+	if len(routeData) > MaxRouteDataLength {
+		return fmt.Errorf("route data must not exceed %d bytes", MaxRouteDataLength)
+	}
+
+	if IsIBCDestination(destinationType) && len(routeData) > 0 {
+		return errors.New("route data is not supported by ibc destinations")
+	}
+
+	return nil
+}
+
+// IsIBCDestination returns whether a destination type forwards through IBC.
+func IsIBCDestination(destinationType string) bool {
+	return destinationType == "" || destinationType == DestinationTypeIBC
+}
+
+// IsIBC returns whether a forwarding account forwards through IBC.
+func (fa *ForwardingAccount) IsIBC() bool {
+	return IsIBCDestination(fa.DestinationType)
+}
+
+// Destination returns the destination type of a forwarding account.
+func (fa *ForwardingAccount) Destination() string {
+	if fa.IsIBC() {
+		return DestinationTypeIBC
+	}
+
+	return fa.DestinationType
+}
+
+// StatsKey returns the key that the statistics of a forwarding account are
+// tracked under, being its channel, or its destination type for non IBC
+// destinations.
+func (fa *ForwardingAccount) StatsKey() string {
+	if fa.IsIBC() {
+		return fa.Channel
+	}
+
+	return fa.DestinationType
+}
+
+// ValidateDestination checks if the destination of a forwarding account is
+// valid. Accounts forwarding through IBC require a valid channel, while non
+// IBC destinations don't support channels, hops, splits, denom routes or
+// unwind-only mode.
+func (fa *ForwardingAccount) ValidateDestination() error {
+	if err := ValidateRouteData(fa.DestinationType, fa.RouteData); err != nil {
+		return err
+	}
+
+	if fa.IsIBC() {
+		if !channeltypes.IsValidChannelID(fa.Channel) {
+			return fmt.Errorf("%s is an invalid channel id", fa.Channel)
+		}
+
+		return nil
+	}
+
+	if err := ValidateDestinationType(fa.DestinationType); err != nil {
+		return err
+	}
+
+	if fa.Channel != "" || len(fa.Hops) > 0 || len(fa.Splits) > 0 || len(fa.DenomRoutes) > 0 || fa.UnwindOnly {
+		return fmt.Errorf("%s destinations don't support channels, hops, splits, denom routes or unwind-only mode", fa.DestinationType)
+	}
+
+	return nil
+}
+
+// ForwardingRouterWrapper is a wrapper for modules to inject a Router using
+// depinject. The router is registered under the name of the module.
+type ForwardingRouterWrapper struct{ Router }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (ForwardingRouterWrapper) IsOnePerModuleType() {}
@@ -0,0 +1,46 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestValidateDestination(t *testing.T) {
+	ibc := types.ForwardingAccount{Channel: "channel-0"}
+	require.NoError(t, ibc.ValidateDestination())
+	ibc.RouteData = []byte{0x01}
+	require.Error(t, ibc.ValidateDestination())
+
+	local := types.ForwardingAccount{DestinationType: types.DestinationTypeLocal}
+	require.NoError(t, local.ValidateDestination())
+	local.Splits = []types.Split{{Channel: "channel-0", Recipient: "cosmos1...", Bps: types.BasisPoints}}
+	require.Error(t, local.ValidateDestination())
+
+	require.Error(t, types.ValidateDestinationType(""))
+	require.Error(t, types.ValidateDestinationType("Bridge"))
+	require.NoError(t, types.ValidateDestinationType("bridge"))
+	require.Error(t, types.ValidateRouteData("bridge", make([]byte, types.MaxRouteDataLength+1)))
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+)
+
+// MaxHoldDuration is the maximum duration that deposits into a forwarding
+// account can be held for, and the maximum interval between releases.
+const MaxHoldDuration = 365 * 24 * time.Hour
+
+// IsZero returns true if the schedule doesn't hold deposits.
+func (s Schedule) IsZero() bool {
+	return s.HoldDuration == 0 && s.ReleaseTime == 0 && s.ReleaseInterval == 0
+}
+
+// EligibleAt returns the time, in unix nanoseconds, at which a deposit made at
+// the specified time becomes eligible to be forwarded.
+func (s Schedule) EligibleAt(depositedAt int64) int64 {
+	eligibleAt := depositedAt + int64(s.HoldDuration)
+	if eligibleAt <= s.ReleaseTime {
+		return s.ReleaseTime
+	}
+
+	if s.ReleaseInterval == 0 {
+		return eligibleAt
+	}
+
+	interval := int64(s.ReleaseInterval)
+	periods := (eligibleAt - s.ReleaseTime + interval - 1) / interval
+
+	return s.ReleaseTime + periods*interval
+}
+
+// ValidateSchedule checks if a specified schedule is valid.
+func ValidateSchedule(schedule Schedule) error {
+	if schedule.HoldDuration > uint64(MaxHoldDuration) {
+		return fmt.Errorf("hold duration must not exceed %s", MaxHoldDuration)
+	}
+
+	if schedule.ReleaseTime < 0 {
+		return fmt.Errorf("%d is an invalid release time", schedule.ReleaseTime)
+	}
+
+	if schedule.ReleaseInterval > uint64(MaxHoldDuration) {
+		return fmt.Errorf("release interval must not exceed %s", MaxHoldDuration)
+	}
+
+	return nil
+}
+
+// EncodeSchedule deterministically encodes a schedule, for use in the
+// derivation of forwarding account addresses.
+func EncodeSchedule(schedule Schedule) []byte {
+	bz := binary.BigEndian.AppendUint64(nil, schedule.HoldDuration)
+	bz = binary.BigEndian.AppendUint64(bz, uint64(schedule.ReleaseTime))
+	return binary.BigEndian.AppendUint64(bz, schedule.ReleaseInterval)
+}
@@ -0,0 +1,49 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/noble-assets/forwarding/v2/types"
+)
+
+func TestScheduleEligibleAt(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano()
+	hour := int64(time.Hour)
+
+	// ARRANGE: Release daily, after holding deposits for an hour.
+	schedule := types.Schedule{
+		HoldDuration:    uint64(time.Hour),
+		ReleaseTime:     start,
+		ReleaseInterval: uint64(24 * time.Hour),
+	}
+
+	// ASSERT: Deposits before the release time are released at it.
+	require.Equal(t, start, schedule.EligibleAt(start-2*hour))
+	// ASSERT: Deposits held past a release are released at the next one.
+	require.Equal(t, start+24*hour, schedule.EligibleAt(start-hour/2))
+	require.Equal(t, start+24*hour, schedule.EligibleAt(start+23*hour))
+	require.Equal(t, start+48*hour, schedule.EligibleAt(start+23*hour+1))
+}
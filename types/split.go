@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: BUSL-1.1
+//
+// Copyright (C) 2025, NASD Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included
+// in the LICENSE file of this repository and at www.mariadb.com/bsl11.
+//
+// ANY USE OF THE LICENSED WORK IN VIOLATION OF THIS LICENSE WILL AUTOMATICALLY
+// TERMINATE YOUR RIGHTS UNDER THIS LICENSE FOR THE CURRENT AND ALL OTHER
+// VERSIONS OF THE LICENSED WORK.
+//
+// THIS LICENSE DOES NOT GRANT YOU ANY RIGHT IN ANY TRADEMARK OR LOGO OF
+// LICENSOR OR ITS AFFILIATES (PROVIDED THAT YOU MAY USE A TRADEMARK OR LOGO OF
+// LICENSOR AS EXPRESSLY REQUIRED BY THIS LICENSE).
+//
+// TO THE EXTENT PERMITTED BY APPLICABLE LAW, THE LICENSED WORK IS PROVIDED ON
+// AN "AS IS" BASIS. LICENSOR HEREBY DISCLAIMS ALL WARRANTIES AND CONDITIONS,
+// EXPRESS OR IMPLIED, INCLUDING (WITHOUT LIMITATION) WARRANTIES OF
+// MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE, NON-INFRINGEMENT, AND
+// TITLE.
+
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+)
+
+const (
+	// BasisPoints is the total of the shares of a split forwarding account.
+	BasisPoints = 10_000
+
+	// MaxSplits is the maximum number of splits a forwarding account can
+	// define.
+	MaxSplits = 8
+)
+
+// ValidateSplits checks if a specified list of splits is valid for a
+// forwarding account. The first split must match the channel and recipient of
+// the account, and the shares of all splits must total 100%.
+func ValidateSplits(channel string, recipient string, hops []Hop, splits []Split) error {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	if len(splits) > MaxSplits {
+		return fmt.Errorf("splits must not exceed %d entries", MaxSplits)
+	}
+
+	if len(hops) > 0 {
+		return errors.New("splits cannot be combined with hops")
+	}
+
+	if splits[0].Channel != channel || splits[0].Recipient != recipient {
+		return errors.New("first split must match the channel and recipient of the account")
+	}
+
+	var total uint64
+	seen := make(map[string]bool)
+	for _, split := range splits {
+		if !channeltypes.IsValidChannelID(split.Channel) {
+			return fmt.Errorf("invalid split channel: %s", split.Channel)
+		}
+
+		if split.Recipient == "" {
+			return errors.New("split recipient cannot be empty")
+		}
+
+		if len(split.Recipient) > transfertypes.MaximumReceiverLength {
+			return fmt.Errorf("split recipient must not exceed %d bytes", transfertypes.MaximumReceiverLength)
+		}
+
+		if split.Bps == 0 {
+			return fmt.Errorf("split of %s on %s must be positive", split.Recipient, split.Channel)
+		}
+
+		key := split.Channel + "/" + split.Recipient
+		if seen[key] {
+			return fmt.Errorf("duplicate split of %s on %s", split.Recipient, split.Channel)
+		}
+		seen[key] = true
+
+		total += uint64(split.Bps)
+	}
+
+	if total != BasisPoints {
+		return fmt.Errorf("splits must total %d basis points, got %d", BasisPoints, total)
+	}
+
+	return nil
+}
+
+// EncodeSplits deterministically encodes a list of splits, for use in the
+// derivation of forwarding account addresses.
+func EncodeSplits(splits []Split) []byte {
+	var bz []byte
+	for _, split := range splits {
+		bz = binary.AppendUvarint(bz, uint64(len(split.Channel)))
+		bz = append(bz, split.Channel...)
+		bz = binary.AppendUvarint(bz, uint64(len(split.Recipient)))
+		bz = append(bz, split.Recipient...)
+		bz = binary.BigEndian.AppendUint32(bz, split.Bps)
+	}
+
+	return bz
+}
+
+// SplitAmount splits an amount into the shares of the specified splits. Each
+// share is rounded down, with the remainder added to the share of the first
+// split, so that the shares always total the amount.
+func SplitAmount(amount sdkmath.Int, splits []Split) []sdkmath.Int {
+	if len(splits) == 0 {
+		return nil
+	}
+
+	shares := make([]sdkmath.Int, len(splits))
+	remainder := amount
+
+	for i, split := range splits {
+		shares[i] = amount.MulRaw(int64(split.Bps)).QuoRaw(BasisPoints)
+		remainder = remainder.Sub(shares[i])
+	}
+	shares[0] = shares[0].Add(remainder)
+
+	return shares
+}